@@ -23,7 +23,7 @@ func main() {
 		panic(fmt.Sprintf("Failed to load config: %v", err))
 	}
 
-	consumer, err := messaging.NewRabbitMQConsumer(cfg.RabbitMQURL, cfg.Exchange, cfg.Logger)
+	consumer, err := messaging.NewRabbitMQConsumer(cfg.RabbitMQURL, cfg.Exchange, cfg.Tenants, cfg.Logger)
 	if err != nil {
 		cfg.Logger.Fatal("Failed to initialize RabbitMQ consumer", zap.Error(err))
 	}
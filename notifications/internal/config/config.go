@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
@@ -12,6 +13,7 @@ type Config struct {
 	RabbitMQURL string
 	Exchange    string
 	Port        string
+	Tenants     []string
 	Logger      *zap.Logger
 }
 
@@ -32,11 +34,13 @@ func LoadConfig() (*Config, error) {
 
 	exchange := getEnv("RABBITMQ_EXCHANGE", "products_events")
 	port := getEnv("NOTIFICATIONS_SERVICE_PORT", "8081")
+	tenants := getEnvAsList("NOTIFICATIONS_TENANTS")
 
 	return &Config{
 		RabbitMQURL: rabbitMQURL,
 		Exchange:    exchange,
 		Port:        port,
+		Tenants:     tenants,
 		Logger:      logger,
 	}, nil
 }
@@ -48,3 +52,13 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvAsList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
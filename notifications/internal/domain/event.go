@@ -3,13 +3,84 @@ package domain
 import "time"
 
 type ProductEvent struct {
-	Type      string    `json:"type"`
-	ProductID int       `json:"product_id"`
-	Timestamp time.Time `json:"timestamp"`
+	Type       string                 `json:"type"`
+	TenantID   string                 `json:"tenant_id,omitempty"`
+	ProductID  int                    `json:"product_id"`
+	ParentID   *int                   `json:"parent_id,omitempty"`
+	Status     string                 `json:"status,omitempty"`
+	FromStatus string                 `json:"from_status,omitempty"`
+	ToStatus   string                 `json:"to_status,omitempty"`
+	Changes    map[string]FieldChange `json:"changes,omitempty"`
+	Prices     map[string]string      `json:"prices,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Stock      *StockLevel            `json:"stock,omitempty"`
+	Category   *Category              `json:"category,omitempty"`
+	Timestamp  time.Time              `json:"timestamp"`
+}
+
+type FieldChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+type StockLevel struct {
+	OnHand        int        `json:"on_hand"`
+	Reserved      int        `json:"reserved"`
+	Available     int        `json:"available"`
+	Delta         int        `json:"delta,omitempty"`
+	Reason        string     `json:"reason,omitempty"`
+	ReservationID int64      `json:"reservation_id,omitempty"`
+	Quantity      int        `json:"quantity,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+type Category struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	Slug             string `json:"slug"`
+	ParentID         *int   `json:"parent_id"`
+	Path             string `json:"path"`
+	PreviousParentID *int   `json:"previous_parent_id,omitempty"`
+	PreviousPath     string `json:"previous_path,omitempty"`
 }
 
 const (
-	EventTypeProductCreated = "PRODUCT_CREATED"
-	EventTypeProductDeleted = "PRODUCT_DELETED"
+	EventTypeProductCreated      = "PRODUCT_CREATED"
+	EventTypeProductUpdated      = "PRODUCT_UPDATED"
+	EventTypeProductDeleted      = "PRODUCT_DELETED"
+	EventTypeProductRestored     = "PRODUCT_RESTORED"
+	EventTypeProductActivated    = "PRODUCT_ACTIVATED"
+	EventTypeProductDiscontinued = "PRODUCT_DISCONTINUED"
+	EventTypeProductArchived     = "PRODUCT_ARCHIVED"
+	EventTypeStockChanged        = "STOCK_CHANGED"
+	EventTypeStockReserved       = "STOCK_RESERVED"
+	EventTypeOutOfStock          = "OUT_OF_STOCK"
+	EventTypeCategoryCreated     = "CATEGORY_CREATED"
+	EventTypeCategoryUpdated     = "CATEGORY_UPDATED"
+	EventTypeCategoryMoved       = "CATEGORY_MOVED"
+	EventTypeCategoryDeleted     = "CATEGORY_DELETED"
 )
 
+const DefaultTenant = "default"
+
+func (e ProductEvent) Tenant() string {
+	if e.TenantID == "" {
+		return DefaultTenant
+	}
+	return e.TenantID
+}
+
+func IsKnownEventType(eventType string) bool {
+	switch eventType {
+	case EventTypeProductCreated, EventTypeProductUpdated, EventTypeProductDeleted, EventTypeProductRestored:
+		return true
+	case EventTypeProductActivated, EventTypeProductDiscontinued, EventTypeProductArchived:
+		return true
+	case EventTypeStockChanged, EventTypeStockReserved, EventTypeOutOfStock:
+		return true
+	case EventTypeCategoryCreated, EventTypeCategoryUpdated, EventTypeCategoryMoved, EventTypeCategoryDeleted:
+		return true
+	default:
+		return false
+	}
+}
@@ -20,11 +20,12 @@ type rabbitMQConsumer struct {
 	channel   *amqp.Channel
 	exchange  string
 	queueName string
+	tenants   map[string]struct{}
 	logger    *zap.Logger
 	done      chan bool
 }
 
-func NewRabbitMQConsumer(connStr, exchange string, logger *zap.Logger) (Consumer, error) {
+func NewRabbitMQConsumer(connStr, exchange string, tenants []string, logger *zap.Logger) (Consumer, error) {
 	conn, err := amqp.Dial(connStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
@@ -83,6 +84,7 @@ func NewRabbitMQConsumer(connStr, exchange string, logger *zap.Logger) (Consumer
 		channel:   ch,
 		exchange:  exchange,
 		queueName: queue.Name,
+		tenants:   tenantSet(tenants),
 		logger:    logger,
 		done:      make(chan bool),
 	}, nil
@@ -104,7 +106,8 @@ func (c *rabbitMQConsumer) Start(ctx context.Context) error {
 
 	c.logger.Info("Started consuming messages",
 		zap.String("exchange", c.exchange),
-		zap.String("queue", c.queueName))
+		zap.String("queue", c.queueName),
+		zap.Int("tenants", len(c.tenants)))
 
 	go func() {
 		for {
@@ -138,7 +141,7 @@ func (c *rabbitMQConsumer) handleMessage(msg amqp.Delivery) {
 		return
 	}
 
-	if event.Type != domain.EventTypeProductCreated && event.Type != domain.EventTypeProductDeleted {
+	if !domain.IsKnownEventType(event.Type) {
 		c.logger.Warn("Unknown event type",
 			zap.String("type", event.Type),
 			zap.String("body", string(msg.Body)))
@@ -146,12 +149,71 @@ func (c *rabbitMQConsumer) handleMessage(msg amqp.Delivery) {
 		return
 	}
 
+	if !c.handlesTenant(event.Tenant()) {
+		c.logger.Debug("Skipping event for unhandled tenant",
+			zap.String("type", event.Type),
+			zap.String("tenant_id", event.Tenant()))
+		msg.Ack(false)
+		return
+	}
+
 	c.logger.Info("Received product event",
 		zap.String("type", event.Type),
+		zap.String("tenant_id", event.Tenant()),
 		zap.Int("product_id", event.ProductID),
 		zap.Time("timestamp", event.Timestamp),
 		zap.String("raw_json", string(msg.Body)))
 
+	if event.ToStatus != "" {
+		c.logger.Info("Product status changed",
+			zap.Int("product_id", event.ProductID),
+			zap.String("from", event.FromStatus),
+			zap.String("to", event.ToStatus))
+	}
+
+	if event.Type == domain.EventTypeProductUpdated {
+		c.logger.Info("Product fields changed",
+			zap.Int("product_id", event.ProductID),
+			zap.Any("changes", event.Changes))
+	}
+
+	if len(event.Prices) > 0 {
+		c.logger.Info("Product prices",
+			zap.Int("product_id", event.ProductID),
+			zap.Any("prices", event.Prices))
+	}
+
+	if len(event.Attributes) > 0 || event.ParentID != nil {
+		c.logger.Info("Product attributes",
+			zap.Int("product_id", event.ProductID),
+			zap.Intp("parent_id", event.ParentID),
+			zap.Any("attributes", event.Attributes))
+	}
+
+	if event.Stock != nil {
+		c.logger.Info("Product stock level",
+			zap.Int("product_id", event.ProductID),
+			zap.Int("on_hand", event.Stock.OnHand),
+			zap.Int("reserved", event.Stock.Reserved),
+			zap.Int("available", event.Stock.Available),
+			zap.String("reason", event.Stock.Reason))
+	}
+
+	if event.Category != nil {
+		c.logger.Info("Category changed",
+			zap.String("type", event.Type),
+			zap.Int("category_id", event.Category.ID),
+			zap.String("slug", event.Category.Slug),
+			zap.String("path", event.Category.Path),
+			zap.String("previous_path", event.Category.PreviousPath),
+			zap.Any("changes", event.Changes))
+	}
+
+	if event.Type == domain.EventTypeOutOfStock {
+		c.logger.Warn("Product is out of stock",
+			zap.Int("product_id", event.ProductID))
+	}
+
 	if err := msg.Ack(false); err != nil {
 		c.logger.Error("Failed to acknowledge message", zap.Error(err))
 	} else {
@@ -161,6 +223,22 @@ func (c *rabbitMQConsumer) handleMessage(msg amqp.Delivery) {
 	}
 }
 
+func (c *rabbitMQConsumer) handlesTenant(tenant string) bool {
+	if len(c.tenants) == 0 {
+		return true
+	}
+	_, ok := c.tenants[tenant]
+	return ok
+}
+
+func tenantSet(tenants []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(tenants))
+	for _, tenant := range tenants {
+		set[tenant] = struct{}{}
+	}
+	return set
+}
+
 func (c *rabbitMQConsumer) Stop() error {
 	close(c.done)
 	if c.channel != nil {
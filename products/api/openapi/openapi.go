@@ -0,0 +1,190 @@
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+)
+
+const errorMessageExtension = "x-error-message"
+
+var (
+	//go:embed openapi.yaml
+	specYAML []byte
+
+	//go:embed docs.html
+	DocsPage []byte
+
+	loadOnce sync.Once
+	spec     *openapi3.T
+	specJSON []byte
+	loadErr  error
+
+	missingProperty = regexp.MustCompile(`^property "(.+)" is missing$`)
+)
+
+func Load() (*openapi3.T, error) {
+	loadOnce.Do(func() {
+		loader := openapi3.NewLoader()
+		spec, loadErr = loader.LoadFromData(specYAML)
+		if loadErr != nil {
+			loadErr = fmt.Errorf("failed to load OpenAPI spec: %w", loadErr)
+			return
+		}
+		if loadErr = spec.Validate(loader.Context); loadErr != nil {
+			loadErr = fmt.Errorf("invalid OpenAPI spec: %w", loadErr)
+			return
+		}
+		if specJSON, loadErr = json.Marshal(spec); loadErr != nil {
+			loadErr = fmt.Errorf("failed to encode OpenAPI spec: %w", loadErr)
+		}
+	})
+	return spec, loadErr
+}
+
+func JSON() ([]byte, error) {
+	if _, err := Load(); err != nil {
+		return nil, err
+	}
+	return specJSON, nil
+}
+
+type ValidationError struct {
+	Message string
+	Details map[string]string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func ValidateSchema(name string, value interface{}) error {
+	doc, err := Load()
+	if err != nil {
+		return err
+	}
+	schema, ok := doc.Components.Schemas[name]
+	if !ok || schema.Value == nil {
+		return fmt.Errorf("schema %q is not defined", name)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return err
+	}
+
+	if err := schema.Value.VisitJSON(document, openapi3.MultiErrors()); err != nil {
+		return NewValidationError(err)
+	}
+	return nil
+}
+
+func NewValidationError(err error) *ValidationError {
+	details := make(map[string]string)
+	collectDetails(err, "", details)
+
+	message := "Request validation failed"
+	if len(details) == 1 {
+		for field, reason := range details {
+			message = reason
+			name := field[strings.LastIndex(field, ".")+1:]
+			if field != "body" && !strings.HasPrefix(reason, name+" ") {
+				message = field + ": " + reason
+			}
+		}
+	}
+	return &ValidationError{Message: message, Details: details}
+}
+
+func collectDetails(err error, prefix string, details map[string]string) {
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		for _, e := range multi {
+			collectDetails(e, prefix, details)
+		}
+		return
+	}
+
+	var requestErr *openapi3filter.RequestError
+	if errors.As(err, &requestErr) {
+		switch {
+		case requestErr.Parameter != nil:
+			field := requestErr.Parameter.Name
+			if requestErr.Err == nil {
+				details[field] = requestErr.Reason
+				return
+			}
+			if errors.Is(requestErr.Err, openapi3filter.ErrInvalidRequired) {
+				details[field] = field + " is required"
+				return
+			}
+			var schemaErr *openapi3.SchemaError
+			if errors.As(requestErr.Err, &schemaErr) {
+				details[field] = schemaReason(schemaErr)
+				return
+			}
+			details[field] = requestErr.Err.Error()
+		case errors.Is(requestErr.Err, openapi3filter.ErrInvalidRequired):
+			details["body"] = "request body is required"
+		case isSchemaError(requestErr.Err):
+			collectDetails(requestErr.Err, "body", details)
+		case requestErr.Reason != "":
+			details["body"] = requestErr.Reason
+		default:
+			details["body"] = requestErr.Err.Error()
+		}
+		return
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		path := schemaErr.JSONPointer()
+		if match := missingProperty.FindStringSubmatch(schemaErr.Reason); match != nil {
+			path = append(path, match[1])
+		}
+		field := strings.Join(path, ".")
+		if field == "" {
+			field = prefix
+		}
+		if field == "" {
+			field = "body"
+		}
+		details[field] = schemaReason(schemaErr)
+		return
+	}
+
+	field := prefix
+	if field == "" {
+		field = "request"
+	}
+	details[field] = err.Error()
+}
+
+func isSchemaError(err error) bool {
+	var multi openapi3.MultiError
+	var schemaErr *openapi3.SchemaError
+	return errors.As(err, &multi) || errors.As(err, &schemaErr)
+}
+
+func schemaReason(err *openapi3.SchemaError) string {
+	if err.Schema != nil {
+		if message, ok := err.Schema.Extensions[errorMessageExtension].(string); ok && message != "" {
+			return message
+		}
+	}
+	if match := missingProperty.FindStringSubmatch(err.Reason); match != nil {
+		return match[1] + " is required"
+	}
+	return err.Reason
+}
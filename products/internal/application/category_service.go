@@ -0,0 +1,198 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+)
+
+var _ ports.CategoryApplicationService = (*ProductService)(nil)
+
+func (s *ProductService) CreateCategoryWithEvent(
+	ctx context.Context,
+	category *domain.Category,
+	idempotencyKey string,
+) error {
+	return s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		if err := uow.CategoryRepository().Create(ctx, category); err != nil {
+			if errors.Is(err, domain.ErrCategorySlugConflict) || errors.Is(err, domain.ErrCategoryNotFound) {
+				return err
+			}
+			return NewTransactionError("create category", err)
+		}
+
+		category.RecordCreatedEvent()
+
+		if err := s.publishCategoryEvents(ctx, category, idempotencyKey, uow.OutboxRepository()); err != nil {
+			return err
+		}
+
+		s.logger.Info("Category created successfully",
+			ports.NewField("category_id", category.ID),
+			ports.NewField("path", category.Path),
+		)
+
+		return nil
+	})
+}
+
+func (s *ProductService) UpdateCategoryWithEvent(
+	ctx context.Context,
+	category *domain.Category,
+	idempotencyKey string,
+) error {
+	return s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		if err := uow.CategoryRepository().Update(ctx, category); err != nil {
+			if errors.Is(err, domain.ErrCategoryNotFound) || errors.Is(err, domain.ErrVersionConflict) || errors.Is(err, domain.ErrCategorySlugConflict) {
+				return err
+			}
+			return NewTransactionError("update category", err)
+		}
+
+		if err := s.publishCategoryEvents(ctx, category, idempotencyKey, uow.OutboxRepository()); err != nil {
+			return err
+		}
+
+		s.logger.Info("Category updated successfully",
+			ports.NewField("category_id", category.ID),
+		)
+
+		return nil
+	})
+}
+
+func (s *ProductService) MoveCategoryWithEvent(
+	ctx context.Context,
+	id int,
+	parentID *int,
+	expectedVersion int,
+	check ports.CategoryMoveCheck,
+	idempotencyKey string,
+) (*domain.Category, error) {
+	var moved *domain.Category
+
+	err := s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		categoryRepo := uow.CategoryRepository()
+
+		if err := categoryRepo.LockForMove(ctx, id, parentID); err != nil {
+			return NewTransactionError("lock categories", err)
+		}
+
+		category, err := categoryRepo.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, domain.ErrCategoryNotFound) {
+				return err
+			}
+			return NewTransactionError("get category", err)
+		}
+
+		if err := category.CheckVersion(expectedVersion); err != nil {
+			return fmt.Errorf("category %d: %w", id, err)
+		}
+
+		var parent *domain.Category
+		if parentID != nil {
+			parent, err = categoryRepo.GetByID(ctx, *parentID)
+			if err != nil {
+				if errors.Is(err, domain.ErrCategoryNotFound) {
+					return fmt.Errorf("parent category %d: %w", *parentID, domain.ErrCategoryNotFound)
+				}
+				return NewTransactionError("get parent category", err)
+			}
+		}
+
+		subtreeDepth, err := categoryRepo.SubtreeDepth(ctx, category)
+		if err != nil {
+			return NewTransactionError("get category subtree depth", err)
+		}
+
+		if err := check(category, parent, subtreeDepth-category.Depth()+1); err != nil {
+			return err
+		}
+
+		previousPath := category.Path
+		if err := category.MoveTo(parent); err != nil {
+			return err
+		}
+
+		if len(category.DomainEvents()) == 0 {
+			moved = category
+			return nil
+		}
+
+		if err := categoryRepo.Move(ctx, category, previousPath); err != nil {
+			if errors.Is(err, domain.ErrCategoryNotFound) || errors.Is(err, domain.ErrVersionConflict) {
+				return err
+			}
+			return NewTransactionError("move category", err)
+		}
+
+		if err := s.publishCategoryEvents(ctx, category, idempotencyKey, uow.OutboxRepository()); err != nil {
+			return err
+		}
+
+		s.logger.Info("Category moved successfully",
+			ports.NewField("category_id", category.ID),
+			ports.NewField("previous_path", previousPath),
+			ports.NewField("path", category.Path),
+		)
+
+		moved = category
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
+
+func (s *ProductService) DeleteCategoryWithEvent(
+	ctx context.Context,
+	category *domain.Category,
+	idempotencyKey string,
+) error {
+	return s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		if err := uow.CategoryRepository().Delete(ctx, category.ID, category.Version); err != nil {
+			if errors.Is(err, domain.ErrCategoryNotFound) || errors.Is(err, domain.ErrVersionConflict) || errors.Is(err, domain.ErrCategoryInUse) {
+				return err
+			}
+			return NewTransactionError("delete category", err)
+		}
+
+		if err := s.publishCategoryEvents(ctx, category, idempotencyKey, uow.OutboxRepository()); err != nil {
+			return err
+		}
+
+		s.logger.Info("Category deleted successfully",
+			ports.NewField("category_id", category.ID),
+		)
+
+		return nil
+	})
+}
+
+func (s *ProductService) publishCategoryEvents(
+	ctx context.Context,
+	category *domain.Category,
+	idempotencyKey string,
+	outboxRepo ports.OutboxRepository,
+) error {
+	events := category.DomainEvents()
+	if len(events) == 0 {
+		return fmt.Errorf("no domain events found in category - event should be recorded in Use Case layer")
+	}
+
+	if err := s.publishDomainEventsBatch(ctx, events, idempotencyKey, outboxRepo); err != nil {
+		s.logger.Error("Failed to save events to outbox",
+			ports.NewField("error", err),
+			ports.NewField("category_id", category.ID),
+		)
+		return NewTransactionError("publish events", err)
+	}
+
+	category.ClearDomainEvents()
+	return nil
+}
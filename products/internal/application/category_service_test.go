@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/domain/services"
+	"product_service/products/mocks"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestProductService_MoveCategoryWithEvent_ChecksLockedRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFactory := mocks.NewMockUoWFactory(ctrl)
+	mockUoW := mocks.NewMockUnitOfWork(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	service := NewProductService(mockFactory, nil, mockLogger, nil, nil)
+
+	ctx := context.Background()
+	parentID := 4
+	category := &domain.Category{ID: 1, Name: "Clothing", Slug: "clothing", Path: "/1/", Version: 2}
+	parent := &domain.Category{ID: 4, Name: "Shirts", Slug: "shirts", ParentID: &category.ID, Path: "/1/4/"}
+
+	mockFactory.EXPECT().CreateUnitOfWork().Return(mockUoW)
+	mockUoW.EXPECT().Begin(ctx).Return(nil)
+	mockUoW.EXPECT().CategoryRepository().Return(mockCategoryRepo)
+	gomock.InOrder(
+		mockCategoryRepo.EXPECT().LockForMove(ctx, 1, &parentID).Return(nil),
+		mockCategoryRepo.EXPECT().GetByID(ctx, 1).Return(category, nil),
+		mockCategoryRepo.EXPECT().GetByID(ctx, 4).Return(parent, nil),
+		mockCategoryRepo.EXPECT().SubtreeDepth(ctx, category).Return(2, nil),
+	)
+	mockUoW.EXPECT().Rollback().Return(nil)
+
+	check := services.NewCategoryDomainService().CanMoveCategory
+	_, err := service.MoveCategoryWithEvent(ctx, 1, &parentID, 2, check, "")
+	if !errors.Is(err, domain.ErrInvalidCategoryMove) {
+		t.Errorf("Expected ErrInvalidCategoryMove, got: %v", err)
+	}
+}
+
+func TestProductService_MoveCategoryWithEvent_StaleVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFactory := mocks.NewMockUoWFactory(ctrl)
+	mockUoW := mocks.NewMockUnitOfWork(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	service := NewProductService(mockFactory, nil, mockLogger, nil, nil)
+
+	ctx := context.Background()
+	category := &domain.Category{ID: 1, Name: "Clothing", Slug: "clothing", Path: "/1/", Version: 3}
+
+	mockFactory.EXPECT().CreateUnitOfWork().Return(mockUoW)
+	mockUoW.EXPECT().Begin(ctx).Return(nil)
+	mockUoW.EXPECT().CategoryRepository().Return(mockCategoryRepo)
+	mockCategoryRepo.EXPECT().LockForMove(ctx, 1, nil).Return(nil)
+	mockCategoryRepo.EXPECT().GetByID(ctx, 1).Return(category, nil)
+	mockUoW.EXPECT().Rollback().Return(nil)
+
+	check := func(category, parent *domain.Category, subtreeHeight int) error {
+		t.Fatal("Expected the check to be skipped for a stale version")
+		return nil
+	}
+	_, err := service.MoveCategoryWithEvent(ctx, 1, nil, 2, check, "")
+	if !errors.Is(err, domain.ErrVersionConflict) {
+		t.Errorf("Expected ErrVersionConflict, got: %v", err)
+	}
+}
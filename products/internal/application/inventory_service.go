@@ -0,0 +1,267 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"time"
+)
+
+var _ ports.InventoryApplicationService = (*ProductService)(nil)
+
+func (s *ProductService) AdjustStockWithEvent(
+	ctx context.Context,
+	productID int,
+	delta int,
+	reason string,
+	idempotencyKey string,
+) (*domain.Inventory, error) {
+	var inventory *domain.Inventory
+
+	err := s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		inventoryRepo := uow.InventoryRepository()
+
+		locked, err := inventoryRepo.GetForUpdate(ctx, productID)
+		if err != nil {
+			return NewTransactionError("lock inventory", err)
+		}
+
+		if err := locked.Adjust(delta, reason); err != nil {
+			return err
+		}
+
+		if err := inventoryRepo.Save(ctx, locked); err != nil {
+			return NewTransactionError("save inventory", err)
+		}
+
+		if err := s.publishInventoryEvents(ctx, locked, idempotencyKey, uow.OutboxRepository()); err != nil {
+			return err
+		}
+
+		s.logger.Info("Stock adjusted",
+			ports.NewField("product_id", productID),
+			ports.NewField("delta", delta),
+			ports.NewField("on_hand", locked.OnHand),
+		)
+
+		inventory = locked
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inventory, nil
+}
+
+func (s *ProductService) ReserveStockWithEvent(
+	ctx context.Context,
+	productID int,
+	quantity int,
+	expiresAt time.Time,
+	idempotencyKey string,
+) (*domain.Reservation, *domain.Inventory, error) {
+	var reservation *domain.Reservation
+	var inventory *domain.Inventory
+
+	err := s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		inventoryRepo := uow.InventoryRepository()
+
+		locked, err := inventoryRepo.GetForUpdate(ctx, productID)
+		if err != nil {
+			return NewTransactionError("lock inventory", err)
+		}
+
+		reserved, err := locked.Reserve(quantity, expiresAt)
+		if err != nil {
+			return err
+		}
+
+		if err := inventoryRepo.Save(ctx, locked); err != nil {
+			return NewTransactionError("save inventory", err)
+		}
+
+		if err := inventoryRepo.CreateReservation(ctx, reserved); err != nil {
+			return NewTransactionError("create reservation", err)
+		}
+
+		locked.RecordReservedEvent(reserved)
+
+		if err := s.publishInventoryEvents(ctx, locked, idempotencyKey, uow.OutboxRepository()); err != nil {
+			return err
+		}
+
+		s.logger.Info("Stock reserved",
+			ports.NewField("product_id", productID),
+			ports.NewField("reservation_id", reserved.ID),
+			ports.NewField("quantity", quantity),
+		)
+
+		reservation = reserved
+		inventory = locked
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reservation, inventory, nil
+}
+
+func (s *ProductService) ConfirmReservationWithEvent(
+	ctx context.Context,
+	reservationID int64,
+	idempotencyKey string,
+) (*domain.Reservation, *domain.Inventory, error) {
+	return s.settleReservation(ctx, reservationID, idempotencyKey, "confirm", func(inventory *domain.Inventory, reservation *domain.Reservation) error {
+		return inventory.Confirm(reservation, time.Now().UTC())
+	})
+}
+
+func (s *ProductService) ReleaseReservationWithEvent(
+	ctx context.Context,
+	reservationID int64,
+	idempotencyKey string,
+) (*domain.Reservation, *domain.Inventory, error) {
+	return s.settleReservation(ctx, reservationID, idempotencyKey, "release", func(inventory *domain.Inventory, reservation *domain.Reservation) error {
+		return inventory.Release(reservation)
+	})
+}
+
+func (s *ProductService) settleReservation(
+	ctx context.Context,
+	reservationID int64,
+	idempotencyKey string,
+	operation string,
+	apply func(inventory *domain.Inventory, reservation *domain.Reservation) error,
+) (*domain.Reservation, *domain.Inventory, error) {
+	var reservation *domain.Reservation
+	var inventory *domain.Inventory
+
+	err := s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		inventoryRepo := uow.InventoryRepository()
+
+		locked, err := inventoryRepo.GetReservationForUpdate(ctx, reservationID)
+		if err != nil {
+			if errors.Is(err, domain.ErrReservationNotFound) {
+				return err
+			}
+			return NewTransactionError("lock reservation", err)
+		}
+
+		stock, err := inventoryRepo.GetForUpdate(ctx, locked.ProductID)
+		if err != nil {
+			return NewTransactionError("lock inventory", err)
+		}
+
+		if err := apply(stock, locked); err != nil {
+			return err
+		}
+
+		if err := inventoryRepo.Save(ctx, stock); err != nil {
+			return NewTransactionError("save inventory", err)
+		}
+
+		if err := inventoryRepo.UpdateReservationStatus(ctx, locked); err != nil {
+			return NewTransactionError(operation+" reservation", err)
+		}
+
+		if err := s.publishInventoryEvents(ctx, stock, idempotencyKey, uow.OutboxRepository()); err != nil {
+			return err
+		}
+
+		s.logger.Info("Reservation settled",
+			ports.NewField("reservation_id", reservationID),
+			ports.NewField("product_id", locked.ProductID),
+			ports.NewField("status", string(locked.Status)),
+		)
+
+		reservation = locked
+		inventory = stock
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reservation, inventory, nil
+}
+
+func (s *ProductService) ReleaseExpiredReservations(ctx context.Context, now time.Time, limit int) (int, error) {
+	released := 0
+
+	err := s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		released = 0
+		inventoryRepo := uow.InventoryRepository()
+
+		expired, err := inventoryRepo.ListExpiredReservations(ctx, now, limit)
+		if err != nil {
+			return NewTransactionError("list expired reservations", err)
+		}
+
+		events := make([]domain.DomainEvent, 0, len(expired))
+		for i := range expired {
+			reservation := &expired[i]
+
+			stock, err := inventoryRepo.GetForUpdate(ctx, reservation.ProductID)
+			if err != nil {
+				return NewTransactionError("lock inventory", err)
+			}
+
+			if err := stock.Expire(reservation); err != nil {
+				return err
+			}
+
+			if err := inventoryRepo.Save(ctx, stock); err != nil {
+				return NewTransactionError("save inventory", err)
+			}
+
+			if err := inventoryRepo.UpdateReservationStatus(ctx, reservation); err != nil {
+				return NewTransactionError("expire reservation", err)
+			}
+
+			events = append(events, stock.DomainEvents()...)
+			released++
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		if err := s.publishDomainEventsBatch(ctx, events, "", uow.OutboxRepository()); err != nil {
+			return NewTransactionError("publish events", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return released, nil
+}
+
+func (s *ProductService) publishInventoryEvents(
+	ctx context.Context,
+	inventory *domain.Inventory,
+	idempotencyKey string,
+	outboxRepo ports.OutboxRepository,
+) error {
+	events := inventory.DomainEvents()
+	if len(events) == 0 {
+		return fmt.Errorf("no domain events found in inventory")
+	}
+
+	if err := s.publishDomainEventsBatch(ctx, events, idempotencyKey, outboxRepo); err != nil {
+		s.logger.Error("Failed to save events to outbox",
+			ports.NewField("error", err),
+			ports.NewField("product_id", inventory.ProductID),
+		)
+		return NewEventPublishError(inventory.ProductID, events[0].EventType(), err)
+	}
+
+	inventory.ClearDomainEvents()
+	return nil
+}
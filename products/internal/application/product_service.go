@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"product_service/products/internal/domain"
 	"product_service/products/internal/usecase/ports"
+	"strconv"
 	"time"
 )
 
@@ -173,6 +174,14 @@ func (s *ProductService) CreateProductWithEvent(
 			return fmt.Errorf("no domain events found in product")
 		}
 
+		if err := s.recordHistory(ctx, uow, events); err != nil {
+			s.logger.Error("Failed to record product history",
+				ports.NewField("error", err),
+				ports.NewField("product_id", product.ID),
+			)
+			return NewTransactionError("record history", err)
+		}
+
 		outboxRepo := uow.OutboxRepository()
 		if err := s.publishDomainEventsBatch(ctx, events, idempotencyKey, outboxRepo); err != nil {
 			s.logger.Error("Failed to save events to outbox",
@@ -196,16 +205,199 @@ func (s *ProductService) CreateProductWithEvent(
 	})
 }
 
+func (s *ProductService) CreateProductsWithEvents(
+	ctx context.Context,
+	products []*domain.Product,
+	idempotencyKey string,
+) error {
+	return s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		if err := uow.ProductRepository().CreateBatch(ctx, products); err != nil {
+			s.logger.Error("Failed to save products to repository",
+				ports.NewField("error", err),
+				ports.NewField("count", len(products)),
+			)
+			return NewTransactionError("create products", err)
+		}
+
+		var events []domain.DomainEvent
+		var keys []string
+		for i, product := range products {
+			product.ClearDomainEvents()
+			product.RecordCreatedEvent()
+			for j, event := range product.DomainEvents() {
+				events = append(events, event)
+				keys = append(keys, eventIdempotencyKey(batchItemIdempotencyKey(idempotencyKey, i), j, event))
+			}
+		}
+
+		if err := s.recordHistory(ctx, uow, events); err != nil {
+			s.logger.Error("Failed to record product history",
+				ports.NewField("error", err),
+			)
+			return NewTransactionError("record history", err)
+		}
+
+		if err := s.saveOutboxEvents(ctx, events, keys, uow.OutboxRepository()); err != nil {
+			s.logger.Error("Failed to save events to outbox",
+				ports.NewField("error", err),
+				ports.NewField("count", len(products)),
+			)
+			return NewTransactionError("publish events", err)
+		}
+
+		for _, product := range products {
+			product.ClearDomainEvents()
+		}
+
+		s.logger.Info("Products created successfully",
+			ports.NewField("count", len(products)),
+		)
+
+		return nil
+	})
+}
+
+func (s *ProductService) ImportProductsWithEvents(
+	ctx context.Context,
+	created []*domain.Product,
+	updated []*domain.Product,
+	idempotencyKey string,
+) error {
+	versions := make([]int, len(updated))
+	for i, product := range updated {
+		versions[i] = product.Version
+	}
+
+	return s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		for i, product := range updated {
+			product.Version = versions[i]
+		}
+
+		repo := uow.ProductRepository()
+		if err := repo.CreateBatch(ctx, created); err != nil {
+			s.logger.Error("Failed to save imported products to repository",
+				ports.NewField("error", err),
+				ports.NewField("count", len(created)),
+			)
+			return NewTransactionError("import products", err)
+		}
+
+		for _, product := range updated {
+			if err := repo.Update(ctx, product); err != nil {
+				if errors.Is(err, domain.ErrProductNotFound) {
+					return fmt.Errorf("product %d not found: %w", product.ID, domain.ErrProductNotFound)
+				}
+				if errors.Is(err, domain.ErrVersionConflict) {
+					return fmt.Errorf("product %d version conflict: %w", product.ID, domain.ErrVersionConflict)
+				}
+				return NewTransactionError("import products", err)
+			}
+		}
+
+		for _, product := range created {
+			product.ClearDomainEvents()
+			product.RecordCreatedEvent()
+		}
+
+		products := append(append([]*domain.Product{}, created...), updated...)
+		var events []domain.DomainEvent
+		var keys []string
+		for i, product := range products {
+			for j, event := range product.DomainEvents() {
+				events = append(events, event)
+				keys = append(keys, eventIdempotencyKey(batchItemIdempotencyKey(idempotencyKey, i), j, event))
+			}
+		}
+
+		if err := s.recordHistory(ctx, uow, events); err != nil {
+			s.logger.Error("Failed to record product history",
+				ports.NewField("error", err),
+			)
+			return NewTransactionError("record history", err)
+		}
+
+		if err := s.saveOutboxEvents(ctx, events, keys, uow.OutboxRepository()); err != nil {
+			s.logger.Error("Failed to save events to outbox",
+				ports.NewField("error", err),
+				ports.NewField("count", len(products)),
+			)
+			return NewTransactionError("publish events", err)
+		}
+
+		for _, product := range products {
+			product.ClearDomainEvents()
+		}
+
+		s.logger.Info("Products imported successfully",
+			ports.NewField("created", len(created)),
+			ports.NewField("updated", len(updated)),
+		)
+
+		return nil
+	})
+}
+
+func (s *ProductService) UpdateProductWithEvent(
+	ctx context.Context,
+	product *domain.Product,
+	idempotencyKey string,
+) error {
+	return s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		if err := uow.ProductRepository().Update(ctx, product); err != nil {
+			if errors.Is(err, domain.ErrProductNotFound) {
+				return fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+			}
+			if errors.Is(err, domain.ErrVersionConflict) {
+				return fmt.Errorf("product version conflict: %w", domain.ErrVersionConflict)
+			}
+			return NewTransactionError("update product", err)
+		}
+
+		events := product.DomainEvents()
+		if len(events) == 0 {
+			return fmt.Errorf("no domain events found in product - event should be recorded by Product.Update")
+		}
+
+		if err := s.recordHistory(ctx, uow, events); err != nil {
+			s.logger.Error("Failed to record product history",
+				ports.NewField("error", err),
+				ports.NewField("product_id", product.ID),
+			)
+			return NewTransactionError("record history", err)
+		}
+
+		outboxRepo := uow.OutboxRepository()
+		if err := s.publishDomainEventsBatch(ctx, events, idempotencyKey, outboxRepo); err != nil {
+			s.logger.Error("Failed to save events to outbox",
+				ports.NewField("error", err),
+				ports.NewField("product_id", product.ID),
+			)
+			return NewEventPublishError(product.ID, events[0].EventType(), err)
+		}
+
+		product.ClearDomainEvents()
+
+		s.logger.Info("Product updated successfully",
+			ports.NewField("product_id", product.ID),
+		)
+
+		return nil
+	})
+}
+
 func (s *ProductService) DeleteProductWithEvent(
 	ctx context.Context,
 	product *domain.Product,
 	idempotencyKey string,
 ) error {
 	return s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
-		if err := uow.ProductRepository().Delete(ctx, product.ID); err != nil {
+		if err := uow.ProductRepository().Delete(ctx, product.ID, product.Version); err != nil {
 			if errors.Is(err, domain.ErrProductNotFound) {
 				return fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
 			}
+			if errors.Is(err, domain.ErrVersionConflict) {
+				return fmt.Errorf("product version conflict: %w", domain.ErrVersionConflict)
+			}
 			return NewTransactionError("delete product", err)
 		}
 
@@ -214,6 +406,14 @@ func (s *ProductService) DeleteProductWithEvent(
 			return fmt.Errorf("no domain events found in product - event should be recorded in Use Case layer")
 		}
 
+		if err := s.recordHistory(ctx, uow, events); err != nil {
+			s.logger.Error("Failed to record product history",
+				ports.NewField("error", err),
+				ports.NewField("product_id", product.ID),
+			)
+			return NewTransactionError("record history", err)
+		}
+
 		outboxRepo := uow.OutboxRepository()
 		if err := s.publishDomainEventsBatch(ctx, events, idempotencyKey, outboxRepo); err != nil {
 			s.logger.Error("Failed to save events to outbox",
@@ -236,15 +436,76 @@ func (s *ProductService) DeleteProductWithEvent(
 	})
 }
 
+func (s *ProductService) RestoreProductWithEvent(
+	ctx context.Context,
+	product *domain.Product,
+	idempotencyKey string,
+) error {
+	return s.ExecuteInTransaction(ctx, func(uow ports.UnitOfWork) error {
+		if err := uow.ProductRepository().Restore(ctx, product); err != nil {
+			if errors.Is(err, domain.ErrProductNotFound) {
+				return fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+			}
+			if errors.Is(err, domain.ErrVersionConflict) {
+				return fmt.Errorf("product version conflict: %w", domain.ErrVersionConflict)
+			}
+			return NewTransactionError("restore product", err)
+		}
+
+		events := product.DomainEvents()
+		if len(events) == 0 {
+			return fmt.Errorf("no domain events found in product - event should be recorded by Product.Restore")
+		}
+
+		if err := s.recordHistory(ctx, uow, events); err != nil {
+			s.logger.Error("Failed to record product history",
+				ports.NewField("error", err),
+				ports.NewField("product_id", product.ID),
+			)
+			return NewTransactionError("record history", err)
+		}
+
+		outboxRepo := uow.OutboxRepository()
+		if err := s.publishDomainEventsBatch(ctx, events, idempotencyKey, outboxRepo); err != nil {
+			s.logger.Error("Failed to save events to outbox",
+				ports.NewField("error", err),
+				ports.NewField("product_id", product.ID),
+			)
+			return NewEventPublishError(product.ID, events[0].EventType(), err)
+		}
+
+		product.ClearDomainEvents()
+
+		s.logger.Info("Product restored successfully",
+			ports.NewField("product_id", product.ID),
+		)
+
+		return nil
+	})
+}
+
 func (s *ProductService) publishDomainEventsBatch(
 	ctx context.Context,
 	events []domain.DomainEvent,
 	idempotencyKey string,
 	outboxRepo ports.OutboxRepository,
+) error {
+	keys := make([]string, len(events))
+	for i, event := range events {
+		keys[i] = eventIdempotencyKey(idempotencyKey, i, event)
+	}
+	return s.saveOutboxEvents(ctx, events, keys, outboxRepo)
+}
+
+func (s *ProductService) saveOutboxEvents(
+	ctx context.Context,
+	events []domain.DomainEvent,
+	keys []string,
+	outboxRepo ports.OutboxRepository,
 ) error {
 	if batchRepo, ok := outboxRepo.(ports.BatchOutboxRepository); ok {
 		outboxEvents := make([]*ports.OutboxEvent, 0, len(events))
-		for _, event := range events {
+		for i, event := range events {
 			eventDataJSON, err := event.MarshalJSON()
 			if err != nil {
 				return fmt.Errorf("failed to marshal event data: %w", err)
@@ -253,7 +514,7 @@ func (s *ProductService) publishDomainEventsBatch(
 			outboxEvents = append(outboxEvents, &ports.OutboxEvent{
 				EventType:      event.EventType(),
 				EventData:      eventDataJSON,
-				IdempotencyKey: idempotencyKey,
+				IdempotencyKey: keys[i],
 				Status:         ports.OutboxStatusPending,
 			})
 		}
@@ -265,8 +526,8 @@ func (s *ProductService) publishDomainEventsBatch(
 		return batchRepo.SaveEventsBatch(ctx, outboxEvents)
 	}
 
-		for _, event := range events {
-			if err := s.eventPublisher.PublishDomainEventWithIdempotencyKey(ctx, event, idempotencyKey, outboxRepo); err != nil {
+		for i, event := range events {
+			if err := s.eventPublisher.PublishDomainEventWithIdempotencyKey(ctx, event, keys[i], outboxRepo); err != nil {
 				return NewEventPublishError(0, event.EventType(), err)
 			}
 		}
@@ -274,3 +535,33 @@ func (s *ProductService) publishDomainEventsBatch(
 	return nil
 }
 
+func (s *ProductService) recordHistory(ctx context.Context, uow ports.UnitOfWork, events []domain.DomainEvent) error {
+	actor := ports.ActorFromContext(ctx)
+	requestID := ports.RequestIDFromContext(ctx)
+
+	entries := make([]domain.ProductHistoryEntry, 0, len(events))
+	for _, event := range events {
+		if entry, ok := domain.NewProductHistoryEntry(event, actor, requestID); ok {
+			entries = append(entries, entry)
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return uow.ProductHistoryRepository().Append(ctx, entries)
+}
+
+func eventIdempotencyKey(idempotencyKey string, index int, event domain.DomainEvent) string {
+	if idempotencyKey == "" || index == 0 {
+		return idempotencyKey
+	}
+	return idempotencyKey + ":" + event.EventType()
+}
+
+func batchItemIdempotencyKey(idempotencyKey string, index int) string {
+	if idempotencyKey == "" {
+		return ""
+	}
+	return idempotencyKey + ":" + strconv.Itoa(index)
+}
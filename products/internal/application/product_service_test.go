@@ -0,0 +1,199 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"product_service/products/mocks"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+type retryTestEnv struct {
+	service     *ProductService
+	productRepo *mocks.MockProductRepository
+	outbox      *mocks.MockBatchOutboxRepository
+}
+
+func newRetryTestEnv(t *testing.T, attempts int) retryTestEnv {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+
+	mockFactory := mocks.NewMockUoWFactory(ctrl)
+	mockUoW := mocks.NewMockUnitOfWork(ctrl)
+	mockProductRepo := mocks.NewMockProductRepository(ctrl)
+	mockHistoryRepo := mocks.NewMockProductHistoryRepository(ctrl)
+	mockOutbox := mocks.NewMockBatchOutboxRepository(ctrl)
+	mockRetrier := mocks.NewMockRetrier(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockRetrier.EXPECT().
+		Do(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, cfg ports.RetryConfig, fn func() error) error {
+			var err error
+			for attempt := 0; attempt < cfg.MaxAttempts; attempt++ {
+				if err = fn(); err == nil {
+					return nil
+				}
+			}
+			return err
+		})
+
+	mockFactory.EXPECT().CreateUnitOfWork().Return(mockUoW).Times(attempts)
+	mockUoW.EXPECT().Begin(gomock.Any()).Return(nil).Times(attempts)
+	mockUoW.EXPECT().ProductRepository().Return(mockProductRepo).AnyTimes()
+	mockUoW.EXPECT().ProductHistoryRepository().Return(mockHistoryRepo).AnyTimes()
+	mockUoW.EXPECT().OutboxRepository().Return(mockOutbox).AnyTimes()
+	mockUoW.EXPECT().Rollback().Return(nil).Times(attempts - 1)
+	mockUoW.EXPECT().Commit().Return(nil)
+	mockHistoryRepo.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return retryTestEnv{
+		service:     NewProductService(mockFactory, nil, mockLogger, mockRetrier, nil),
+		productRepo: mockProductRepo,
+		outbox:      mockOutbox,
+	}
+}
+
+func (env retryTestEnv) failFirstOutboxWrite(saved *[]*ports.OutboxEvent) {
+	gomock.InOrder(
+		env.outbox.EXPECT().SaveEventsBatch(gomock.Any(), gomock.Any()).Return(errors.New("connection reset")),
+		env.outbox.EXPECT().
+			SaveEventsBatch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, events []*ports.OutboxEvent) error {
+				*saved = events
+				return nil
+			}),
+	)
+}
+
+func outboxProductIDs(t *testing.T, events []*ports.OutboxEvent, eventType string) []int {
+	t.Helper()
+	var ids []int
+	for _, event := range events {
+		if event.EventType != eventType {
+			continue
+		}
+		var payload struct {
+			ProductID int `json:"product_id"`
+		}
+		if err := json.Unmarshal(event.EventData, &payload); err != nil {
+			t.Fatalf("decode outbox event: %v", err)
+		}
+		ids = append(ids, payload.ProductID)
+	}
+	return ids
+}
+
+func newTestProducts(t *testing.T, names ...string) []*domain.Product {
+	t.Helper()
+	price, err := domain.NewMoney(1000, "USD")
+	if err != nil {
+		t.Fatalf("Failed to build price: %v", err)
+	}
+	products := make([]*domain.Product, 0, len(names))
+	for _, name := range names {
+		product, err := domain.NewProduct(name, price)
+		if err != nil {
+			t.Fatalf("Failed to build product: %v", err)
+		}
+		products = append(products, product)
+	}
+	return products
+}
+
+func TestProductService_CreateProductsWithEvents_RetryPublishesOneEventPerProduct(t *testing.T) {
+	env := newRetryTestEnv(t, 2)
+	ctx := context.Background()
+	products := newTestProducts(t, "Lamp", "Desk")
+
+	nextID := 1
+	env.productRepo.EXPECT().
+		CreateBatch(ctx, products).
+		DoAndReturn(func(_ context.Context, products []*domain.Product) error {
+			for _, product := range products {
+				product.ID = nextID
+				nextID++
+			}
+			return nil
+		}).
+		Times(2)
+
+	var saved []*ports.OutboxEvent
+	env.failFirstOutboxWrite(&saved)
+
+	if err := env.service.CreateProductsWithEvents(ctx, products, "batch-1"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(saved) != len(products) {
+		t.Fatalf("Expected %d outbox events, got %d", len(products), len(saved))
+	}
+	ids := outboxProductIDs(t, saved, "PRODUCT_CREATED")
+	if len(ids) != 2 || ids[0] != 3 || ids[1] != 4 {
+		t.Errorf("Expected PRODUCT_CREATED for the committed ids [3 4], got %v", ids)
+	}
+	for _, product := range products {
+		if events := product.DomainEvents(); len(events) != 0 {
+			t.Errorf("Expected product %d to have no pending events, got %v", product.ID, events)
+		}
+	}
+}
+
+func TestProductService_ImportProductsWithEvents_RetryPublishesOneEventPerProduct(t *testing.T) {
+	env := newRetryTestEnv(t, 2)
+	ctx := context.Background()
+	products := newTestProducts(t, "Lamp", "Desk")
+	created, existing := products[:1], products[1]
+	existing.ID = 7
+	existing.Version = 3
+	existing.ClearDomainEvents()
+	if err := existing.Update("Standing Desk", existing.Price, nil); err != nil {
+		t.Fatalf("Failed to update product: %v", err)
+	}
+
+	nextID := 10
+	env.productRepo.EXPECT().
+		CreateBatch(ctx, created).
+		DoAndReturn(func(_ context.Context, products []*domain.Product) error {
+			products[0].ID = nextID
+			nextID++
+			return nil
+		}).
+		Times(2)
+	env.productRepo.EXPECT().
+		Update(ctx, existing).
+		DoAndReturn(func(_ context.Context, product *domain.Product) error {
+			if product.Version != 3 {
+				t.Errorf("Expected every attempt to update from version 3, got %d", product.Version)
+			}
+			product.Version++
+			return nil
+		}).
+		Times(2)
+
+	var saved []*ports.OutboxEvent
+	env.failFirstOutboxWrite(&saved)
+
+	if err := env.service.ImportProductsWithEvents(ctx, created, []*domain.Product{existing}, "import-1"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(saved) != 2 {
+		t.Fatalf("Expected 2 outbox events, got %d", len(saved))
+	}
+	if ids := outboxProductIDs(t, saved, "PRODUCT_CREATED"); len(ids) != 1 || ids[0] != 11 {
+		t.Errorf("Expected PRODUCT_CREATED for the committed id 11, got %v", ids)
+	}
+	if ids := outboxProductIDs(t, saved, "PRODUCT_UPDATED"); len(ids) != 1 || ids[0] != 7 {
+		t.Errorf("Expected a single PRODUCT_UPDATED for product 7, got %v", ids)
+	}
+	if existing.Version != 4 {
+		t.Errorf("Expected the committed version 4, got %d", existing.Version)
+	}
+}
@@ -9,8 +9,11 @@ import (
 	"go.uber.org/zap"
 
 	"product_service/products/internal/config"
+	"product_service/products/internal/grpcserver"
+	"product_service/products/internal/infrastructure/inventory"
 	"product_service/products/internal/infrastructure/messaging"
 	"product_service/products/internal/infrastructure/metrics"
+	"product_service/products/internal/infrastructure/retention"
 	"product_service/products/internal/infrastructure/tracing"
 	"product_service/products/internal/repository"
 	"product_service/products/internal/usecase"
@@ -23,7 +26,10 @@ type App struct {
 	DB            *config.Dependencies
 	Router        *gin.Engine
 	HTTPServer    *http.Server
+	GRPCServer    *grpcserver.Server
 	OutboxWorker  *messaging.OutboxWorker
+	PurgeWorker   *retention.PurgeWorker
+	ReservationSweeper *inventory.ReservationSweeper
 	Publisher     ports.EventPublisher
 	ProductStm    *repository.PreparedStatements
 	OutboxStm     *repository.PreparedStatements
@@ -50,6 +56,11 @@ func Bootstrap() (*App, error) {
 		}
 	}()
 
+	tokenVerifier, err := initTokenVerifier(appConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := initDatabase(appConfig, deps, logger); err != nil {
 		return nil, err
 	}
@@ -61,13 +72,17 @@ func Bootstrap() (*App, error) {
 		return nil, err
 	}
 
-	uowFactory := initUnitOfWorkFactory(deps.DB, productStm, outboxStm, metricsCollector)
+	uowFactory := initUnitOfWorkFactory(deps.DB, productStm, outboxStm, metricsCollector, appConfig.Tenancy.RowLevelSecurity)
 
 	publisher, outboxWorker, err := initMessaging(appConfig, logger, outboxRepo, metricsCollector)
 	if err != nil {
 		return nil, err
 	}
 
+	idempotencyStore := initIdempotencyStore(deps.DB, productStm)
+
+	purgeWorker := initRetention(appConfig, logger, productRepo, idempotencyStore, metricsCollector)
+
 	transactionalEventPublisher := messaging.NewTransactionalEventPublisher(publisher)
 
 	handlerLogger := initLoggerAdapters(logger)
@@ -84,23 +99,97 @@ func Bootstrap() (*App, error) {
 		metricsCollector,
 	)
 
-	productUseCase := initUseCase(
+	authorizer, err := initAuthorizer(appConfig, handlerLogger, metricsCollector)
+	if err != nil {
+		return nil, err
+	}
+
+	productUseCase := usecase.NewAuthorizedProductUseCase(initUseCase(
 		productRepo,
 		appService,
 		domainService,
 		handlerLogger,
-	)
+	), authorizer)
+
+	inventoryUseCase := usecase.NewAuthorizedInventoryUseCase(initInventoryUseCase(
+		productRepo,
+		initInventoryRepository(deps.DB, productStm),
+		appService,
+		handlerLogger,
+	), authorizer)
+
+	categoryUseCase := usecase.NewAuthorizedCategoryUseCase(initCategoryUseCase(
+		initCategoryRepository(deps.DB, productStm),
+		productRepo,
+		appService,
+		handlerLogger,
+	), authorizer)
+
+	historyUseCase := usecase.NewAuthorizedProductHistoryUseCase(initProductHistoryUseCase(
+		initProductHistoryRepository(deps.DB, productStm),
+		productRepo,
+		handlerLogger,
+	), authorizer)
+
+	apiKeyUseCase := usecase.NewAuthorizedAPIKeyUseCase(initAPIKeyUseCase(
+		initAPIKeyRepository(deps.DB, productStm),
+		handlerLogger,
+	), authorizer)
 
-	productHandler := initHandlers(productUseCase, handlerLogger, metricsCollector, appConfig)
+	outboxAdminUseCase := usecase.NewAuthorizedOutboxAdminUseCase(initOutboxAdminUseCase(
+		initOutboxAdminRepository(deps.DB, outboxStm),
+		handlerLogger,
+	), authorizer)
 
-	healthChecker, rateLimiter := initMiddleware(deps.DB, publisher, handlerLogger, metricsCollector)
+	reservationSweeper := initReservationSweeper(appConfig, logger, appService, metricsCollector)
+
+	errorMapper := initErrorMapper(handlerLogger)
+
+	productHandler := initHandlers(productUseCase, inventoryUseCase, categoryUseCase, historyUseCase, apiKeyUseCase, outboxAdminUseCase, errorMapper, handlerLogger, metricsCollector, appConfig)
+
+	graphqlHandler, err := initGraphQLHandler(productUseCase, errorMapper, handlerLogger, metricsCollector, appConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	healthChecker, rateLimiter := initMiddleware(deps.DB, publisher, errorMapper, handlerLogger, metricsCollector)
+
+	apiKeyAuth := initAPIKeyAuth(apiKeyUseCase, errorMapper, handlerLogger)
+
+	authenticator := initAuthenticator(tokenVerifier, appConfig, errorMapper, handlerLogger)
+
+	authorization := initAuthorization(authorizer, errorMapper)
+
+	idempotency := initIdempotency(idempotencyStore, appConfig, errorMapper, handlerLogger)
+
+	openAPIValidator, err := initOpenAPIValidator(appConfig, errorMapper, handlerLogger)
+	if err != nil {
+		return nil, err
+	}
 
 	tracerProvider := initTracing(appConfig, logger)
 
 	router, httpServer := initRouter(
 		productHandler,
+		graphqlHandler,
 		healthChecker,
 		rateLimiter,
+		apiKeyAuth,
+		authenticator,
+		authorization,
+		idempotency,
+		openAPIValidator,
+		errorMapper,
+		metricsCollector,
+		tracerProvider,
+		handlerLogger,
+		appConfig,
+	)
+
+	grpcServer := initGRPCServer(
+		productUseCase,
+		apiKeyUseCase,
+		tokenVerifier,
 		metricsCollector,
 		tracerProvider,
 		handlerLogger,
@@ -113,7 +202,10 @@ func Bootstrap() (*App, error) {
 		DB:            deps,
 		Router:        router,
 		HTTPServer:    httpServer,
+		GRPCServer:    grpcServer,
 		OutboxWorker:  outboxWorker,
+		PurgeWorker:   purgeWorker,
+		ReservationSweeper: reservationSweeper,
 		Publisher:     publisher,
 		ProductStm:    productStm,
 		OutboxStm:     outboxStm,
@@ -125,6 +217,12 @@ func Bootstrap() (*App, error) {
 
 func (a *App) Start() error {
 	a.Logger.Info("Starting Products service", zap.String("port", a.Config.Server.Port))
+	if a.GRPCServer != nil {
+		if err := a.GRPCServer.Start(); err != nil {
+			return err
+		}
+		a.Logger.Info("gRPC server listening", zap.String("port", a.Config.GRPC.Port))
+	}
 	if a.HTTPServer != nil {
 		return a.HTTPServer.ListenAndServe()
 	}
@@ -146,11 +244,28 @@ func (a *App) Shutdown(ctx context.Context) error {
 		a.RateLimiter.Stop()
 	}
 
+	if a.GRPCServer != nil {
+		a.Logger.Info("Stopping gRPC server...")
+		if err := a.GRPCServer.Shutdown(ctx); err != nil {
+			a.Logger.Error("gRPC server forced to shutdown", zap.Error(err))
+		}
+	}
+
 	a.Logger.Info("Stopping outbox worker...")
 	if a.OutboxWorker != nil {
 		a.OutboxWorker.Stop()
 	}
 
+	if a.PurgeWorker != nil {
+		a.Logger.Info("Stopping purge worker...")
+		a.PurgeWorker.Stop()
+	}
+
+	if a.ReservationSweeper != nil {
+		a.Logger.Info("Stopping reservation sweeper...")
+		a.ReservationSweeper.Stop()
+	}
+
 	if shutdownable, ok := a.ProductUseCase.(usecase.Shutdownable); ok {
 		if err := shutdownable.Shutdown(ctx); err != nil {
 			a.Logger.Error("Failed to shutdown use case gracefully", zap.Error(err))
@@ -0,0 +1,55 @@
+package bootstrap
+
+import (
+	"product_service/products/internal/config"
+	"product_service/products/internal/grpcserver"
+	"product_service/products/internal/infrastructure/tracing"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+)
+
+func initGRPCServer(
+	productUseCase usecase.ProductUseCase,
+	apiKeyUseCase usecase.APIKeyUseCase,
+	tokenVerifier ports.TokenVerifier,
+	metricsCollector ports.MetricsCollector,
+	tracerProvider *tracing.TracerProvider,
+	handlerLogger ports.Logger,
+	appConfig *config.AppConfig,
+) *grpcserver.Server {
+	if !appConfig.GRPC.Enabled {
+		return nil
+	}
+
+	errorMapper := grpcserver.NewErrorMapper(handlerLogger)
+
+	var interceptors []grpcserver.Interceptor
+	if tracerProvider != nil {
+		interceptors = append(interceptors, grpcserver.TracingInterceptor())
+	}
+	interceptors = append(interceptors,
+		grpcserver.RequestIDInterceptor(),
+		grpcserver.TenantInterceptor(),
+		grpcserver.LoggingInterceptor(handlerLogger),
+		grpcserver.RecoveryInterceptor(handlerLogger),
+		grpcserver.MetricsInterceptor(metricsCollector),
+		grpcserver.NewAuthInterceptor(
+			tokenVerifier,
+			apiKeyUseCase,
+			errorMapper,
+			appConfig.Auth.TenantClaim,
+			appConfig.Auth.RolesClaim,
+			handlerLogger,
+		),
+	)
+
+	productService := grpcserver.NewProductService(
+		productUseCase,
+		errorMapper,
+		handlerLogger,
+		metricsCollector,
+		appConfig.Server.RequestTimeout,
+	)
+
+	return grpcserver.NewServer(":"+appConfig.GRPC.Port, appConfig.GRPC.Reflection, productService, handlerLogger, interceptors...)
+}
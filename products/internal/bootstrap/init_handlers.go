@@ -1,7 +1,10 @@
 package bootstrap
 
 import (
+	"fmt"
+
 	"product_service/products/internal/config"
+	"product_service/products/internal/graphqlapi"
 	"product_service/products/internal/handler"
 	"product_service/products/internal/infrastructure/logging"
 	"product_service/products/internal/usecase"
@@ -12,19 +15,63 @@ import (
 
 func initHandlers(
 	productUseCase usecase.ProductUseCase,
+	inventoryUseCase usecase.InventoryUseCase,
+	categoryUseCase usecase.CategoryUseCase,
+	historyUseCase usecase.ProductHistoryUseCase,
+	apiKeyUseCase usecase.APIKeyUseCase,
+	outboxAdminUseCase usecase.OutboxAdminUseCase,
+	errorMapper *handler.ErrorMapper,
 	handlerLogger ports.Logger,
 	metricsCollector ports.MetricsCollector,
 	appConfig *config.AppConfig,
 ) *handler.GinProductHandler {
 	return handler.NewGinProductHandler(
 		productUseCase,
+		inventoryUseCase,
+		categoryUseCase,
+		historyUseCase,
+		apiKeyUseCase,
+		outboxAdminUseCase,
 		handlerLogger,
 		metricsCollector,
+		errorMapper,
 		appConfig.Server.RequestTimeout,
 		appConfig.Server.ReadTimeout,
 	)
 }
 
+func initGraphQLHandler(
+	productUseCase usecase.ProductUseCase,
+	errorMapper *handler.ErrorMapper,
+	handlerLogger ports.Logger,
+	metricsCollector ports.MetricsCollector,
+	appConfig *config.AppConfig,
+) (*graphqlapi.Handler, error) {
+	if !appConfig.GraphQL.Enabled {
+		return nil, nil
+	}
+
+	graphqlHandler, err := graphqlapi.NewHandler(
+		productUseCase,
+		errorMapper,
+		handlerLogger,
+		metricsCollector,
+		graphqlapi.Limits{
+			MaxDepth:      appConfig.GraphQL.MaxDepth,
+			MaxComplexity: appConfig.GraphQL.MaxComplexity,
+		},
+		appConfig.Server.RequestTimeout,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GraphQL schema: %w", err)
+	}
+	return graphqlHandler, nil
+}
+
+func initErrorMapper(handlerLogger ports.Logger) *handler.ErrorMapper {
+	return handler.NewErrorMapper(handlerLogger)
+}
+
 func initLoggerAdapters(logger *zap.Logger) ports.Logger {
 	return logging.NewZapLoggerAdapter(logger)
 }
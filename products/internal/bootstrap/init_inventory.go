@@ -0,0 +1,36 @@
+package bootstrap
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"product_service/products/internal/config"
+	"product_service/products/internal/infrastructure/inventory"
+	"product_service/products/internal/usecase/ports"
+)
+
+func initReservationSweeper(
+	appConfig *config.AppConfig,
+	logger *zap.Logger,
+	appService ports.InventoryApplicationService,
+	metrics ports.MetricsCollector,
+) *inventory.ReservationSweeper {
+	if !appConfig.Inventory.SweepEnabled {
+		return nil
+	}
+
+	sweeper := inventory.NewReservationSweeper(
+		appService,
+		logger,
+		appConfig.Inventory.SweepInterval,
+		appConfig.Inventory.SweepBatchSize,
+		metrics,
+	)
+	sweeper.Start(ports.WithAllTenants(context.Background()))
+
+	logger.Info("Reservation sweeper started",
+		zap.Duration("interval", appConfig.Inventory.SweepInterval),
+	)
+	return sweeper
+}
@@ -31,7 +31,7 @@ func initMessaging(
 		return nil, nil, fmt.Errorf("failed to initialize RabbitMQ publisher: %w", err)
 	}
 
-	workerCtx := context.Background()
+	workerCtx := ports.WithAllTenants(context.Background())
 	outboxWorker := messaging.NewOutboxWorker(
 		outboxRepo,
 		publisher,
@@ -2,15 +2,24 @@ package bootstrap
 
 import (
 	"database/sql"
+	"fmt"
 	"time"
 
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"product_service/products/internal/config"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/infrastructure/auth"
 	"product_service/products/internal/middleware"
+	"product_service/products/internal/usecase"
 	"product_service/products/internal/usecase/ports"
 )
 
 func initMiddleware(
 	db *sql.DB,
 	publisher ports.EventPublisher,
+	errorMapper *handler.ErrorMapper,
 	handlerLogger ports.Logger,
 	_ ports.MetricsCollector,
 ) (*middleware.HealthChecker, *middleware.RateLimiter) {
@@ -20,8 +29,90 @@ func initMiddleware(
 	}
 	healthChecker := middleware.NewHealthChecker(db, publisherHealthChecker, handlerLogger)
 
-	rateLimiter := middleware.NewRateLimiter(100, 1*time.Minute, handlerLogger)
+	rateLimiter := middleware.NewRateLimiter(100, 1*time.Minute, errorMapper, handlerLogger)
 
 	return healthChecker, rateLimiter
 }
 
+func initIdempotency(
+	store ports.IdempotencyStore,
+	appConfig *config.AppConfig,
+	errorMapper *handler.ErrorMapper,
+	handlerLogger ports.Logger,
+) *middleware.Idempotency {
+	return middleware.NewIdempotency(
+		store,
+		appConfig.Idempotency.LockTimeout,
+		appConfig.Idempotency.TTL,
+		errorMapper,
+		handlerLogger,
+	)
+}
+
+func initOpenAPIValidator(appConfig *config.AppConfig, errorMapper *handler.ErrorMapper, handlerLogger ports.Logger) (*middleware.OpenAPIValidator, error) {
+	validateResponses := appConfig.OpenAPI.ValidateResponses || gin.Mode() == gin.TestMode
+	return middleware.NewOpenAPIValidator(validateResponses, errorMapper, handlerLogger)
+}
+
+func initAPIKeyAuth(apiKeyUseCase usecase.APIKeyUseCase, errorMapper *handler.ErrorMapper, handlerLogger ports.Logger) *middleware.APIKeyAuth {
+	return middleware.NewAPIKeyAuth(
+		apiKeyUseCase,
+		errorMapper,
+		handlerLogger,
+	)
+}
+
+func initAuthorizer(appConfig *config.AppConfig, handlerLogger ports.Logger, metricsCollector ports.MetricsCollector) (ports.Authorizer, error) {
+	authorizer, err := auth.NewPolicyAuthorizer(auth.PolicyConfig{
+		Enabled:       appConfig.RBAC.Enabled,
+		Roles:         appConfig.RBAC.Roles,
+		DefaultRole:   appConfig.RBAC.DefaultRole,
+		AnonymousRole: appConfig.RBAC.AnonymousRole,
+	}, handlerLogger, metricsCollector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize authorization policy: %w", err)
+	}
+	return authorizer, nil
+}
+
+func initAuthorization(authorizer ports.Authorizer, errorMapper *handler.ErrorMapper) *middleware.Authorization {
+	return middleware.NewAuthorization(
+		authorizer,
+		errorMapper,
+	)
+}
+
+func initTokenVerifier(appConfig *config.AppConfig, logger *zap.Logger) (ports.TokenVerifier, error) {
+	if !appConfig.Auth.Enabled {
+		logger.Warn("Authentication is disabled; all routes are anonymous")
+		return nil, nil
+	}
+
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{
+		HMACSecret:       appConfig.Auth.HMACSecret,
+		RSAPublicKeyFile: appConfig.Auth.RSAPublicKeyFile,
+		JWKSFile:         appConfig.Auth.JWKSFile,
+		Issuer:           appConfig.Auth.Issuer,
+		Audience:         appConfig.Auth.Audience,
+		Leeway:           appConfig.Auth.Leeway,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize JWT verifier: %w", err)
+	}
+	return verifier, nil
+}
+
+func initAuthenticator(verifier ports.TokenVerifier, appConfig *config.AppConfig, errorMapper *handler.ErrorMapper, handlerLogger ports.Logger) *middleware.Authenticator {
+	if verifier == nil {
+		return nil
+	}
+
+	return middleware.NewAuthenticator(
+		verifier,
+		errorMapper,
+		appConfig.Auth.PublicRoutes,
+		appConfig.Auth.TenantClaim,
+		appConfig.Auth.RolesClaim,
+		handlerLogger,
+	)
+}
@@ -35,7 +35,32 @@ func initUnitOfWorkFactory(
 	productStm *repository.PreparedStatements,
 	outboxStm *repository.PreparedStatements,
 	metrics ports.MetricsCollector,
+	rowLevelSecurity bool,
 ) ports.UoWFactory {
-	return repository.NewUoWFactory(db, productStm, outboxStm, metrics)
+	return repository.NewUoWFactory(db, productStm, outboxStm, metrics, rowLevelSecurity)
 }
 
+
+func initInventoryRepository(db *sql.DB, productStm *repository.PreparedStatements) ports.InventoryRepository {
+	return repository.NewPostgresInventoryRepository(db, productStm)
+}
+
+func initCategoryRepository(db *sql.DB, productStm *repository.PreparedStatements) ports.CategoryRepository {
+	return repository.NewPostgresCategoryRepository(db, productStm)
+}
+
+func initProductHistoryRepository(db *sql.DB, productStm *repository.PreparedStatements) ports.ProductHistoryRepository {
+	return repository.NewPostgresProductHistoryRepository(db, productStm)
+}
+
+func initAPIKeyRepository(db *sql.DB, productStm *repository.PreparedStatements) ports.APIKeyRepository {
+	return repository.NewPostgresAPIKeyRepository(db, productStm)
+}
+
+func initOutboxAdminRepository(db *sql.DB, outboxStm *repository.PreparedStatements) ports.OutboxAdminRepository {
+	return repository.NewPostgresOutboxAdminRepository(db, outboxStm)
+}
+
+func initIdempotencyStore(db *sql.DB, productStm *repository.PreparedStatements) ports.IdempotencyStore {
+	return repository.NewPostgresIdempotencyStore(db, productStm)
+}
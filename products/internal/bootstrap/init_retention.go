@@ -0,0 +1,40 @@
+package bootstrap
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"product_service/products/internal/config"
+	"product_service/products/internal/infrastructure/retention"
+	"product_service/products/internal/usecase/ports"
+)
+
+func initRetention(
+	appConfig *config.AppConfig,
+	logger *zap.Logger,
+	productRepo ports.ProductRepository,
+	idempotencyStore ports.IdempotencyStore,
+	metrics ports.MetricsCollector,
+) *retention.PurgeWorker {
+	if !appConfig.Retention.Enabled {
+		return nil
+	}
+
+	purgeWorker := retention.NewPurgeWorker(
+		productRepo,
+		idempotencyStore,
+		logger,
+		appConfig.Retention.Period,
+		appConfig.Retention.PurgeInterval,
+		appConfig.Retention.BatchSize,
+		metrics,
+	)
+	purgeWorker.Start(ports.WithAllTenants(context.Background()))
+
+	logger.Info("Product purge worker started",
+		zap.Duration("retention", appConfig.Retention.Period),
+		zap.Duration("interval", appConfig.Retention.PurgeInterval),
+	)
+	return purgeWorker
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"product_service/products/internal/config"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/graphqlapi"
 	"product_service/products/internal/handler"
 	"product_service/products/internal/infrastructure/tracing"
 	"product_service/products/internal/middleware"
@@ -15,34 +17,103 @@ import (
 
 func initRouter(
 	productHandler *handler.GinProductHandler,
+	graphqlHandler *graphqlapi.Handler,
 	healthChecker *middleware.HealthChecker,
 	rateLimiter *middleware.RateLimiter,
+	apiKeyAuth *middleware.APIKeyAuth,
+	authenticator *middleware.Authenticator,
+	authorization *middleware.Authorization,
+	idempotency *middleware.Idempotency,
+	openAPIValidator *middleware.OpenAPIValidator,
+	errorMapper *handler.ErrorMapper,
 	metricsCollector ports.MetricsCollector,
 	tracerProvider *tracing.TracerProvider,
 	handlerLogger ports.Logger,
 	appConfig *config.AppConfig,
 ) (*gin.Engine, *http.Server) {
 	router := gin.New()
+	router.HandleMethodNotAllowed = true
+	router.NoRoute(func(c *gin.Context) {
+		errorMapper.WriteError(c.Writer, c.Request.Context(), handler.CodeNotFound, "")
+	})
+	router.NoMethod(func(c *gin.Context) {
+		errorMapper.WriteError(c.Writer, c.Request.Context(), handler.CodeMethodNotAllowed, "")
+	})
 
 	if tracerProvider != nil {
 		router.Use(middleware.TracingMiddleware())
 	}
 
 	router.Use(middleware.RequestIDMiddleware())
+	router.Use(middleware.ActorMiddleware())
+	router.Use(middleware.TenantMiddleware(errorMapper))
 	router.Use(middleware.LoggingMiddleware(handlerLogger))
-	router.Use(middleware.RecoveryMiddleware(handlerLogger))
+	router.Use(middleware.RecoveryMiddleware(errorMapper, handlerLogger))
 	router.Use(middleware.MetricsMiddleware(metricsCollector))
+	router.Use(apiKeyAuth.Middleware())
 	router.Use(rateLimiter.RateLimitMiddleware())
+	if authenticator != nil {
+		router.Use(authenticator.Middleware())
+	}
 
 	router.GET("/health", healthChecker.HealthCheckHandler)
 
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
-	v1 := router.Group("/api/v1")
+	router.GET("/openapi.json", openAPIValidator.SpecHandler)
+	router.GET("/docs", openAPIValidator.DocsHandler)
+
+	idempotent := idempotency.Middleware()
+	read := authorization.Require(domain.PermissionProductsRead)
+	write := authorization.Require(domain.PermissionProductsWrite)
+	remove := authorization.Require(domain.PermissionProductsDelete)
+	manageOutbox := authorization.Require(domain.PermissionOutboxManage)
+	manageKeys := authorization.Require(domain.PermissionAPIKeysManage)
+
+	v1 := router.Group("/api/v1", openAPIValidator.Middleware())
+	{
+		v1.POST("/products", write, idempotent, productHandler.CreateProduct)
+		v1.POST("/products\\:batch", write, idempotent, productHandler.BatchCreateProducts)
+		v1.GET("/products", read, productHandler.GetProducts)
+		v1.GET("/products/search", read, productHandler.SearchProducts)
+		v1.GET("/products/export", read, productHandler.ExportProducts)
+		v1.POST("/products/import", write, productHandler.ImportProducts)
+		v1.GET("/products/:id", read, productHandler.GetProduct)
+		v1.PUT("/products/:id", write, productHandler.UpdateProduct)
+		v1.PATCH("/products/:id", write, productHandler.PatchProduct)
+		v1.DELETE("/products/:id", remove, productHandler.DeleteProduct)
+		v1.POST("/products/:id/restore", write, productHandler.RestoreProduct)
+		v1.POST("/products/:id/transitions", write, productHandler.TransitionProduct)
+		v1.POST("/products/:id/variants", write, idempotent, productHandler.CreateVariant)
+		v1.GET("/products/:id/history", read, productHandler.GetProductHistory)
+		v1.GET("/products/:id/categories", read, productHandler.GetProductCategories)
+		v1.PUT("/products/:id/categories", write, productHandler.SetProductCategories)
+		v1.GET("/products/:id/inventory", read, productHandler.GetInventory)
+		v1.POST("/products/:id/inventory/adjustments", write, productHandler.AdjustStock)
+		v1.POST("/products/:id/reservations", write, productHandler.ReserveStock)
+		v1.POST("/reservations/:id/confirm", write, productHandler.ConfirmReservation)
+		v1.POST("/reservations/:id/release", write, productHandler.ReleaseReservation)
+		v1.POST("/categories", write, idempotent, productHandler.CreateCategory)
+		v1.GET("/categories", read, productHandler.GetCategories)
+		v1.GET("/categories/:id", read, productHandler.GetCategory)
+		v1.PATCH("/categories/:id", write, productHandler.PatchCategory)
+		v1.DELETE("/categories/:id", remove, productHandler.DeleteCategory)
+		v1.POST("/categories/:id/move", write, productHandler.MoveCategory)
+	}
+
+	if graphqlHandler != nil {
+		router.GET("/graphql", read, graphqlHandler.Handle)
+		router.POST("/graphql", read, idempotent, graphqlHandler.Handle)
+	}
+
+	admin := v1.Group("/admin")
 	{
-		v1.POST("/products", productHandler.CreateProduct)
-		v1.GET("/products", productHandler.GetProducts)
-		v1.DELETE("/products/:id", productHandler.DeleteProduct)
+		admin.POST("/api-keys", manageKeys, productHandler.CreateAPIKey)
+		admin.GET("/api-keys", manageKeys, productHandler.GetAPIKeys)
+		admin.PUT("/api-keys/:id/scopes", manageKeys, productHandler.SetAPIKeyScopes)
+		admin.DELETE("/api-keys/:id", manageKeys, productHandler.RevokeAPIKey)
+		admin.GET("/outbox/dead-letters", manageOutbox, productHandler.GetDeadLetters)
+		admin.POST("/outbox/dead-letters/:id/retry", manageOutbox, productHandler.RetryDeadLetter)
 	}
 
 	httpServer := &http.Server{
@@ -0,0 +1,262 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/mock/gomock"
+
+	"product_service/products/api/openapi"
+	"product_service/products/internal/config"
+	"product_service/products/internal/domain"
+	domainServices "product_service/products/internal/domain/services"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/middleware"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+	"product_service/products/mocks"
+)
+
+var ginRouteParam = regexp.MustCompile(`/:([^/]+)`)
+
+type routerTestEnv struct {
+	router     *gin.Engine
+	repo       *mocks.MockProductRepository
+	appService *mocks.MockProductApplicationService
+}
+
+func newRouterTestEnv(t *testing.T) routerTestEnv {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	metrics := mocks.NewMockMetricsCollector(ctrl)
+	metrics.EXPECT().RecordRequestDuration(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	metrics.EXPECT().IncrementRequestCount(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	metrics.EXPECT().IncrementProductsCreated().AnyTimes()
+	authorizer := mocks.NewMockAuthorizer(ctrl)
+	authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	repo := mocks.NewMockProductRepository(ctrl)
+	appService := mocks.NewMockProductApplicationService(ctrl)
+	productUseCase := usecase.NewProductUseCase(repo, appService, domainServices.NewProductDomainService(nil), logger)
+
+	errorMapper := handler.NewErrorMapper(logger)
+	productHandler := handler.NewGinProductHandler(productUseCase, nil, nil, nil, nil, nil, logger, metrics, errorMapper, time.Second, time.Second)
+	validator, err := middleware.NewOpenAPIValidator(true, errorMapper, logger)
+	if err != nil {
+		t.Fatalf("Failed to load the OpenAPI validator: %v", err)
+	}
+	rateLimiter := middleware.NewRateLimiter(1000, time.Minute, errorMapper, logger)
+	t.Cleanup(rateLimiter.Stop)
+
+	router, _ := initRouter(
+		productHandler,
+		nil,
+		middleware.NewHealthChecker(nil, nil, logger),
+		rateLimiter,
+		middleware.NewAPIKeyAuth(nil, errorMapper, logger),
+		nil,
+		middleware.NewAuthorization(authorizer, errorMapper),
+		middleware.NewIdempotency(nil, time.Minute, time.Hour, errorMapper, logger),
+		validator,
+		errorMapper,
+		metrics,
+		nil,
+		logger,
+		&config.AppConfig{Server: config.ServerConfig{Port: "0"}},
+	)
+	return routerTestEnv{router: router, repo: repo, appService: appService}
+}
+
+func (env routerTestEnv) do(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	env.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRouter_EveryAPIRouteHasASpecOperation(t *testing.T) {
+	env := newRouterTestEnv(t)
+	spec, err := openapi.Load()
+	if err != nil {
+		t.Fatalf("Failed to load the OpenAPI spec: %v", err)
+	}
+
+	checked := 0
+	for _, route := range env.router.Routes() {
+		if !strings.HasPrefix(route.Path, "/api/v1/") {
+			continue
+		}
+		checked++
+
+		path := ginRouteParam.ReplaceAllString(strings.ReplaceAll(route.Path, `\:`, ":"), "/{$1}")
+		item := spec.Paths.Value(path)
+		if item == nil || item.GetOperation(route.Method) == nil {
+			t.Errorf("%s %s has no operation in openapi.yaml (looked for %s)", route.Method, route.Path, path)
+		}
+	}
+	if checked == 0 {
+		t.Fatal("Expected the router to register /api/v1 routes")
+	}
+}
+
+func TestRouter_ResponsesMatchTheSpec(t *testing.T) {
+	env := newRouterTestEnv(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	stored, err := domain.NewProduct("Laptop", mustMoney(t, 129900))
+	if err != nil {
+		t.Fatalf("Failed to build product: %v", err)
+	}
+	stored.ID = 7
+	stored.Version = 3
+	stored.CreatedAt = now
+
+	env.repo.EXPECT().GetByID(gomock.Any(), 7, false).Return(stored, nil).AnyTimes()
+	env.repo.EXPECT().GetByID(gomock.Any(), 404, false).Return(nil, domain.ErrProductNotFound).AnyTimes()
+	env.repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(ports.ProductListResult{Products: []domain.Product{*stored}, Total: 1}, nil).AnyTimes()
+	env.appService.EXPECT().
+		CreateProductWithEvent(gomock.Any(), gomock.Any(), "").
+		DoAndReturn(func(ctx context.Context, p *domain.Product, key string) error {
+			p.ID = 8
+			p.Version = 1
+			p.CreatedAt = now
+			return nil
+		}).
+		AnyTimes()
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		wantStatus  int
+		wantProblem bool
+	}{
+		{name: "get product", method: http.MethodGet, path: "/api/v1/products/7", wantStatus: http.StatusOK},
+		{name: "list products", method: http.MethodGet, path: "/api/v1/products?limit=5", wantStatus: http.StatusOK},
+		{name: "list products from a zero price", method: http.MethodGet, path: "/api/v1/products?min_price=0&max_price=10", wantStatus: http.StatusOK},
+		{name: "create product", method: http.MethodPost, path: "/api/v1/products", body: `{"name":"Phone","price":"499.00"}`, wantStatus: http.StatusCreated},
+		{name: "missing product", method: http.MethodGet, path: "/api/v1/products/404", wantStatus: http.StatusNotFound, wantProblem: true},
+		{name: "invalid path parameter", method: http.MethodGet, path: "/api/v1/products/abc", wantStatus: http.StatusBadRequest, wantProblem: true},
+		{name: "invalid body", method: http.MethodPost, path: "/api/v1/products", body: `{"price":"499.00"}`, wantStatus: http.StatusBadRequest, wantProblem: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := env.do(tt.method, tt.path, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			contentType := rec.Header().Get("Content-Type")
+			if tt.wantProblem != strings.HasPrefix(contentType, "application/problem+json") {
+				t.Errorf("Content-Type = %q, problem expected: %v", contentType, tt.wantProblem)
+			}
+			if !json.Valid(rec.Body.Bytes()) {
+				t.Errorf("Expected a JSON body, got %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestRouter_ListReturnsTheEffectiveLimit(t *testing.T) {
+	env := newRouterTestEnv(t)
+	env.repo.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query ports.ProductListQuery) (ports.ProductListResult, error) {
+			if query.Limit != 100 {
+				t.Errorf("Expected the repository to be asked for 100 products, got %d", query.Limit)
+			}
+			return ports.ProductListResult{Total: 0}, nil
+		})
+
+	rec := env.do(http.MethodGet, "/api/v1/products?limit=500", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d (body %s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var body struct {
+		Page  int `json:"page"`
+		Limit int `json:"limit"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if body.Page != 1 || body.Limit != 100 {
+		t.Errorf("Expected page 1 and limit 100, got page %d and limit %d", body.Page, body.Limit)
+	}
+}
+
+func TestRouter_MalformedIfMatchIsABadRequest(t *testing.T) {
+	env := newRouterTestEnv(t)
+
+	for _, ifMatch := range []string{`"abc"`, `"0"`, `W/"-3"`} {
+		t.Run(ifMatch, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/products/7", nil)
+			req.Header.Set("If-Match", ifMatch)
+			rec := httptest.NewRecorder()
+			env.router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, http.StatusBadRequest, rec.Body.String())
+			}
+			var problem handler.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("decode problem: %v", err)
+			}
+			if problem.Code != handler.CodeInvalidIfMatch {
+				t.Errorf("code = %s, want %s", problem.Code, handler.CodeInvalidIfMatch)
+			}
+		})
+	}
+}
+
+func TestRouter_UnknownCollectionActionIsNotRouted(t *testing.T) {
+	env := newRouterTestEnv(t)
+
+	for _, path := range []string{"/api/v1/productsXYZ", "/api/v1/products:import"} {
+		t.Run(path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"products":[]}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Idempotency-Key", "unknown-action")
+			rec := httptest.NewRecorder()
+			env.router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusNotFound {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, http.StatusNotFound, rec.Body.String())
+			}
+			var problem handler.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("decode problem: %v", err)
+			}
+			if problem.Code != handler.CodeNotFound {
+				t.Errorf("code = %s, want %s", problem.Code, handler.CodeNotFound)
+			}
+		})
+	}
+}
+
+func mustMoney(t *testing.T, minorUnits int64) domain.Money {
+	t.Helper()
+	money, err := domain.NewMoney(minorUnits, "USD")
+	if err != nil {
+		t.Fatalf("Failed to build money: %v", err)
+	}
+	return money
+}
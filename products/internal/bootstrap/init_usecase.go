@@ -41,3 +41,64 @@ func initUseCase(
 	)
 }
 
+
+func initInventoryUseCase(
+	productRepo ports.ProductRepository,
+	inventoryRepo ports.InventoryRepository,
+	appService ports.InventoryApplicationService,
+	logger ports.Logger,
+) usecase.InventoryUseCase {
+	return usecase.NewInventoryUseCase(
+		productRepo,
+		inventoryRepo,
+		appService,
+		logger,
+	)
+}
+
+func initProductHistoryUseCase(
+	historyRepo ports.ProductHistoryRepository,
+	productRepo ports.ProductRepository,
+	logger ports.Logger,
+) usecase.ProductHistoryUseCase {
+	return usecase.NewProductHistoryUseCase(
+		historyRepo,
+		productRepo,
+		logger,
+	)
+}
+
+func initAPIKeyUseCase(
+	apiKeyRepo ports.APIKeyRepository,
+	logger ports.Logger,
+) usecase.APIKeyUseCase {
+	return usecase.NewAPIKeyUseCase(
+		apiKeyRepo,
+		logger,
+	)
+}
+
+func initOutboxAdminUseCase(
+	outboxRepo ports.OutboxAdminRepository,
+	logger ports.Logger,
+) usecase.OutboxAdminUseCase {
+	return usecase.NewOutboxAdminUseCase(
+		outboxRepo,
+		logger,
+	)
+}
+
+func initCategoryUseCase(
+	categoryRepo ports.CategoryRepository,
+	productRepo ports.ProductRepository,
+	appService ports.CategoryApplicationService,
+	logger ports.Logger,
+) usecase.CategoryUseCase {
+	return usecase.NewCategoryUseCase(
+		categoryRepo,
+		productRepo,
+		appService,
+		services.NewCategoryDomainService(),
+		logger,
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -13,8 +14,17 @@ type AppConfig struct {
 	Database    DatabaseConfig
 	RabbitMQ    RabbitMQConfig
 	Server      ServerConfig
+	GRPC        GRPCConfig
+	GraphQL     GraphQLConfig
+	OpenAPI     OpenAPIConfig
 	Tracing     TracingConfig
 	Outbox      OutboxConfig
+	Retention   RetentionConfig
+	Inventory   InventoryConfig
+	Idempotency IdempotencyConfig
+	Tenancy     TenancyConfig
+	Auth        AuthConfig
+	RBAC        RBACConfig
 }
 
 type DatabaseConfig struct {
@@ -53,6 +63,22 @@ type ServerConfig struct {
 	ShutdownTimeout        time.Duration
 }
 
+type GRPCConfig struct {
+	Enabled    bool
+	Port       string
+	Reflection bool
+}
+
+type GraphQLConfig struct {
+	Enabled       bool
+	MaxDepth      int
+	MaxComplexity int
+}
+
+type OpenAPIConfig struct {
+	ValidateResponses bool
+}
+
 type TracingConfig struct {
 	Enabled       bool
 	OTLPEndpoint  string
@@ -69,6 +95,48 @@ type OutboxConfig struct {
 	Concurrency   int
 }
 
+type RetentionConfig struct {
+	Enabled       bool
+	Period        time.Duration
+	PurgeInterval time.Duration
+	BatchSize     int
+}
+
+type InventoryConfig struct {
+	SweepEnabled   bool
+	SweepInterval  time.Duration
+	SweepBatchSize int
+}
+
+type IdempotencyConfig struct {
+	TTL         time.Duration
+	LockTimeout time.Duration
+}
+
+type TenancyConfig struct {
+	RowLevelSecurity bool
+}
+
+type AuthConfig struct {
+	Enabled          bool
+	HMACSecret       string
+	RSAPublicKeyFile string
+	JWKSFile         string
+	Issuer           string
+	Audience         string
+	Leeway           time.Duration
+	TenantClaim      string
+	RolesClaim       string
+	PublicRoutes     []string
+}
+
+type RBACConfig struct {
+	Enabled       bool
+	Roles         map[string][]string
+	DefaultRole   string
+	AnonymousRole string
+}
+
 func LoadAppConfig() (*AppConfig, error) {
 	_ = godotenv.Load()
 
@@ -97,6 +165,19 @@ func LoadAppConfig() (*AppConfig, error) {
 			ReadTimeout:     getEnvAsDuration("READ_TIMEOUT", 5*time.Second),
 			ShutdownTimeout: getEnvAsDuration("SHUTDOWN_TIMEOUT", 15*time.Second),
 		},
+		GRPC: GRPCConfig{
+			Enabled:    getEnvAsBool("GRPC_ENABLED", true),
+			Port:       getEnv("GRPC_PORT", "50051"),
+			Reflection: getEnvAsBool("GRPC_REFLECTION_ENABLED", false),
+		},
+		GraphQL: GraphQLConfig{
+			Enabled:       getEnvAsBool("GRAPHQL_ENABLED", true),
+			MaxDepth:      getEnvAsInt("GRAPHQL_MAX_DEPTH", 8),
+			MaxComplexity: getEnvAsInt("GRAPHQL_MAX_COMPLEXITY", 500),
+		},
+		OpenAPI: OpenAPIConfig{
+			ValidateResponses: getEnvAsBool("OPENAPI_VALIDATE_RESPONSES", false),
+		},
 		Tracing: TracingConfig{
 			Enabled:      getEnvAsBool("TRACING_ENABLED", false),
 			OTLPEndpoint: getEnv("OTLP_ENDPOINT", "localhost:4318"),
@@ -111,6 +192,46 @@ func LoadAppConfig() (*AppConfig, error) {
 			MaxBackoff:   getEnvAsDuration("OUTBOX_MAX_BACKOFF", 30*time.Second),
 			Concurrency:  getEnvAsInt("OUTBOX_CONCURRENCY", 3),
 		},
+		Retention: RetentionConfig{
+			Enabled:       getEnvAsBool("PRODUCT_PURGE_ENABLED", true),
+			Period:        getEnvAsDuration("PRODUCT_RETENTION_PERIOD", 30*24*time.Hour),
+			PurgeInterval: getEnvAsDuration("PRODUCT_PURGE_INTERVAL", 1*time.Hour),
+			BatchSize:     getEnvAsInt("PRODUCT_PURGE_BATCH_SIZE", 500),
+		},
+		Inventory: InventoryConfig{
+			SweepEnabled:   getEnvAsBool("RESERVATION_SWEEP_ENABLED", true),
+			SweepInterval:  getEnvAsDuration("RESERVATION_SWEEP_INTERVAL", 30*time.Second),
+			SweepBatchSize: getEnvAsInt("RESERVATION_SWEEP_BATCH_SIZE", 100),
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:         getEnvAsDuration("IDEMPOTENCY_KEY_TTL", 24*time.Hour),
+			LockTimeout: getEnvAsDuration("IDEMPOTENCY_LOCK_TIMEOUT", 1*time.Minute),
+		},
+		Tenancy: TenancyConfig{
+			RowLevelSecurity: getEnvAsBool("TENANT_RLS_ENABLED", false),
+		},
+		Auth: AuthConfig{
+			Enabled:          getEnvAsBool("AUTH_ENABLED", false),
+			HMACSecret:       getEnv("JWT_HS256_SECRET", ""),
+			RSAPublicKeyFile: getEnv("JWT_RS256_PUBLIC_KEY_FILE", ""),
+			JWKSFile:         getEnv("JWT_JWKS_FILE", ""),
+			Issuer:           getEnv("JWT_ISSUER", ""),
+			Audience:         getEnv("JWT_AUDIENCE", ""),
+			Leeway:           getEnvAsDuration("JWT_LEEWAY", 30*time.Second),
+			TenantClaim:      getEnv("JWT_TENANT_CLAIM", "tenant_id"),
+			RolesClaim:       getEnv("JWT_ROLES_CLAIM", "roles"),
+			PublicRoutes:     getEnvAsList("AUTH_PUBLIC_ROUTES", []string{"/health", "/metrics", "/openapi.json", "/docs"}),
+		},
+		RBAC: RBACConfig{
+			Enabled: getEnvAsBool("RBAC_ENABLED", false),
+			Roles: getEnvAsRoles("RBAC_ROLES", map[string][]string{
+				"admin":  {"*"},
+				"editor": {"products:read", "products:write"},
+				"viewer": {"products:read"},
+			}),
+			DefaultRole:   getEnv("RBAC_DEFAULT_ROLE", "viewer"),
+			AnonymousRole: getEnv("RBAC_ANONYMOUS_ROLE", "anonymous"),
+		},
 	}, nil
 }
 
@@ -139,6 +260,43 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+func getEnvAsList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var values []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+func getEnvAsRoles(key string, defaultValue map[string][]string) map[string][]string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	roles := make(map[string][]string)
+	for _, entry := range strings.Split(value, ";") {
+		role, permissions, _ := strings.Cut(entry, "=")
+		if role = strings.TrimSpace(role); role == "" {
+			continue
+		}
+		roles[role] = nil
+		for _, permission := range strings.Split(permissions, ",") {
+			if permission = strings.TrimSpace(permission); permission != "" {
+				roles[role] = append(roles[role], permission)
+			}
+		}
+	}
+	return roles
+}
+
 func getEnvAsBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		if boolValue, err := strconv.ParseBool(value); err == nil {
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrAPIKeyNotFound      = errors.New("api key not found")
+	ErrInvalidAPIKey       = errors.New("invalid api key")
+	ErrInvalidAPIKeyScope  = errors.New("invalid api key scope")
+	ErrInsufficientScope   = errors.New("insufficient scope")
+	ErrInvalidAPIKeyConfig = errors.New("invalid api key settings")
+)
+
+type APIKeyScope string
+
+const (
+	ScopeProductsRead  APIKeyScope = "products:read"
+	ScopeProductsWrite APIKeyScope = "products:write"
+	ScopeOutboxAdmin   APIKeyScope = "outbox:admin"
+)
+
+const (
+	APIKeyPrefix          = "pk_"
+	MaxAPIKeyNameLength   = 255
+	MaxAPIKeyRateLimit    = 100000
+	APIKeyLastUsedGranule = time.Minute
+
+	apiKeyIDBytes     = 4
+	apiKeySecretBytes = 32
+)
+
+func (s APIKeyScope) String() string {
+	return string(s)
+}
+
+func ParseAPIKeyScope(value string) (APIKeyScope, error) {
+	scope := APIKeyScope(strings.TrimSpace(value))
+	switch scope {
+	case ScopeProductsRead, ScopeProductsWrite, ScopeOutboxAdmin:
+		return scope, nil
+	default:
+		return "", fmt.Errorf("unknown scope %q: %w", value, ErrInvalidAPIKeyScope)
+	}
+}
+
+func (s APIKeyScope) Grants(permission Permission) bool {
+	switch s {
+	case ScopeProductsRead:
+		return permission == PermissionProductsRead
+	case ScopeProductsWrite:
+		return permission == PermissionProductsWrite || permission == PermissionProductsDelete
+	case ScopeOutboxAdmin:
+		return permission == PermissionOutboxManage
+	default:
+		return false
+	}
+}
+
+func ParseAPIKeyScopes(values []string) ([]APIKeyScope, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("at least one scope is required: %w", ErrInvalidAPIKeyScope)
+	}
+
+	scopes := make([]APIKeyScope, 0, len(values))
+	for _, value := range values {
+		scope, err := ParseAPIKeyScope(value)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	slices.Sort(scopes)
+	return scopes, nil
+}
+
+type APIKey struct {
+	ID         int64
+	TenantID   string
+	Name       string
+	Prefix     string
+	Hash       string
+	Scopes     []APIKeyScope
+	RateLimit  int
+	CreatedBy  string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func NewAPIKey(name string, scopes []APIKeyScope, rateLimit int) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxAPIKeyNameLength {
+		return nil, "", fmt.Errorf("name must be between 1 and %d characters: %w", MaxAPIKeyNameLength, ErrInvalidAPIKeyConfig)
+	}
+	if len(scopes) == 0 {
+		return nil, "", fmt.Errorf("at least one scope is required: %w", ErrInvalidAPIKeyScope)
+	}
+	if rateLimit < 0 || rateLimit > MaxAPIKeyRateLimit {
+		return nil, "", fmt.Errorf("rate limit must be between 0 and %d: %w", MaxAPIKeyRateLimit, ErrInvalidAPIKeyConfig)
+	}
+
+	id := make([]byte, apiKeyIDBytes)
+	secret := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(id); err != nil {
+		return nil, "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	prefix := APIKeyPrefix + hex.EncodeToString(id)
+	plaintext := prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+
+	return &APIKey{
+		Name:      name,
+		Prefix:    prefix,
+		Hash:      HashAPIKey(plaintext),
+		Scopes:    scopes,
+		RateLimit: rateLimit,
+	}, plaintext, nil
+}
+
+func HashAPIKey(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+func (k *APIKey) Active() bool {
+	return k.RevokedAt == nil
+}
+
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+func (k *APIKey) NeedsLastUsedUpdate(now time.Time) bool {
+	return k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= APIKeyLastUsedGranule
+}
+
+func (k *APIKey) Grants(permission Permission) bool {
+	for _, scope := range k.Scopes {
+		if scope.Grants(permission) {
+			return true
+		}
+	}
+	return false
+}
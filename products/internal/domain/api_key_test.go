@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAPIKeyScopes(t *testing.T) {
+	scopes, err := ParseAPIKeyScopes([]string{"products:write", " products:read", "products:write"})
+	if err != nil {
+		t.Fatalf("ParseAPIKeyScopes() error = %v", err)
+	}
+	if len(scopes) != 2 || scopes[0] != ScopeProductsRead || scopes[1] != ScopeProductsWrite {
+		t.Errorf("ParseAPIKeyScopes() = %v, want sorted unique scopes", scopes)
+	}
+
+	if _, err := ParseAPIKeyScopes([]string{"products:delete"}); !errors.Is(err, ErrInvalidAPIKeyScope) {
+		t.Errorf("ParseAPIKeyScopes() error = %v, want ErrInvalidAPIKeyScope", err)
+	}
+	if _, err := ParseAPIKeyScopes(nil); !errors.Is(err, ErrInvalidAPIKeyScope) {
+		t.Errorf("ParseAPIKeyScopes(nil) error = %v, want ErrInvalidAPIKeyScope", err)
+	}
+}
+
+func TestNewAPIKey(t *testing.T) {
+	key, plaintext, err := NewAPIKey(" nightly export ", []APIKeyScope{ScopeProductsRead}, 500)
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	if key.Name != "nightly export" || key.RateLimit != 500 {
+		t.Errorf("NewAPIKey() = %+v", key)
+	}
+	if !strings.HasPrefix(plaintext, key.Prefix+"_") || !strings.HasPrefix(key.Prefix, APIKeyPrefix) {
+		t.Errorf("plaintext %q does not start with prefix %q", plaintext, key.Prefix)
+	}
+	if key.Hash != HashAPIKey(plaintext) || strings.Contains(key.Hash, plaintext) {
+		t.Errorf("NewAPIKey() stored hash %q does not match plaintext", key.Hash)
+	}
+	if !key.HasScope(ScopeProductsRead) || key.HasScope(ScopeOutboxAdmin) {
+		t.Errorf("HasScope() mismatch for scopes %v", key.Scopes)
+	}
+
+	if _, _, err := NewAPIKey("", []APIKeyScope{ScopeProductsRead}, 0); !errors.Is(err, ErrInvalidAPIKeyConfig) {
+		t.Errorf("NewAPIKey() with empty name error = %v, want ErrInvalidAPIKeyConfig", err)
+	}
+	if _, _, err := NewAPIKey("job", []APIKeyScope{ScopeProductsRead}, -1); !errors.Is(err, ErrInvalidAPIKeyConfig) {
+		t.Errorf("NewAPIKey() with negative rate limit error = %v, want ErrInvalidAPIKeyConfig", err)
+	}
+}
+
+func TestAPIKey_NeedsLastUsedUpdate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	recent := now.Add(-10 * time.Second)
+	stale := now.Add(-2 * APIKeyLastUsedGranule)
+
+	if !(&APIKey{}).NeedsLastUsedUpdate(now) {
+		t.Error("expected never-used key to need an update")
+	}
+	if (&APIKey{LastUsedAt: &recent}).NeedsLastUsedUpdate(now) {
+		t.Error("expected recently used key to skip the update")
+	}
+	if !(&APIKey{LastUsedAt: &stale}).NeedsLastUsedUpdate(now) {
+		t.Error("expected stale key to need an update")
+	}
+}
@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+var (
+	ErrInvalidAttribute     = errors.New("invalid product attribute")
+	ErrInvalidVariantParent = errors.New("invalid variant parent")
+	ErrProductHasVariants   = errors.New("product has active variants")
+)
+
+type AttributeType string
+
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeNumber  AttributeType = "number"
+	AttributeTypeBoolean AttributeType = "boolean"
+)
+
+var attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+var wellKnownAttributes = map[string]AttributeType{
+	"color":  AttributeTypeString,
+	"size":   AttributeTypeString,
+	"weight": AttributeTypeNumber,
+}
+
+func WellKnownAttributeType(key string) (AttributeType, bool) {
+	attributeType, ok := wellKnownAttributes[key]
+	return attributeType, ok
+}
+
+type AttributeValue struct {
+	kind   AttributeType
+	text   string
+	number float64
+	flag   bool
+}
+
+func StringAttribute(value string) AttributeValue {
+	return AttributeValue{kind: AttributeTypeString, text: value}
+}
+
+func NumberAttribute(value float64) AttributeValue {
+	return AttributeValue{kind: AttributeTypeNumber, number: value}
+}
+
+func BooleanAttribute(value bool) AttributeValue {
+	return AttributeValue{kind: AttributeTypeBoolean, flag: value}
+}
+
+func NewAttributeValue(raw interface{}) (AttributeValue, error) {
+	switch v := raw.(type) {
+	case string:
+		return StringAttribute(v), nil
+	case bool:
+		return BooleanAttribute(v), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return AttributeValue{}, fmt.Errorf("%w: number must be finite", ErrInvalidAttribute)
+		}
+		return NumberAttribute(v), nil
+	case json.Number:
+		number, err := v.Float64()
+		if err != nil {
+			return AttributeValue{}, fmt.Errorf("%w: malformed number %q", ErrInvalidAttribute, v.String())
+		}
+		return NumberAttribute(number), nil
+	case int:
+		return NumberAttribute(float64(v)), nil
+	default:
+		return AttributeValue{}, fmt.Errorf("%w: values must be strings, numbers or booleans", ErrInvalidAttribute)
+	}
+}
+
+func ParseAttributeValue(raw string, attributeType AttributeType) (AttributeValue, error) {
+	switch attributeType {
+	case AttributeTypeNumber:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return AttributeValue{}, fmt.Errorf("%w: %q is not a number", ErrInvalidAttribute, raw)
+		}
+		return NumberAttribute(number), nil
+	case AttributeTypeBoolean:
+		flag, err := strconv.ParseBool(raw)
+		if err != nil {
+			return AttributeValue{}, fmt.Errorf("%w: %q is not a boolean", ErrInvalidAttribute, raw)
+		}
+		return BooleanAttribute(flag), nil
+	default:
+		return StringAttribute(raw), nil
+	}
+}
+
+func (v AttributeValue) Type() AttributeType {
+	return v.kind
+}
+
+func (v AttributeValue) Text() string {
+	return v.text
+}
+
+func (v AttributeValue) Number() float64 {
+	return v.number
+}
+
+func (v AttributeValue) Bool() bool {
+	return v.flag
+}
+
+func (v AttributeValue) Interface() interface{} {
+	switch v.kind {
+	case AttributeTypeNumber:
+		return v.number
+	case AttributeTypeBoolean:
+		return v.flag
+	default:
+		return v.text
+	}
+}
+
+func (v AttributeValue) String() string {
+	switch v.kind {
+	case AttributeTypeNumber:
+		return strconv.FormatFloat(v.number, 'f', -1, 64)
+	case AttributeTypeBoolean:
+		return strconv.FormatBool(v.flag)
+	default:
+		return v.text
+	}
+}
+
+func (v AttributeValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Interface())
+}
+
+func (v *AttributeValue) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	value, err := NewAttributeValue(raw)
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
+
+type Attributes map[string]AttributeValue
+
+func ValidateAttributeKey(key string) error {
+	if !attributeKeyPattern.MatchString(key) {
+		return fmt.Errorf("%w: key %q must be lowercase snake_case of at most 64 characters", ErrInvalidAttribute, key)
+	}
+	return nil
+}
+
+func NewAttributes(values map[string]interface{}) (Attributes, error) {
+	attributes := make(Attributes, len(values))
+	for key, raw := range values {
+		if err := ValidateAttributeKey(key); err != nil {
+			return nil, err
+		}
+		value, err := NewAttributeValue(raw)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", key, err)
+		}
+		attributes[key] = value
+	}
+	return attributes, nil
+}
+
+func (a Attributes) Merge(overrides Attributes) Attributes {
+	merged := make(Attributes, len(a)+len(overrides))
+	maps.Copy(merged, a)
+	maps.Copy(merged, overrides)
+	return merged
+}
+
+func (a Attributes) Equal(other Attributes) bool {
+	return maps.Equal(a, other)
+}
+
+func (a Attributes) Values() map[string]interface{} {
+	if len(a) == 0 {
+		return nil
+	}
+	values := make(map[string]interface{}, len(a))
+	for key, value := range a {
+		values[key] = value.Interface()
+	}
+	return values
+}
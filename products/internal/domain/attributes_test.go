@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNewAttributes(t *testing.T) {
+	attributes, err := NewAttributes(map[string]interface{}{
+		"color":     "red",
+		"weight":    json.Number("1.25"),
+		"organic":   true,
+		"pack_size": float64(6),
+	})
+	if err != nil {
+		t.Fatalf("NewAttributes() error = %v", err)
+	}
+
+	if attributes["color"].Type() != AttributeTypeString || attributes["color"].Text() != "red" {
+		t.Errorf("color = %v, want string red", attributes["color"])
+	}
+	if attributes["weight"].Type() != AttributeTypeNumber || attributes["weight"].Number() != 1.25 {
+		t.Errorf("weight = %v, want number 1.25", attributes["weight"])
+	}
+	if attributes["organic"].Type() != AttributeTypeBoolean || !attributes["organic"].Bool() {
+		t.Errorf("organic = %v, want boolean true", attributes["organic"])
+	}
+
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded Attributes
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !decoded.Equal(attributes) {
+		t.Errorf("round trip = %v, want %v", decoded.Values(), attributes.Values())
+	}
+}
+
+func TestNewAttributes_Invalid(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"uppercase key": {"Color": "red"},
+		"nested object": {"dimensions": map[string]interface{}{"w": 1}},
+		"array value":   {"tags": []interface{}{"a"}},
+		"null value":    {"color": nil},
+	}
+
+	for name, values := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewAttributes(values); !errors.Is(err, ErrInvalidAttribute) {
+				t.Errorf("NewAttributes() error = %v, want ErrInvalidAttribute", err)
+			}
+		})
+	}
+}
+
+func TestProduct_NewVariant(t *testing.T) {
+	price, _ := ParseMoney("20.00", DefaultCurrency)
+	parent, _ := NewProduct("Shirt", price)
+	parent.ID = 3
+	parent.Attributes = Attributes{"color": StringAttribute("blue"), "size": StringAttribute("M")}
+
+	variant, err := parent.NewVariant("Shirt (L)", price, Attributes{"size": StringAttribute("L")})
+	if err != nil {
+		t.Fatalf("NewVariant() error = %v", err)
+	}
+
+	effective := variant.EffectiveAttributes()
+	if effective["color"].Text() != "blue" || effective["size"].Text() != "L" {
+		t.Errorf("effective attributes = %v, want color=blue size=L", effective.Values())
+	}
+
+	if _, err := variant.NewVariant("Shirt (L, slim)", price, nil); !errors.Is(err, ErrInvalidVariantParent) {
+		t.Errorf("NewVariant() on variant error = %v, want ErrInvalidVariantParent", err)
+	}
+}
+
+func TestProduct_Update_Attributes(t *testing.T) {
+	price, _ := ParseMoney("20.00", DefaultCurrency)
+	product, _ := NewProduct("Shirt", price)
+	product.ID = 1
+
+	if err := product.Update("Shirt", price, Attributes{"color": StringAttribute("red")}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	events := product.DomainEvents()
+	if len(events) != 1 {
+		t.Fatalf("events = %d, want 1", len(events))
+	}
+	updated := events[0].(ProductUpdatedEvent)
+	if _, ok := updated.Changes["attributes"]; !ok {
+		t.Errorf("changes = %v, want attributes change", updated.Changes)
+	}
+	if updated.Details.Attributes["color"] != "red" {
+		t.Errorf("event attributes = %v, want color=red", updated.Details.Attributes)
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrInvalidToken    = errors.New("invalid token")
+	ErrTokenExpired    = errors.New("token expired")
+)
+
+type Principal struct {
+	Subject string
+	Roles   []string
+	Claims  map[string]interface{}
+}
+
+func (p Principal) StringClaim(name string) (string, bool) {
+	value, ok := p.Claims[name].(string)
+	return value, ok && value != ""
+}
+
+func (p Principal) StringsClaim(name string) []string {
+	switch value := p.Claims[name].(type) {
+	case string:
+		return strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
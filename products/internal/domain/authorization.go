@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrForbidden     = errors.New("forbidden")
+	ErrInvalidPolicy = errors.New("invalid authorization policy")
+)
+
+type Permission string
+
+const (
+	PermissionProductsRead   Permission = "products:read"
+	PermissionProductsWrite  Permission = "products:write"
+	PermissionProductsDelete Permission = "products:delete"
+	PermissionProductsAdmin  Permission = "products:admin"
+	PermissionOutboxManage   Permission = "outbox:manage"
+	PermissionAPIKeysManage  Permission = "api_keys:manage"
+
+	PermissionAll Permission = "*"
+)
+
+func (p Permission) String() string {
+	return string(p)
+}
+
+func ParsePermission(value string) (Permission, error) {
+	permission := Permission(strings.TrimSpace(value))
+	switch permission {
+	case PermissionProductsRead, PermissionProductsWrite, PermissionProductsDelete, PermissionProductsAdmin,
+		PermissionOutboxManage, PermissionAPIKeysManage, PermissionAll:
+		return permission, nil
+	default:
+		return "", fmt.Errorf("unknown permission %q: %w", value, ErrInvalidPolicy)
+	}
+}
+
+type Policy struct {
+	roles map[string][]Permission
+}
+
+func NewPolicy(roles map[string][]string) (*Policy, error) {
+	policy := &Policy{roles: make(map[string][]Permission, len(roles))}
+	for role, values := range roles {
+		role = strings.TrimSpace(role)
+		if role == "" {
+			return nil, fmt.Errorf("role name cannot be empty: %w", ErrInvalidPolicy)
+		}
+		permissions := make([]Permission, 0, len(values))
+		for _, value := range values {
+			permission, err := ParsePermission(value)
+			if err != nil {
+				return nil, fmt.Errorf("role %q: %w", role, err)
+			}
+			permissions = append(permissions, permission)
+		}
+		policy.roles[role] = permissions
+	}
+	return policy, nil
+}
+
+func (p *Policy) Allows(roles []string, permission Permission) bool {
+	for _, role := range roles {
+		granted := p.roles[role]
+		if slices.Contains(granted, PermissionAll) || slices.Contains(granted, permission) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) HasRole(role string) bool {
+	_, ok := p.roles[role]
+	return ok
+}
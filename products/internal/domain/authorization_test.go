@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPolicyAllows(t *testing.T) {
+	policy, err := NewPolicy(map[string][]string{
+		"admin":  {"*"},
+		"editor": {"products:read", "products:write"},
+		"viewer": {"products:read"},
+	})
+	if err != nil {
+		t.Fatalf("NewPolicy() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		roles      []string
+		permission Permission
+		want       bool
+	}{
+		{"viewer reads", []string{"viewer"}, PermissionProductsRead, true},
+		{"viewer cannot write", []string{"viewer"}, PermissionProductsWrite, false},
+		{"editor cannot delete", []string{"editor"}, PermissionProductsDelete, false},
+		{"roles are combined", []string{"viewer", "editor"}, PermissionProductsWrite, true},
+		{"admin wildcard", []string{"admin"}, PermissionAPIKeysManage, true},
+		{"unknown role", []string{"auditor"}, PermissionProductsRead, false},
+		{"no roles", nil, PermissionProductsRead, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Allows(tt.roles, tt.permission); got != tt.want {
+				t.Errorf("Allows(%v, %s) = %v, want %v", tt.roles, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPolicyRejectsUnknownPermission(t *testing.T) {
+	if _, err := NewPolicy(map[string][]string{"editor": {"products:publish"}}); !errors.Is(err, ErrInvalidPolicy) {
+		t.Errorf("NewPolicy() error = %v, want ErrInvalidPolicy", err)
+	}
+	if _, err := NewPolicy(map[string][]string{" ": {"products:read"}}); !errors.Is(err, ErrInvalidPolicy) {
+		t.Errorf("NewPolicy() with empty role error = %v, want ErrInvalidPolicy", err)
+	}
+}
+
+func TestAPIKeyGrants(t *testing.T) {
+	key := &APIKey{Scopes: []APIKeyScope{ScopeProductsWrite}}
+	if !key.Grants(PermissionProductsDelete) || !key.Grants(PermissionProductsWrite) {
+		t.Errorf("products:write scope should grant write and delete")
+	}
+	if key.Grants(PermissionProductsRead) || key.Grants(PermissionAPIKeysManage) {
+		t.Errorf("products:write scope should not grant read or key management")
+	}
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrCategoryNotFound     = errors.New("category not found")
+	ErrCategoryInUse        = errors.New("category is in use")
+	ErrInvalidCategoryMove  = errors.New("invalid category move")
+	ErrCategorySlugConflict = errors.New("category slug already exists")
+	ErrInvalidCategory      = errors.New("invalid category")
+)
+
+const (
+	MaxCategoryNameLength = 255
+	MaxCategorySlugLength = 100
+)
+
+var (
+	categorySlugPattern   = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	categorySlugSeparator = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+type CategoryUsage struct {
+	Products int
+	Children int
+}
+
+type Category struct {
+	ID        int
+	Name      string
+	Slug      string
+	ParentID  *int
+	Path      string
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	domainEvents []DomainEvent
+}
+
+func NewCategory(name, slug string, parent *Category) (*Category, error) {
+	name = strings.TrimSpace(name)
+	if err := validateCategoryName(name); err != nil {
+		return nil, err
+	}
+
+	if slug == "" {
+		slug = SlugifyCategoryName(name)
+	}
+	if err := ValidateCategorySlug(slug); err != nil {
+		return nil, err
+	}
+
+	category := &Category{
+		Name: name,
+		Slug: slug,
+	}
+	if parent != nil {
+		parentID := parent.ID
+		category.ParentID = &parentID
+	}
+	return category, nil
+}
+
+func SlugifyCategoryName(name string) string {
+	slug := categorySlugSeparator.ReplaceAllString(strings.ToLower(name), "-")
+	slug = strings.Trim(slug, "-")
+	if len(slug) > MaxCategorySlugLength {
+		slug = strings.TrimRight(slug[:MaxCategorySlugLength], "-")
+	}
+	return slug
+}
+
+func ValidateCategorySlug(slug string) error {
+	if len(slug) > MaxCategorySlugLength || !categorySlugPattern.MatchString(slug) {
+		return fmt.Errorf("%w: slug must be lowercase letters, digits and single hyphens, at most %d characters", ErrInvalidCategory, MaxCategorySlugLength)
+	}
+	if _, err := strconv.Atoi(slug); err == nil {
+		return fmt.Errorf("%w: slug must not be purely numeric", ErrInvalidCategory)
+	}
+	return nil
+}
+
+func validateCategoryName(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > MaxCategoryNameLength {
+		return fmt.Errorf("%w: name must be 1-%d characters", ErrInvalidCategory, MaxCategoryNameLength)
+	}
+	return nil
+}
+
+func CategoryPath(parentPath string, id int) string {
+	if parentPath == "" {
+		parentPath = "/"
+	}
+	return parentPath + strconv.Itoa(id) + "/"
+}
+
+func (c *Category) Depth() int {
+	return strings.Count(c.Path, "/") - 1
+}
+
+func (c *Category) IsAncestorOf(other *Category) bool {
+	return other != nil && c.Path != "" && strings.HasPrefix(other.Path, c.Path)
+}
+
+func (c *Category) CheckVersion(expected int) error {
+	if expected != 0 && expected != c.Version {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+func (c *Category) Snapshot() CategorySnapshot {
+	return CategorySnapshot{
+		ID:       c.ID,
+		Name:     c.Name,
+		Slug:     c.Slug,
+		ParentID: c.ParentID,
+		Path:     c.Path,
+	}
+}
+
+func (c *Category) RecordCreatedEvent() {
+	c.recordDomainEvent(NewCategoryCreatedEvent(c.Snapshot()))
+}
+
+func (c *Category) Rename(name, slug string) error {
+	name = strings.TrimSpace(name)
+	if err := validateCategoryName(name); err != nil {
+		return err
+	}
+	if err := ValidateCategorySlug(slug); err != nil {
+		return err
+	}
+
+	changes := make(map[string]FieldChange)
+	if name != c.Name {
+		changes["name"] = FieldChange{From: c.Name, To: name}
+	}
+	if slug != c.Slug {
+		changes["slug"] = FieldChange{From: c.Slug, To: slug}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	c.Name = name
+	c.Slug = slug
+	c.recordDomainEvent(NewCategoryUpdatedEvent(c.Snapshot(), changes))
+	return nil
+}
+
+func (c *Category) MoveTo(parent *Category) error {
+	if parent != nil && (parent.ID == c.ID || c.IsAncestorOf(parent)) {
+		return fmt.Errorf("%w: category %d cannot be moved under itself or its descendants", ErrInvalidCategoryMove, c.ID)
+	}
+
+	var parentID *int
+	parentPath := ""
+	if parent != nil {
+		id := parent.ID
+		parentID = &id
+		parentPath = parent.Path
+	}
+	if sameParent(c.ParentID, parentID) {
+		return nil
+	}
+
+	previous := c.Snapshot()
+	c.ParentID = parentID
+	c.Path = CategoryPath(parentPath, c.ID)
+	c.recordDomainEvent(NewCategoryMovedEvent(c.Snapshot(), previous))
+	return nil
+}
+
+func sameParent(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func (c *Category) RecordDeleteEvent() {
+	c.recordDomainEvent(NewCategoryDeletedEvent(c.Snapshot()))
+}
+
+func (c *Category) recordDomainEvent(event DomainEvent) {
+	c.domainEvents = append(c.domainEvents, event)
+}
+
+func (c *Category) DomainEvents() []DomainEvent {
+	if len(c.domainEvents) == 0 {
+		return []DomainEvent{}
+	}
+	return append([]DomainEvent{}, c.domainEvents...)
+}
+
+func (c *Category) ClearDomainEvents() {
+	c.domainEvents = nil
+}
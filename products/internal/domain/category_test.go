@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewCategory_GeneratesSlug(t *testing.T) {
+	parent := &Category{ID: 3, Path: "/3/"}
+
+	category, err := NewCategory("  Men's T-Shirts ", "", parent)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if category.Slug != "men-s-t-shirts" {
+		t.Errorf("Expected slug men-s-t-shirts, got %s", category.Slug)
+	}
+	if category.ParentID == nil || *category.ParentID != 3 {
+		t.Errorf("Expected parent 3, got %v", category.ParentID)
+	}
+}
+
+func TestValidateCategorySlug(t *testing.T) {
+	tests := []struct {
+		slug  string
+		valid bool
+	}{
+		{"shoes", true},
+		{"running-shoes-2024", true},
+		{"Shoes", false},
+		{"running--shoes", false},
+		{"-shoes", false},
+		{"42", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateCategorySlug(tt.slug)
+		if tt.valid && err != nil {
+			t.Errorf("Expected %q to be valid, got: %v", tt.slug, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidCategory) {
+			t.Errorf("Expected %q to be invalid, got: %v", tt.slug, err)
+		}
+	}
+}
+
+func TestCategory_MoveTo(t *testing.T) {
+	category := &Category{ID: 2, Name: "Shirts", Slug: "shirts", Path: "/1/2/"}
+	root := 1
+	category.ParentID = &root
+
+	if err := category.MoveTo(&Category{ID: 7, Path: "/1/2/7/"}); !errors.Is(err, ErrInvalidCategoryMove) {
+		t.Errorf("Expected ErrInvalidCategoryMove for descendant parent, got: %v", err)
+	}
+
+	if err := category.MoveTo(&Category{ID: 1, Path: "/1/"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(category.DomainEvents()) != 0 {
+		t.Errorf("Expected no events for a move to the same parent")
+	}
+
+	if err := category.MoveTo(nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if category.Path != "/2/" || category.ParentID != nil || category.Depth() != 1 {
+		t.Errorf("Expected root category at /2/, got path %s parent %v", category.Path, category.ParentID)
+	}
+
+	events := category.DomainEvents()
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(events))
+	}
+	moved, ok := events[0].(CategoryMovedEvent)
+	if !ok {
+		t.Fatalf("Expected CategoryMovedEvent, got %T", events[0])
+	}
+	if moved.Category.PreviousPath != "/1/2/" {
+		t.Errorf("Expected previous path /1/2/, got %s", moved.Category.PreviousPath)
+	}
+}
@@ -34,11 +34,23 @@ func (e ProductCreatedEvent) OccurredAt() time.Time {
 }
 
 func (e ProductCreatedEvent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]interface{}{
+	payload := map[string]interface{}{
 		"type":       e.EventType(),
 		"product_id": e.ProductID,
 		"timestamp":  e.Timestamp,
-	})
+	}
+	if e.Product != nil {
+		details := e.Product.EventDetails()
+		payload["prices"] = details.Prices
+		payload["status"] = details.Status
+		if details.Attributes != nil {
+			payload["attributes"] = details.Attributes
+		}
+		if details.ParentID != nil {
+			payload["parent_id"] = *details.ParentID
+		}
+	}
+	return json.Marshal(payload)
 }
 
 type ProductDeletedEvent struct {
@@ -69,3 +81,318 @@ func (e ProductDeletedEvent) MarshalJSON() ([]byte, error) {
 	})
 }
 
+
+type FieldChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+type ProductEventDetails struct {
+	Status     ProductStatus          `json:"status,omitempty"`
+	Prices     map[string]string      `json:"prices,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	ParentID   *int                   `json:"parent_id,omitempty"`
+}
+
+type ProductUpdatedEvent struct {
+	ProductID int
+	Changes   map[string]FieldChange
+	Details   ProductEventDetails
+	Timestamp time.Time
+}
+
+func NewProductUpdatedEvent(productID int, changes map[string]FieldChange, details ProductEventDetails) DomainEvent {
+	return ProductUpdatedEvent{
+		ProductID: productID,
+		Changes:   changes,
+		Details:   details,
+		Timestamp: time.Now(),
+	}
+}
+
+func (e ProductUpdatedEvent) EventType() string {
+	return "PRODUCT_UPDATED"
+}
+
+func (e ProductUpdatedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e ProductUpdatedEvent) MarshalJSON() ([]byte, error) {
+	payload := map[string]interface{}{
+		"type":       e.EventType(),
+		"product_id": e.ProductID,
+		"changes":    e.Changes,
+		"prices":     e.Details.Prices,
+		"timestamp":  e.Timestamp,
+	}
+	if e.Details.Status != "" {
+		payload["status"] = e.Details.Status
+	}
+	if e.Details.Attributes != nil {
+		payload["attributes"] = e.Details.Attributes
+	}
+	if e.Details.ParentID != nil {
+		payload["parent_id"] = *e.Details.ParentID
+	}
+	return json.Marshal(payload)
+}
+
+type ProductRestoredEvent struct {
+	ProductID int
+	Timestamp time.Time
+}
+
+func NewProductRestoredEvent(productID int) DomainEvent {
+	return ProductRestoredEvent{
+		ProductID: productID,
+		Timestamp: time.Now(),
+	}
+}
+
+func (e ProductRestoredEvent) EventType() string {
+	return "PRODUCT_RESTORED"
+}
+
+func (e ProductRestoredEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e ProductRestoredEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":       e.EventType(),
+		"product_id": e.ProductID,
+		"timestamp":  e.Timestamp,
+	})
+}
+
+type StockSnapshot struct {
+	OnHand        int        `json:"on_hand"`
+	Reserved      int        `json:"reserved"`
+	Available     int        `json:"available"`
+	Delta         int        `json:"delta,omitempty"`
+	Reason        string     `json:"reason,omitempty"`
+	ReservationID int64      `json:"reservation_id,omitempty"`
+	Quantity      int        `json:"quantity,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+type StockChangedEvent struct {
+	ProductID int
+	Stock     StockSnapshot
+	Timestamp time.Time
+}
+
+func NewStockChangedEvent(productID int, stock StockSnapshot) DomainEvent {
+	return StockChangedEvent{
+		ProductID: productID,
+		Stock:     stock,
+		Timestamp: time.Now(),
+	}
+}
+
+func (e StockChangedEvent) EventType() string {
+	return "STOCK_CHANGED"
+}
+
+func (e StockChangedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e StockChangedEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":       e.EventType(),
+		"product_id": e.ProductID,
+		"stock":      e.Stock,
+		"timestamp":  e.Timestamp,
+	})
+}
+
+type StockReservedEvent struct {
+	ProductID int
+	Stock     StockSnapshot
+	Timestamp time.Time
+}
+
+func NewStockReservedEvent(productID int, stock StockSnapshot) DomainEvent {
+	return StockReservedEvent{
+		ProductID: productID,
+		Stock:     stock,
+		Timestamp: time.Now(),
+	}
+}
+
+func (e StockReservedEvent) EventType() string {
+	return "STOCK_RESERVED"
+}
+
+func (e StockReservedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e StockReservedEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":       e.EventType(),
+		"product_id": e.ProductID,
+		"stock":      e.Stock,
+		"timestamp":  e.Timestamp,
+	})
+}
+
+type OutOfStockEvent struct {
+	ProductID int
+	Stock     StockSnapshot
+	Timestamp time.Time
+}
+
+func NewOutOfStockEvent(productID int, stock StockSnapshot) DomainEvent {
+	return OutOfStockEvent{
+		ProductID: productID,
+		Stock:     stock,
+		Timestamp: time.Now(),
+	}
+}
+
+func (e OutOfStockEvent) EventType() string {
+	return "OUT_OF_STOCK"
+}
+
+func (e OutOfStockEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e OutOfStockEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":       e.EventType(),
+		"product_id": e.ProductID,
+		"stock":      e.Stock,
+		"timestamp":  e.Timestamp,
+	})
+}
+
+type CategorySnapshot struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	Slug             string `json:"slug"`
+	ParentID         *int   `json:"parent_id"`
+	Path             string `json:"path"`
+	PreviousParentID *int   `json:"previous_parent_id,omitempty"`
+	PreviousPath     string `json:"previous_path,omitempty"`
+}
+
+type CategoryCreatedEvent struct {
+	Category  CategorySnapshot
+	Timestamp time.Time
+}
+
+func NewCategoryCreatedEvent(category CategorySnapshot) DomainEvent {
+	return CategoryCreatedEvent{
+		Category:  category,
+		Timestamp: time.Now(),
+	}
+}
+
+func (e CategoryCreatedEvent) EventType() string {
+	return "CATEGORY_CREATED"
+}
+
+func (e CategoryCreatedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e CategoryCreatedEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":      e.EventType(),
+		"category":  e.Category,
+		"timestamp": e.Timestamp,
+	})
+}
+
+type CategoryUpdatedEvent struct {
+	Category  CategorySnapshot
+	Changes   map[string]FieldChange
+	Timestamp time.Time
+}
+
+func NewCategoryUpdatedEvent(category CategorySnapshot, changes map[string]FieldChange) DomainEvent {
+	return CategoryUpdatedEvent{
+		Category:  category,
+		Changes:   changes,
+		Timestamp: time.Now(),
+	}
+}
+
+func (e CategoryUpdatedEvent) EventType() string {
+	return "CATEGORY_UPDATED"
+}
+
+func (e CategoryUpdatedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e CategoryUpdatedEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":      e.EventType(),
+		"category":  e.Category,
+		"changes":   e.Changes,
+		"timestamp": e.Timestamp,
+	})
+}
+
+type CategoryMovedEvent struct {
+	Category  CategorySnapshot
+	Timestamp time.Time
+}
+
+func NewCategoryMovedEvent(category CategorySnapshot, previous CategorySnapshot) DomainEvent {
+	category.PreviousParentID = previous.ParentID
+	category.PreviousPath = previous.Path
+	return CategoryMovedEvent{
+		Category:  category,
+		Timestamp: time.Now(),
+	}
+}
+
+func (e CategoryMovedEvent) EventType() string {
+	return "CATEGORY_MOVED"
+}
+
+func (e CategoryMovedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e CategoryMovedEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":      e.EventType(),
+		"category":  e.Category,
+		"timestamp": e.Timestamp,
+	})
+}
+
+type CategoryDeletedEvent struct {
+	Category  CategorySnapshot
+	Timestamp time.Time
+}
+
+func NewCategoryDeletedEvent(category CategorySnapshot) DomainEvent {
+	return CategoryDeletedEvent{
+		Category:  category,
+		Timestamp: time.Now(),
+	}
+}
+
+func (e CategoryDeletedEvent) EventType() string {
+	return "CATEGORY_DELETED"
+}
+
+func (e CategoryDeletedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e CategoryDeletedEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":      e.EventType(),
+		"category":  e.Category,
+		"timestamp": e.Timestamp,
+	})
+}
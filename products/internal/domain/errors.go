@@ -6,8 +6,10 @@ import (
 )
 
 var (
-	ErrProductNotFound = errors.New("product not found")
-	ErrInvalidInput    = errors.New("invalid input")
+	ErrProductNotFound   = errors.New("product not found")
+	ErrInvalidInput      = errors.New("invalid input")
+	ErrVersionConflict   = errors.New("product version conflict")
+	ErrProductNotDeleted = errors.New("product is not deleted")
 )
 
 type DomainError struct {
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is in progress")
+)
+
+const MaxIdempotencyKeyLength = 255
+
+type IdempotencyRecord struct {
+	Scope        string
+	Key          string
+	RequestHash  string
+	StatusCode   int
+	ContentType  string
+	Headers      map[string]string
+	ResponseBody []byte
+	ExpiresAt    time.Time
+}
+
+func (r IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+func (r IdempotencyRecord) Check(requestHash string) error {
+	if r.RequestHash != requestHash {
+		return ErrIdempotencyKeyReused
+	}
+	if !r.Completed() {
+		return ErrIdempotencyKeyInProgress
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIdempotencyRecord_Check(t *testing.T) {
+	completed := IdempotencyRecord{RequestHash: "abc", StatusCode: 201, ResponseBody: []byte(`{"id":1}`)}
+	inFlight := IdempotencyRecord{RequestHash: "abc"}
+
+	tests := []struct {
+		name   string
+		record IdempotencyRecord
+		hash   string
+		want   error
+	}{
+		{name: "replay completed request", record: completed, hash: "abc"},
+		{name: "different request body", record: completed, hash: "def", want: ErrIdempotencyKeyReused},
+		{name: "request in progress", record: inFlight, hash: "abc", want: ErrIdempotencyKeyInProgress},
+		{name: "different body while in progress", record: inFlight, hash: "def", want: ErrIdempotencyKeyReused},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.record.Check(tt.hash)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Check() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInsufficientStock    = errors.New("insufficient stock")
+	ErrReservationNotFound  = errors.New("reservation not found")
+	ErrReservationNotActive = errors.New("reservation is not active")
+	ErrReservationExpired   = errors.New("reservation has expired")
+)
+
+const (
+	StockReasonAdjustment           = "adjustment"
+	StockReasonReservationConfirmed = "reservation_confirmed"
+	StockReasonReservationReleased  = "reservation_released"
+	StockReasonReservationExpired   = "reservation_expired"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationStatusPending   ReservationStatus = "pending"
+	ReservationStatusConfirmed ReservationStatus = "confirmed"
+	ReservationStatusReleased  ReservationStatus = "released"
+	ReservationStatusExpired   ReservationStatus = "expired"
+)
+
+type Reservation struct {
+	ID        int64
+	ProductID int
+	Quantity  int
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (r *Reservation) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+type Inventory struct {
+	ProductID int
+	OnHand    int
+	Reserved  int
+	Version   int
+	UpdatedAt time.Time
+
+	domainEvents []DomainEvent
+}
+
+func NewInventory(productID int) *Inventory {
+	return &Inventory{
+		ProductID:    productID,
+		domainEvents: make([]DomainEvent, 0),
+	}
+}
+
+func (i *Inventory) Available() int {
+	return i.OnHand - i.Reserved
+}
+
+func (i *Inventory) Snapshot() StockSnapshot {
+	return StockSnapshot{
+		OnHand:    i.OnHand,
+		Reserved:  i.Reserved,
+		Available: i.Available(),
+	}
+}
+
+func (i *Inventory) Adjust(delta int, reason string) error {
+	if delta == 0 {
+		return fmt.Errorf("%w: stock adjustment must not be zero", ErrInvalidInput)
+	}
+	if i.OnHand+delta < i.Reserved {
+		return fmt.Errorf("%w: cannot remove %d units, only %d available", ErrInsufficientStock, -delta, i.Available())
+	}
+	if reason == "" {
+		reason = StockReasonAdjustment
+	}
+
+	wasAvailable := i.Available()
+	i.OnHand += delta
+
+	stock := i.Snapshot()
+	stock.Delta = delta
+	stock.Reason = reason
+	i.addEvent(NewStockChangedEvent(i.ProductID, stock))
+	i.recordOutOfStock(wasAvailable)
+	return nil
+}
+
+func (i *Inventory) Reserve(quantity int, expiresAt time.Time) (*Reservation, error) {
+	if quantity <= 0 {
+		return nil, fmt.Errorf("%w: reservation quantity must be positive", ErrInvalidInput)
+	}
+	if quantity > i.Available() {
+		return nil, fmt.Errorf("%w: requested %d, only %d available", ErrInsufficientStock, quantity, i.Available())
+	}
+
+	i.Reserved += quantity
+
+	return &Reservation{
+		ProductID: i.ProductID,
+		Quantity:  quantity,
+		Status:    ReservationStatusPending,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (i *Inventory) RecordReservedEvent(reservation *Reservation) {
+	stock := i.Snapshot()
+	stock.ReservationID = reservation.ID
+	stock.Quantity = reservation.Quantity
+	expiresAt := reservation.ExpiresAt
+	stock.ExpiresAt = &expiresAt
+	i.addEvent(NewStockReservedEvent(i.ProductID, stock))
+	i.recordOutOfStock(i.Available() + reservation.Quantity)
+}
+
+func (i *Inventory) Confirm(reservation *Reservation, now time.Time) error {
+	if err := i.checkPending(reservation); err != nil {
+		return err
+	}
+	if reservation.IsExpired(now) {
+		return fmt.Errorf("%w: reservation %d expired at %s", ErrReservationExpired, reservation.ID, reservation.ExpiresAt.Format(time.RFC3339))
+	}
+
+	i.Reserved -= reservation.Quantity
+	i.OnHand -= reservation.Quantity
+	reservation.Status = ReservationStatusConfirmed
+
+	stock := i.Snapshot()
+	stock.Delta = -reservation.Quantity
+	stock.Reason = StockReasonReservationConfirmed
+	stock.ReservationID = reservation.ID
+	stock.Quantity = reservation.Quantity
+	i.addEvent(NewStockChangedEvent(i.ProductID, stock))
+	return nil
+}
+
+func (i *Inventory) Release(reservation *Reservation) error {
+	return i.release(reservation, ReservationStatusReleased, StockReasonReservationReleased)
+}
+
+func (i *Inventory) Expire(reservation *Reservation) error {
+	return i.release(reservation, ReservationStatusExpired, StockReasonReservationExpired)
+}
+
+func (i *Inventory) release(reservation *Reservation, status ReservationStatus, reason string) error {
+	if err := i.checkPending(reservation); err != nil {
+		return err
+	}
+
+	i.Reserved -= reservation.Quantity
+	reservation.Status = status
+
+	stock := i.Snapshot()
+	stock.Reason = reason
+	stock.ReservationID = reservation.ID
+	stock.Quantity = reservation.Quantity
+	i.addEvent(NewStockChangedEvent(i.ProductID, stock))
+	return nil
+}
+
+func (i *Inventory) checkPending(reservation *Reservation) error {
+	if reservation.ProductID != i.ProductID {
+		return fmt.Errorf("%w: reservation %d belongs to product %d", ErrInvalidInput, reservation.ID, reservation.ProductID)
+	}
+	if reservation.Status != ReservationStatusPending {
+		return fmt.Errorf("%w: reservation %d is %s", ErrReservationNotActive, reservation.ID, reservation.Status)
+	}
+	if reservation.Quantity > i.Reserved {
+		return fmt.Errorf("reservation %d exceeds reserved stock of product %d", reservation.ID, i.ProductID)
+	}
+	return nil
+}
+
+func (i *Inventory) recordOutOfStock(wasAvailable int) {
+	if wasAvailable > 0 && i.Available() == 0 {
+		i.addEvent(NewOutOfStockEvent(i.ProductID, i.Snapshot()))
+	}
+}
+
+func (i *Inventory) addEvent(event DomainEvent) {
+	i.domainEvents = append(i.domainEvents, event)
+}
+
+func (i *Inventory) DomainEvents() []DomainEvent {
+	return i.domainEvents
+}
+
+func (i *Inventory) ClearDomainEvents() {
+	i.domainEvents = make([]DomainEvent, 0)
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func eventTypes(events []DomainEvent) []string {
+	types := make([]string, len(events))
+	for i, event := range events {
+		types[i] = event.EventType()
+	}
+	return types
+}
+
+func TestInventory_Adjust(t *testing.T) {
+	tests := []struct {
+		name       string
+		onHand     int
+		reserved   int
+		delta      int
+		wantOnHand int
+		wantEvents []string
+		wantErr    error
+	}{
+		{name: "restock", onHand: 0, delta: 10, wantOnHand: 10, wantEvents: []string{"STOCK_CHANGED"}},
+		{name: "partial removal", onHand: 10, delta: -4, wantOnHand: 6, wantEvents: []string{"STOCK_CHANGED"}},
+		{name: "sell out", onHand: 10, reserved: 4, delta: -6, wantOnHand: 4, wantEvents: []string{"STOCK_CHANGED", "OUT_OF_STOCK"}},
+		{name: "below reserved", onHand: 10, reserved: 4, delta: -7, wantErr: ErrInsufficientStock},
+		{name: "zero delta", onHand: 10, delta: 0, wantErr: ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inventory := NewInventory(1)
+			inventory.OnHand = tt.onHand
+			inventory.Reserved = tt.reserved
+
+			err := inventory.Adjust(tt.delta, "")
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Adjust() expected error %v, got %v", tt.wantErr, err)
+				}
+				if len(inventory.DomainEvents()) != 0 {
+					t.Errorf("Adjust() recorded events on failure: %v", eventTypes(inventory.DomainEvents()))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Adjust() unexpected error: %v", err)
+			}
+			if inventory.OnHand != tt.wantOnHand {
+				t.Errorf("Adjust() on hand = %d, want %d", inventory.OnHand, tt.wantOnHand)
+			}
+			got := eventTypes(inventory.DomainEvents())
+			if len(got) != len(tt.wantEvents) {
+				t.Fatalf("Adjust() events = %v, want %v", got, tt.wantEvents)
+			}
+			for i := range got {
+				if got[i] != tt.wantEvents[i] {
+					t.Errorf("Adjust() events = %v, want %v", got, tt.wantEvents)
+				}
+			}
+		})
+	}
+}
+
+func TestInventory_ReservationLifecycle(t *testing.T) {
+	now := time.Now()
+	inventory := NewInventory(1)
+	inventory.OnHand = 5
+
+	if _, err := inventory.Reserve(6, now.Add(time.Minute)); !errors.Is(err, ErrInsufficientStock) {
+		t.Fatalf("Reserve() expected %v, got %v", ErrInsufficientStock, err)
+	}
+
+	reservation, err := inventory.Reserve(5, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("Reserve() unexpected error: %v", err)
+	}
+	reservation.ID = 42
+	inventory.RecordReservedEvent(reservation)
+
+	if inventory.Available() != 0 || inventory.Reserved != 5 {
+		t.Errorf("after Reserve() available = %d, reserved = %d", inventory.Available(), inventory.Reserved)
+	}
+	got := eventTypes(inventory.DomainEvents())
+	if len(got) != 2 || got[0] != "STOCK_RESERVED" || got[1] != "OUT_OF_STOCK" {
+		t.Errorf("Reserve() events = %v, want [STOCK_RESERVED OUT_OF_STOCK]", got)
+	}
+	inventory.ClearDomainEvents()
+
+	if err := inventory.Confirm(reservation, now); err != nil {
+		t.Fatalf("Confirm() unexpected error: %v", err)
+	}
+	if inventory.OnHand != 0 || inventory.Reserved != 0 {
+		t.Errorf("after Confirm() on hand = %d, reserved = %d", inventory.OnHand, inventory.Reserved)
+	}
+	if reservation.Status != ReservationStatusConfirmed {
+		t.Errorf("Confirm() status = %s, want %s", reservation.Status, ReservationStatusConfirmed)
+	}
+
+	if err := inventory.Release(reservation); !errors.Is(err, ErrReservationNotActive) {
+		t.Errorf("Release() after confirm expected %v, got %v", ErrReservationNotActive, err)
+	}
+}
+
+func TestInventory_ConfirmExpiredReservation(t *testing.T) {
+	now := time.Now()
+	inventory := NewInventory(1)
+	inventory.OnHand = 3
+
+	reservation, err := inventory.Reserve(2, now.Add(-time.Second))
+	if err != nil {
+		t.Fatalf("Reserve() unexpected error: %v", err)
+	}
+
+	if err := inventory.Confirm(reservation, now); !errors.Is(err, ErrReservationExpired) {
+		t.Fatalf("Confirm() expected %v, got %v", ErrReservationExpired, err)
+	}
+
+	if err := inventory.Expire(reservation); err != nil {
+		t.Fatalf("Expire() unexpected error: %v", err)
+	}
+	if inventory.Reserved != 0 || inventory.Available() != 3 {
+		t.Errorf("after Expire() reserved = %d, available = %d", inventory.Reserved, inventory.Available())
+	}
+	if reservation.Status != ReservationStatusExpired {
+		t.Errorf("Expire() status = %s, want %s", reservation.Status, ReservationStatusExpired)
+	}
+}
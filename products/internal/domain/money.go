@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultCurrency          = "USD"
+	MoneyScale               = 2
+	MaxMoneyMinorUnits int64 = 9_999_999_999
+)
+
+var (
+	ErrInvalidCurrency   = errors.New("invalid currency code")
+	ErrPriceNotAvailable = errors.New("price not available in requested currency")
+	ErrPriceOutOfRange   = fmt.Errorf("%w: price exceeds 99999999.99", ErrInvalidProductPrice)
+	ErrInvalidPriceScale = fmt.Errorf("%w: price cannot have more than %d decimal places", ErrInvalidProductPrice, MoneyScale)
+	ErrMalformedPrice    = fmt.Errorf("%w: price is not a valid decimal number", ErrInvalidProductPrice)
+)
+
+const minorUnitsPerMajor = 100
+
+var decimalAmount = regexp.MustCompile(`^([0-9]+)(?:\.([0-9]+))?$`)
+
+type Money struct {
+	minor    int64
+	currency string
+}
+
+func NewMoney(minorUnits int64, currency string) (Money, error) {
+	currency, err := NormalizeCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	if minorUnits <= 0 {
+		return Money{}, ErrInvalidProductPrice
+	}
+	if minorUnits > MaxMoneyMinorUnits {
+		return Money{}, ErrPriceOutOfRange
+	}
+	return Money{minor: minorUnits, currency: currency}, nil
+}
+
+func ParseMoney(amount string, currency string) (Money, error) {
+	minor, err := parseMinorUnits(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	if minor == 0 {
+		return Money{}, ErrInvalidProductPrice
+	}
+	return NewMoney(minor, currency)
+}
+
+func ParsePriceBound(amount string, currency string) (Money, error) {
+	minor, err := parseMinorUnits(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	if minor == 0 {
+		currency, err := NormalizeCurrency(currency)
+		if err != nil {
+			return Money{}, err
+		}
+		return Money{currency: currency}, nil
+	}
+	return NewMoney(minor, currency)
+}
+
+func parseMinorUnits(amount string) (int64, error) {
+	match := decimalAmount.FindStringSubmatch(strings.TrimSpace(amount))
+	if match == nil {
+		return 0, ErrMalformedPrice
+	}
+	whole, fraction := match[1], match[2]
+	if len(fraction) > MoneyScale {
+		return 0, ErrInvalidPriceScale
+	}
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || major > MaxMoneyMinorUnits/minorUnitsPerMajor {
+		return 0, ErrPriceOutOfRange
+	}
+
+	var minor int64
+	if fraction != "" {
+		minor, err = strconv.ParseInt(fraction+strings.Repeat("0", MoneyScale-len(fraction)), 10, 64)
+		if err != nil {
+			return 0, ErrMalformedPrice
+		}
+	}
+	return major*minorUnitsPerMajor + minor, nil
+}
+
+func NormalizeCurrency(currency string) (string, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if len(currency) != 3 {
+		return "", ErrInvalidCurrency
+	}
+	for _, c := range currency {
+		if c < 'A' || c > 'Z' {
+			return "", ErrInvalidCurrency
+		}
+	}
+	return currency, nil
+}
+
+func (m Money) MinorUnits() int64 {
+	return m.minor
+}
+
+func (m Money) Currency() string {
+	return m.currency
+}
+
+func (m Money) IsZero() bool {
+	return m.minor == 0
+}
+
+func (m Money) Equal(other Money) bool {
+	return m.minor == other.minor && m.currency == other.currency
+}
+
+func (m Money) Amount() string {
+	return fmt.Sprintf("%d.%02d", m.minor/100, m.minor%100)
+}
+
+func (m Money) Float64() float64 {
+	return float64(m.minor) / 100
+}
+
+func (m Money) String() string {
+	return m.Amount() + " " + m.currency
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    string
+		currency  string
+		wantMinor int64
+		wantErr   error
+	}{
+		{name: "two decimals", amount: "99.99", currency: "USD", wantMinor: 9999},
+		{name: "integer", amount: "100", currency: "USD", wantMinor: 10000},
+		{name: "one decimal", amount: "0.1", currency: "USD", wantMinor: 10},
+		{name: "trailing zero", amount: "12.30", currency: "USD", wantMinor: 1230},
+		{name: "leading zeros", amount: "007.50", currency: "USD", wantMinor: 750},
+		{name: "surrounding spaces", amount: " 5.00 ", currency: "USD", wantMinor: 500},
+		{name: "lowercase currency", amount: "1.00", currency: "eur", wantMinor: 100},
+		{name: "maximum allowed", amount: "99999999.99", currency: "USD", wantMinor: MaxMoneyMinorUnits},
+		{name: "zero", amount: "0", currency: "USD", wantErr: ErrInvalidProductPrice},
+		{name: "zero with decimals", amount: "0.00", currency: "USD", wantErr: ErrInvalidProductPrice},
+		{name: "negative", amount: "-10.50", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "too many decimals", amount: "0.001", currency: "USD", wantErr: ErrInvalidPriceScale},
+		{name: "extra trailing zeros", amount: "12.3400", currency: "USD", wantErr: ErrInvalidPriceScale},
+		{name: "float artifact", amount: "0.30000000000000004", currency: "USD", wantErr: ErrInvalidPriceScale},
+		{name: "exceeds schema precision", amount: "100000000.00", currency: "USD", wantErr: ErrPriceOutOfRange},
+		{name: "too many digits", amount: "99999999999999999999", currency: "USD", wantErr: ErrPriceOutOfRange},
+		{name: "exponent", amount: "1e2", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "negative exponent", amount: "1E-2", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "hexadecimal", amount: "0x10", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "binary", amount: "0b101", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "octal", amount: "0o17", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "digit separators", amount: "1_000", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "plus sign", amount: "+5", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "leading dot", amount: ".5", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "trailing dot", amount: "5.", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "thousands separator", amount: "1,000.00", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "non-ascii digits", amount: "١٢", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "fraction syntax", amount: "1/3", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "garbage", amount: "abc", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "empty", amount: "", currency: "USD", wantErr: ErrMalformedPrice},
+		{name: "invalid currency", amount: "1.00", currency: "US", wantErr: ErrInvalidCurrency},
+		{name: "non-letter currency", amount: "1.00", currency: "U$D", wantErr: ErrInvalidCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.amount, tt.currency)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseMoney() expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseMoney() unexpected error: %v", err)
+			}
+			if got.MinorUnits() != tt.wantMinor {
+				t.Errorf("ParseMoney() minor units = %d, want %d", got.MinorUnits(), tt.wantMinor)
+			}
+		})
+	}
+}
+
+func TestParsePriceBound(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    string
+		wantMinor int64
+		wantErr   error
+	}{
+		{name: "zero", amount: "0", wantMinor: 0},
+		{name: "zero with decimals", amount: "0.00", wantMinor: 0},
+		{name: "positive", amount: "25.50", wantMinor: 2550},
+		{name: "negative", amount: "-1", wantErr: ErrMalformedPrice},
+		{name: "exponent", amount: "1e2", wantErr: ErrMalformedPrice},
+		{name: "hexadecimal", amount: "0x10", wantErr: ErrMalformedPrice},
+		{name: "digit separators", amount: "1_000", wantErr: ErrMalformedPrice},
+		{name: "too many decimals", amount: "0.001", wantErr: ErrInvalidPriceScale},
+		{name: "exceeds schema precision", amount: "100000000.00", wantErr: ErrPriceOutOfRange},
+		{name: "garbage", amount: "abc", wantErr: ErrMalformedPrice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePriceBound(tt.amount, "usd")
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParsePriceBound() expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParsePriceBound() unexpected error: %v", err)
+			}
+			if got.MinorUnits() != tt.wantMinor || got.Currency() != "USD" {
+				t.Errorf("ParsePriceBound() = %d %s, want %d USD", got.MinorUnits(), got.Currency(), tt.wantMinor)
+			}
+		})
+	}
+}
+
+func TestMoney_ScaleErrorsAreInvalidPrice(t *testing.T) {
+	for _, err := range []error{ErrInvalidPriceScale, ErrPriceOutOfRange, ErrMalformedPrice} {
+		if !errors.Is(err, ErrInvalidProductPrice) {
+			t.Errorf("%v should wrap ErrInvalidProductPrice", err)
+		}
+	}
+}
+
+func TestMoney_Amount(t *testing.T) {
+	tests := []struct {
+		minor int64
+		want  string
+	}{
+		{minor: 1, want: "0.01"},
+		{minor: 10, want: "0.10"},
+		{minor: 9999, want: "99.99"},
+		{minor: 10000, want: "100.00"},
+	}
+
+	for _, tt := range tests {
+		money, err := NewMoney(tt.minor, "USD")
+		if err != nil {
+			t.Fatalf("NewMoney() unexpected error: %v", err)
+		}
+		if money.Amount() != tt.want {
+			t.Errorf("Money.Amount() = %v, want %v", money.Amount(), tt.want)
+		}
+	}
+}
+
+func TestMoney_ExactArithmetic(t *testing.T) {
+	a, _ := ParseMoney("0.1", "USD")
+	b, _ := ParseMoney("0.2", "USD")
+	sum, err := NewMoney(a.MinorUnits()+b.MinorUnits(), "USD")
+	if err != nil {
+		t.Fatalf("NewMoney() unexpected error: %v", err)
+	}
+
+	expected, _ := ParseMoney("0.3", "USD")
+	if !sum.Equal(expected) {
+		t.Errorf("0.1 + 0.2 = %s, want %s", sum, expected)
+	}
+}
+
+func TestMoney_Equal(t *testing.T) {
+	usd, _ := ParseMoney("10.00", "USD")
+	eur, _ := ParseMoney("10.00", "EUR")
+	usd2, _ := NewMoney(1000, "usd")
+
+	if !usd.Equal(usd2) {
+		t.Error("Money with same amount and currency should be equal")
+	}
+	if usd.Equal(eur) {
+		t.Error("Money with different currencies should not be equal")
+	}
+}
@@ -0,0 +1,5 @@
+package domain
+
+import "errors"
+
+var ErrDeadLetterNotFound = errors.New("dead-letter event not found")
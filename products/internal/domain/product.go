@@ -2,14 +2,23 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
 type Product struct {
 	ID        int
 	Name      ProductName
-	Price     Price
+	Price     Money
+	Prices    map[string]Money
+	Status    ProductStatus
+	Version   int
 	CreatedAt time.Time
+	DeletedAt *time.Time
+
+	ParentID            *int
+	Attributes          Attributes
+	InheritedAttributes Attributes
 
 	domainEvents []DomainEvent
 }
@@ -23,26 +32,27 @@ func (p *Product) Validate() error {
 	if p.Name.Value() == "" {
 		return ErrInvalidProductName
 	}
-	if p.Price.Value() <= 0 {
+	if p.Price.MinorUnits() <= 0 {
 		return ErrInvalidProductPrice
 	}
 	return nil
 }
 
-func NewProduct(name string, price float64) (*Product, error) {
+func NewProduct(name string, price Money) (*Product, error) {
 	productName, err := NewProductName(name)
 	if err != nil {
 		return nil, err
 	}
 
-	productPrice, err := NewPrice(price)
-	if err != nil {
-		return nil, err
+	if price.IsZero() {
+		return nil, ErrInvalidProductPrice
 	}
 
 	product := &Product{
 		Name:         productName,
-		Price:        productPrice,
+		Price:        price,
+		Prices:       map[string]Money{price.Currency(): price},
+		Status:       ProductStatusDraft,
 		CreatedAt:    time.Now(),
 		domainEvents: make([]DomainEvent, 0),
 	}
@@ -50,6 +60,104 @@ func NewProduct(name string, price float64) (*Product, error) {
 	return product, nil
 }
 
+func (p *Product) AddPrice(price Money) error {
+	if price.IsZero() {
+		return ErrInvalidProductPrice
+	}
+	if price.Currency() == p.Price.Currency() && !price.Equal(p.Price) {
+		return fmt.Errorf("%w: conflicting %s price", ErrInvalidInput, price.Currency())
+	}
+	p.setPrice(price)
+	return nil
+}
+
+func (p *Product) setPrice(price Money) {
+	if p.Prices == nil {
+		p.Prices = make(map[string]Money)
+	}
+	p.Prices[price.Currency()] = price
+}
+
+func (p *Product) PriceIn(currency string) (Money, bool) {
+	currency, err := NormalizeCurrency(currency)
+	if err != nil {
+		return Money{}, false
+	}
+	if currency == p.Price.Currency() {
+		return p.Price, true
+	}
+	price, ok := p.Prices[currency]
+	return price, ok
+}
+
+func (p *Product) PriceAmounts() map[string]string {
+	amounts := make(map[string]string, len(p.Prices)+1)
+	for currency, price := range p.Prices {
+		amounts[currency] = price.Amount()
+	}
+	if !p.Price.IsZero() {
+		amounts[p.Price.Currency()] = p.Price.Amount()
+	}
+	return amounts
+}
+
+func (p *Product) IsVariant() bool {
+	return p.ParentID != nil
+}
+
+func (p *Product) EffectiveAttributes() Attributes {
+	return p.InheritedAttributes.Merge(p.Attributes)
+}
+
+func (p *Product) NewVariant(name string, price Money, attributes Attributes) (*Product, error) {
+	if p.IsVariant() {
+		return nil, fmt.Errorf("%w: product %d is itself a variant", ErrInvalidVariantParent, p.ID)
+	}
+	if p.IsDeleted() {
+		return nil, fmt.Errorf("%w: product %d is deleted", ErrInvalidVariantParent, p.ID)
+	}
+
+	variant, err := NewProduct(name, price)
+	if err != nil {
+		return nil, err
+	}
+
+	parentID := p.ID
+	variant.ParentID = &parentID
+	variant.Attributes = attributes
+	variant.InheritedAttributes = p.EffectiveAttributes()
+	return variant, nil
+}
+
+func (p *Product) EventDetails() ProductEventDetails {
+	return ProductEventDetails{
+		Status:     p.Status,
+		Prices:     p.PriceAmounts(),
+		Attributes: p.EffectiveAttributes().Values(),
+		ParentID:   p.ParentID,
+	}
+}
+
+func (p *Product) CheckVersion(expected int) error {
+	if expected != 0 && expected != p.Version {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+func (p *Product) IsDeleted() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *Product) Restore() error {
+	if !p.IsDeleted() {
+		return ErrProductNotDeleted
+	}
+	p.DeletedAt = nil
+	p.recordDomainEvent(NewProductRestoredEvent(p.ID))
+	return nil
+}
+
 func (p *Product) RecordCreatedEvent() {
 	event := NewProductCreatedEvent(p.ID, p)
 	p.recordDomainEvent(event)
@@ -77,3 +185,72 @@ func (p *Product) RecordDeleteEvent() {
 	event := NewProductDeletedEvent(p.ID)
 	p.recordDomainEvent(event)
 }
+
+func (p *Product) Update(name string, price Money, attributes Attributes, prices ...Money) error {
+	productName, err := NewProductName(name)
+	if err != nil {
+		return err
+	}
+
+	if price.IsZero() {
+		return ErrInvalidProductPrice
+	}
+
+	secondary := make(map[string]Money, len(prices))
+	for _, extra := range prices {
+		if extra.IsZero() {
+			return ErrInvalidProductPrice
+		}
+		if extra.Currency() == price.Currency() {
+			if !extra.Equal(price) {
+				return fmt.Errorf("%w: conflicting %s price", ErrInvalidInput, extra.Currency())
+			}
+			continue
+		}
+		if current, ok := p.Prices[extra.Currency()]; !ok || !current.Equal(extra) {
+			secondary[extra.Currency()] = extra
+		}
+	}
+
+	changes := make(map[string]FieldChange)
+	if productName != p.Name {
+		changes["name"] = FieldChange{From: p.Name.Value(), To: productName.Value()}
+	}
+	if price.MinorUnits() != p.Price.MinorUnits() {
+		changes["price"] = FieldChange{From: p.Price.Amount(), To: price.Amount()}
+	}
+	if price.Currency() != p.Price.Currency() {
+		changes["currency"] = FieldChange{From: p.Price.Currency(), To: price.Currency()}
+	}
+	if attributes != nil && !attributes.Equal(p.Attributes) {
+		changes["attributes"] = FieldChange{From: p.Attributes.Values(), To: attributes.Values()}
+	}
+	if len(secondary) > 0 {
+		from := make(map[string]string, len(secondary))
+		to := make(map[string]string, len(secondary))
+		for currency, extra := range secondary {
+			if current, ok := p.Prices[currency]; ok {
+				from[currency] = current.Amount()
+			}
+			to[currency] = extra.Amount()
+		}
+		changes["prices"] = FieldChange{From: from, To: to}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	p.Name = productName
+	p.Price = price
+	p.setPrice(price)
+	for _, extra := range secondary {
+		p.setPrice(extra)
+	}
+	if attributes != nil {
+		p.Attributes = attributes
+	}
+	p.recordDomainEvent(NewProductUpdatedEvent(p.ID, changes, p.EventDetails()))
+
+	return nil
+}
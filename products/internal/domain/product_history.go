@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+const (
+	HistoryActionCreated  = "created"
+	HistoryActionUpdated  = "updated"
+	HistoryActionDeleted  = "deleted"
+	HistoryActionRestored = "restored"
+
+	HistoryActionStatusChanged = "status_changed"
+)
+
+type ProductHistoryEntry struct {
+	ID        int64
+	ProductID int
+	Action    string
+	Changes   map[string]FieldChange
+	Actor     string
+	RequestID string
+	CreatedAt time.Time
+}
+
+func NewProductHistoryEntry(event DomainEvent, actor, requestID string) (ProductHistoryEntry, bool) {
+	entry := ProductHistoryEntry{
+		Actor:     actor,
+		RequestID: requestID,
+		Changes:   map[string]FieldChange{},
+		CreatedAt: event.OccurredAt(),
+	}
+
+	switch e := event.(type) {
+	case ProductCreatedEvent:
+		entry.ProductID = e.ProductID
+		entry.Action = HistoryActionCreated
+		if e.Product != nil {
+			entry.Changes = creationChanges(e.Product)
+		}
+	case ProductUpdatedEvent:
+		entry.ProductID = e.ProductID
+		entry.Action = HistoryActionUpdated
+		for field, change := range e.Changes {
+			entry.Changes[field] = change
+		}
+	case ProductDeletedEvent:
+		entry.ProductID = e.ProductID
+		entry.Action = HistoryActionDeleted
+	case ProductRestoredEvent:
+		entry.ProductID = e.ProductID
+		entry.Action = HistoryActionRestored
+	case ProductStatusEvent:
+		transition := e.Transition()
+		entry.ProductID = transition.ProductID
+		entry.Action = HistoryActionStatusChanged
+		entry.Changes["status"] = FieldChange{From: transition.From, To: transition.To}
+	default:
+		return ProductHistoryEntry{}, false
+	}
+
+	return entry, true
+}
+
+func creationChanges(product *Product) map[string]FieldChange {
+	changes := map[string]FieldChange{
+		"name":     {To: product.Name.Value()},
+		"price":    {To: product.Price.Amount()},
+		"currency": {To: product.Price.Currency()},
+	}
+	if product.Status != "" {
+		changes["status"] = FieldChange{To: product.Status}
+	}
+	if len(product.Prices) > 1 {
+		changes["prices"] = FieldChange{To: product.PriceAmounts()}
+	}
+	if len(product.Attributes) > 0 {
+		changes["attributes"] = FieldChange{To: product.Attributes.Values()}
+	}
+	if product.ParentID != nil {
+		changes["parent_id"] = FieldChange{To: *product.ParentID}
+	}
+	return changes
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "testing"
+
+func TestNewProductHistoryEntry_Update(t *testing.T) {
+	price, _ := ParseMoney("10.00", DefaultCurrency)
+	newPrice, _ := ParseMoney("12.50", DefaultCurrency)
+	product, _ := NewProduct("Mug", price)
+	product.ID = 4
+
+	if err := product.Update("Mug", newPrice, nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	entry, ok := NewProductHistoryEntry(product.DomainEvents()[0], "user:42", "req-1")
+	if !ok {
+		t.Fatal("Expected update event to produce a history entry")
+	}
+
+	if entry.ProductID != 4 || entry.Action != HistoryActionUpdated {
+		t.Errorf("Expected updated entry for product 4, got %+v", entry)
+	}
+	if entry.Actor != "user:42" || entry.RequestID != "req-1" {
+		t.Errorf("Expected actor and request ID to be recorded, got %+v", entry)
+	}
+	change, ok := entry.Changes["price"]
+	if !ok || change.From != "10.00" || change.To != "12.50" {
+		t.Errorf("Expected price change 10.00 -> 12.50, got %+v", entry.Changes)
+	}
+}
+
+func TestNewProductHistoryEntry_Created(t *testing.T) {
+	price, _ := ParseMoney("10.00", DefaultCurrency)
+	product, _ := NewProduct("Mug", price)
+	product.ID = 5
+	product.RecordCreatedEvent()
+
+	entry, ok := NewProductHistoryEntry(product.DomainEvents()[0], "system", "")
+	if !ok || entry.Action != HistoryActionCreated {
+		t.Fatalf("Expected created entry, got %+v", entry)
+	}
+	if entry.Changes["name"].To != "Mug" || entry.Changes["price"].From != nil {
+		t.Errorf("Expected creation changes to start from nil, got %+v", entry.Changes)
+	}
+}
+
+func TestNewProductHistoryEntry_IgnoresOtherEvents(t *testing.T) {
+	if _, ok := NewProductHistoryEntry(NewStockChangedEvent(1, StockSnapshot{}), "user:1", ""); ok {
+		t.Error("Expected stock events to be ignored")
+	}
+}
@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type ProductStatus string
+
+const (
+	ProductStatusDraft        ProductStatus = "draft"
+	ProductStatusActive       ProductStatus = "active"
+	ProductStatusDiscontinued ProductStatus = "discontinued"
+	ProductStatusArchived     ProductStatus = "archived"
+)
+
+var (
+	ErrInvalidProductStatus    = errors.New("invalid product status")
+	ErrInvalidStatusTransition = errors.New("invalid product status transition")
+)
+
+var productStatusTransitions = map[ProductStatus][]ProductStatus{
+	ProductStatusDraft:        {ProductStatusActive, ProductStatusArchived},
+	ProductStatusActive:       {ProductStatusDiscontinued},
+	ProductStatusDiscontinued: {ProductStatusActive, ProductStatusArchived},
+	ProductStatusArchived:     {},
+}
+
+func ParseProductStatus(value string) (ProductStatus, error) {
+	status := ProductStatus(strings.ToLower(strings.TrimSpace(value)))
+	if _, ok := productStatusTransitions[status]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidProductStatus, value)
+	}
+	return status, nil
+}
+
+func (s ProductStatus) String() string {
+	return string(s)
+}
+
+func (s ProductStatus) CanTransitionTo(target ProductStatus) bool {
+	for _, allowed := range productStatusTransitions[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
+
+func (s ProductStatus) AllowedTransitions() []ProductStatus {
+	return append([]ProductStatus{}, productStatusTransitions[s]...)
+}
+
+func (s ProductStatus) IsDeletable() bool {
+	return s == ProductStatusDraft || s == ProductStatusDiscontinued
+}
+
+type ProductStatusEvent interface {
+	DomainEvent
+	Transition() ProductStatusTransition
+}
+
+type ProductStatusTransition struct {
+	ProductID int
+	From      ProductStatus
+	To        ProductStatus
+	Timestamp time.Time
+}
+
+func (t ProductStatusTransition) Transition() ProductStatusTransition {
+	return t
+}
+
+func (t ProductStatusTransition) OccurredAt() time.Time {
+	return t.Timestamp
+}
+
+func (t ProductStatusTransition) marshal(eventType string) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":        eventType,
+		"product_id":  t.ProductID,
+		"from_status": t.From,
+		"to_status":   t.To,
+		"timestamp":   t.Timestamp,
+	})
+}
+
+type ProductActivatedEvent struct {
+	ProductStatusTransition
+}
+
+func (e ProductActivatedEvent) EventType() string {
+	return "PRODUCT_ACTIVATED"
+}
+
+func (e ProductActivatedEvent) MarshalJSON() ([]byte, error) {
+	return e.marshal(e.EventType())
+}
+
+type ProductDiscontinuedEvent struct {
+	ProductStatusTransition
+}
+
+func (e ProductDiscontinuedEvent) EventType() string {
+	return "PRODUCT_DISCONTINUED"
+}
+
+func (e ProductDiscontinuedEvent) MarshalJSON() ([]byte, error) {
+	return e.marshal(e.EventType())
+}
+
+type ProductArchivedEvent struct {
+	ProductStatusTransition
+}
+
+func (e ProductArchivedEvent) EventType() string {
+	return "PRODUCT_ARCHIVED"
+}
+
+func (e ProductArchivedEvent) MarshalJSON() ([]byte, error) {
+	return e.marshal(e.EventType())
+}
+
+func NewProductStatusEvent(productID int, from, to ProductStatus) DomainEvent {
+	transition := ProductStatusTransition{
+		ProductID: productID,
+		From:      from,
+		To:        to,
+		Timestamp: time.Now(),
+	}
+	switch to {
+	case ProductStatusActive:
+		return ProductActivatedEvent{transition}
+	case ProductStatusDiscontinued:
+		return ProductDiscontinuedEvent{transition}
+	case ProductStatusArchived:
+		return ProductArchivedEvent{transition}
+	default:
+		return nil
+	}
+}
+
+func (p *Product) TransitionTo(target ProductStatus) error {
+	if _, ok := productStatusTransitions[target]; !ok {
+		return fmt.Errorf("%w: %q", ErrInvalidProductStatus, target)
+	}
+	if p.IsDeleted() {
+		return fmt.Errorf("%w: product %d is deleted", ErrInvalidStatusTransition, p.ID)
+	}
+	from := p.Status
+	if !from.CanTransitionTo(target) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, from, target)
+	}
+
+	p.Status = target
+	p.recordDomainEvent(NewProductStatusEvent(p.ID, from, target))
+	return nil
+}
+
+func (p *Product) CanDelete() error {
+	if !p.Status.IsDeletable() {
+		return fmt.Errorf("%w: %s products cannot be deleted", ErrInvalidStatusTransition, p.Status)
+	}
+	return nil
+}
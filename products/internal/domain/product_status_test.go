@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProduct_TransitionTo(t *testing.T) {
+	tests := []struct {
+		from  ProductStatus
+		to    ProductStatus
+		want  error
+		event string
+	}{
+		{from: ProductStatusDraft, to: ProductStatusActive, event: "PRODUCT_ACTIVATED"},
+		{from: ProductStatusDraft, to: ProductStatusArchived, event: "PRODUCT_ARCHIVED"},
+		{from: ProductStatusActive, to: ProductStatusDiscontinued, event: "PRODUCT_DISCONTINUED"},
+		{from: ProductStatusDiscontinued, to: ProductStatusActive, event: "PRODUCT_ACTIVATED"},
+		{from: ProductStatusDiscontinued, to: ProductStatusArchived, event: "PRODUCT_ARCHIVED"},
+		{from: ProductStatusActive, to: ProductStatusArchived, want: ErrInvalidStatusTransition},
+		{from: ProductStatusActive, to: ProductStatusDraft, want: ErrInvalidStatusTransition},
+		{from: ProductStatusArchived, to: ProductStatusActive, want: ErrInvalidStatusTransition},
+		{from: ProductStatusDraft, to: ProductStatusDraft, want: ErrInvalidStatusTransition},
+		{from: ProductStatusDraft, to: "retired", want: ErrInvalidProductStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			product := &Product{ID: 7, Status: tt.from}
+
+			err := product.TransitionTo(tt.to)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("TransitionTo() error = %v, want %v", err, tt.want)
+			}
+
+			events := product.DomainEvents()
+			if tt.want != nil {
+				if product.Status != tt.from || len(events) != 0 {
+					t.Errorf("Expected rejected transition to leave product unchanged, got status %s and %d events", product.Status, len(events))
+				}
+				return
+			}
+
+			if product.Status != tt.to {
+				t.Errorf("Expected status %s, got %s", tt.to, product.Status)
+			}
+			if len(events) != 1 || events[0].EventType() != tt.event {
+				t.Fatalf("Expected one %s event, got %v", tt.event, events)
+			}
+			transition := events[0].(ProductStatusEvent).Transition()
+			if transition.ProductID != 7 || transition.From != tt.from || transition.To != tt.to {
+				t.Errorf("Unexpected transition payload: %+v", transition)
+			}
+		})
+	}
+}
+
+func TestProduct_CanDelete(t *testing.T) {
+	deletable := map[ProductStatus]bool{
+		ProductStatusDraft:        true,
+		ProductStatusActive:       false,
+		ProductStatusDiscontinued: true,
+		ProductStatusArchived:     false,
+	}
+
+	for status, want := range deletable {
+		err := (&Product{Status: status}).CanDelete()
+		if want && err != nil {
+			t.Errorf("Expected %s product to be deletable, got: %v", status, err)
+		}
+		if !want && !errors.Is(err, ErrInvalidStatusTransition) {
+			t.Errorf("Expected %s product deletion to be rejected, got: %v", status, err)
+		}
+	}
+}
+
+func TestNewProduct_StartsAsDraft(t *testing.T) {
+	price, _ := ParseMoney("10.00", DefaultCurrency)
+	product, err := NewProduct("Mug", price)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if product.Status != ProductStatusDraft {
+		t.Errorf("Expected new product to be a draft, got %s", product.Status)
+	}
+}
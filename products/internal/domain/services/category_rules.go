@@ -0,0 +1,61 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+)
+
+const MaxCategoryDepth = 10
+
+type CategoryDomainService interface {
+	ValidateCategoryParent(parent *domain.Category) error
+
+	CanMoveCategory(category *domain.Category, parent *domain.Category, subtreeHeight int) error
+
+	CanDeleteCategory(category *domain.Category, usage domain.CategoryUsage) error
+}
+
+type categoryDomainService struct{}
+
+func NewCategoryDomainService() CategoryDomainService {
+	return &categoryDomainService{}
+}
+
+var _ CategoryDomainService = (*categoryDomainService)(nil)
+
+func (s *categoryDomainService) ValidateCategoryParent(parent *domain.Category) error {
+	if parent != nil && parent.Depth() >= MaxCategoryDepth {
+		return fmt.Errorf("%w: categories can be nested at most %d levels deep", domain.ErrInvalidCategory, MaxCategoryDepth)
+	}
+	return nil
+}
+
+func (s *categoryDomainService) CanMoveCategory(category *domain.Category, parent *domain.Category, subtreeHeight int) error {
+	if category == nil {
+		return errors.New("category is nil")
+	}
+	if parent == nil {
+		return nil
+	}
+	if parent.ID == category.ID || category.IsAncestorOf(parent) {
+		return fmt.Errorf("%w: category %d cannot be moved under itself or its descendants", domain.ErrInvalidCategoryMove, category.ID)
+	}
+	if parent.Depth()+subtreeHeight > MaxCategoryDepth {
+		return fmt.Errorf("%w: categories can be nested at most %d levels deep", domain.ErrInvalidCategoryMove, MaxCategoryDepth)
+	}
+	return nil
+}
+
+func (s *categoryDomainService) CanDeleteCategory(category *domain.Category, usage domain.CategoryUsage) error {
+	if category == nil {
+		return errors.New("category is nil")
+	}
+	if usage.Products > 0 {
+		return fmt.Errorf("%w: %d products are still assigned to category %d", domain.ErrCategoryInUse, usage.Products, category.ID)
+	}
+	if usage.Children > 0 {
+		return fmt.Errorf("%w: category %d still has %d subcategories", domain.ErrCategoryInUse, category.ID, usage.Children)
+	}
+	return nil
+}
@@ -2,15 +2,26 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"product_service/products/internal/domain"
+	"unicode/utf8"
+)
+
+const (
+	MaxProductAttributes     = 50
+	MaxAttributeStringLength = 255
 )
 
 type ProductDomainService interface {
-	ValidateProductForCreation(name string, price float64) error
+	ValidateProductForCreation(name string, price domain.Money) error
+	
+	ValidateProductForUpdate(name string, price domain.Money) error
+	
+	ValidateAttributes(attributes domain.Attributes) error
 	
-	ValidateProductForUpdate(name string, price float64) error
+	ValidateVariantParent(parent *domain.Product) error
 	
-	CanDeleteProduct(product *domain.Product) error
+	CanDeleteProduct(product *domain.Product, activeVariants int) error
 }
 
 type ProductValidator interface {
@@ -29,7 +40,7 @@ func NewProductDomainService(validator ProductValidator) ProductDomainService {
 
 var _ ProductDomainService = (*productDomainService)(nil)
 
-func (s *productDomainService) ValidateProductForCreation(name string, price float64) error {
+func (s *productDomainService) ValidateProductForCreation(name string, price domain.Money) error {
 	
 	if s.validator != nil {
 		if err := s.validator.ValidateProductName(name); err != nil {
@@ -40,16 +51,53 @@ func (s *productDomainService) ValidateProductForCreation(name string, price flo
 	return nil
 }
 
-func (s *productDomainService) ValidateProductForUpdate(name string, price float64) error {
+func (s *productDomainService) ValidateProductForUpdate(name string, price domain.Money) error {
 	return s.ValidateProductForCreation(name, price)
 }
 
-func (s *productDomainService) CanDeleteProduct(product *domain.Product) error {
-	if product == nil {
-		return errors.New("product is nil")
+func (s *productDomainService) ValidateAttributes(attributes domain.Attributes) error {
+	if len(attributes) > MaxProductAttributes {
+		return fmt.Errorf("%w: at most %d attributes are allowed", domain.ErrInvalidAttribute, MaxProductAttributes)
 	}
 	
+	for key, value := range attributes {
+		if expected, ok := domain.WellKnownAttributeType(key); ok && value.Type() != expected {
+			return fmt.Errorf("%w: %s must be a %s", domain.ErrInvalidAttribute, key, expected)
+		}
+		if value.Type() == domain.AttributeTypeString && utf8.RuneCountInString(value.Text()) > MaxAttributeStringLength {
+			return fmt.Errorf("%w: %s must be at most %d characters", domain.ErrInvalidAttribute, key, MaxAttributeStringLength)
+		}
+	}
+	
+	if weight, ok := attributes["weight"]; ok && weight.Number() <= 0 {
+		return fmt.Errorf("%w: weight must be greater than zero", domain.ErrInvalidAttribute)
+	}
 	
 	return nil
 }
 
+func (s *productDomainService) ValidateVariantParent(parent *domain.Product) error {
+	if parent == nil {
+		return errors.New("parent product is nil")
+	}
+	if parent.IsVariant() {
+		return fmt.Errorf("%w: product %d is itself a variant", domain.ErrInvalidVariantParent, parent.ID)
+	}
+	if parent.IsDeleted() {
+		return fmt.Errorf("%w: product %d is deleted", domain.ErrInvalidVariantParent, parent.ID)
+	}
+	
+	return nil
+}
+
+func (s *productDomainService) CanDeleteProduct(product *domain.Product, activeVariants int) error {
+	if product == nil {
+		return errors.New("product is nil")
+	}
+	if activeVariants > 0 {
+		return fmt.Errorf("%w: product %d still has %d variants", domain.ErrProductHasVariants, product.ID, activeVariants)
+	}
+	
+	return product.CanDelete()
+}
+
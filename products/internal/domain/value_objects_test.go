@@ -113,119 +113,6 @@ func TestProductName_String(t *testing.T) {
 	}
 }
 
-func TestNewPrice(t *testing.T) {
-	tests := []struct {
-		name        string
-		input       float64
-		wantErr     bool
-		errType     error
-		description string
-	}{
-		{
-			name:        "valid positive price",
-			input:       99.99,
-			wantErr:     false,
-			description: "should create Price with valid positive value",
-		},
-		{
-			name:        "valid integer price",
-			input:       100,
-			wantErr:     false,
-			description: "should create Price with integer value",
-		},
-		{
-			name:        "very small positive price",
-			input:       0.01,
-			wantErr:     false,
-			description: "should accept very small positive price",
-		},
-		{
-			name:        "zero price",
-			input:       0,
-			wantErr:     true,
-			errType:     ErrInvalidProductPrice,
-			description: "should return error for zero price",
-		},
-		{
-			name:        "negative price",
-			input:       -10.50,
-			wantErr:     true,
-			errType:     ErrInvalidProductPrice,
-			description: "should return error for negative price",
-		},
-		{
-			name:        "maximum allowed price",
-			input:       1e15,
-			wantErr:     false,
-			description: "should accept maximum allowed price",
-		},
-		{
-			name:        "price exceeding maximum",
-			input:       1e15 + 1,
-			wantErr:     true,
-			description: "should reject price exceeding maximum",
-		},
-		{
-			name:        "very large price",
-			input:       1e16,
-			wantErr:     true,
-			description: "should reject very large price",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewPrice(tt.input)
-
-			if tt.wantErr {
-				if err == nil {
-					t.Errorf("NewPrice() expected error, got nil")
-					return
-				}
-				if tt.errType != nil {
-					if !errors.Is(err, tt.errType) {
-						t.Errorf("NewPrice() expected error type %v, got %v", tt.errType, err)
-					}
-				}
-			} else {
-				if err != nil {
-					t.Errorf("NewPrice() unexpected error: %v", err)
-					return
-				}
-				if got.Value() != tt.input {
-					t.Errorf("NewPrice() value = %v, want %v", got.Value(), tt.input)
-				}
-			}
-		})
-	}
-}
-
-func TestPrice_Value(t *testing.T) {
-	price, err := NewPrice(99.99)
-	if err != nil {
-		t.Fatalf("NewPrice() unexpected error: %v", err)
-	}
-
-	if price.Value() != 99.99 {
-		t.Errorf("Price.Value() = %v, want %v", price.Value(), 99.99)
-	}
-}
-
-func TestPrice_Float64(t *testing.T) {
-	price, err := NewPrice(99.99)
-	if err != nil {
-		t.Fatalf("NewPrice() unexpected error: %v", err)
-	}
-
-	if price.Float64() != 99.99 {
-		t.Errorf("Price.Float64() = %v, want %v", price.Float64(), 99.99)
-	}
-
-	if price.Float64() != price.Value() {
-		t.Errorf("Price.Float64() = %v, should equal Value() = %v", price.Float64(), price.Value())
-	}
-}
-
 func TestProductName_Immutable(t *testing.T) {
 	name1, err := NewProductName("Product 1")
 	if err != nil {
@@ -241,19 +128,3 @@ func TestProductName_Immutable(t *testing.T) {
 		t.Error("ProductName should be immutable - different instances should have different values")
 	}
 }
-
-func TestPrice_Immutable(t *testing.T) {
-	price1, err := NewPrice(99.99)
-	if err != nil {
-		t.Fatalf("NewPrice() unexpected error: %v", err)
-	}
-
-	price2, err := NewPrice(199.99)
-	if err != nil {
-		t.Fatalf("NewPrice() unexpected error: %v", err)
-	}
-
-	if price1.Value() == price2.Value() {
-		t.Error("Price should be immutable - different instances should have different values")
-	}
-}
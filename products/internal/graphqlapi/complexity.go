@@ -0,0 +1,142 @@
+package graphqlapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+type queryCost struct {
+	Depth      int
+	Complexity int
+	RootFields int
+}
+
+type costAnalyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	visiting  map[string]bool
+}
+
+func analyzeCost(document *ast.Document, operationName string, variables map[string]interface{}) (queryCost, error) {
+	analyzer := &costAnalyzer{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+		visiting:  make(map[string]bool),
+	}
+
+	var operation *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch d := definition.(type) {
+		case *ast.FragmentDefinition:
+			analyzer.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				if operation != nil && operationName == "" {
+					return queryCost{}, fmt.Errorf("operationName is required when the document has several operations")
+				}
+				operation = d
+			}
+		}
+	}
+	if operation == nil {
+		return queryCost{}, fmt.Errorf("operation %q not found", operationName)
+	}
+
+	return analyzer.selectionSet(operation.SelectionSet, 0), nil
+}
+
+func (a *costAnalyzer) selectionSet(set *ast.SelectionSet, depth int) queryCost {
+	var cost queryCost
+	if set == nil {
+		return cost
+	}
+
+	for _, selection := range set.Selections {
+		var child queryCost
+		switch s := selection.(type) {
+		case *ast.Field:
+			child = a.field(s, depth)
+		case *ast.InlineFragment:
+			child = a.selectionSet(s.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			fragment, ok := a.fragments[s.Name.Value]
+			if !ok || a.visiting[s.Name.Value] {
+				continue
+			}
+			a.visiting[s.Name.Value] = true
+			child = a.selectionSet(fragment.SelectionSet, depth)
+			a.visiting[s.Name.Value] = false
+		}
+
+		cost.Complexity += child.Complexity
+		cost.Depth = max(cost.Depth, child.Depth)
+		cost.RootFields += child.RootFields
+	}
+	return cost
+}
+
+func (a *costAnalyzer) field(field *ast.Field, depth int) queryCost {
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return queryCost{}
+	}
+
+	children := a.selectionSet(field.SelectionSet, depth+1)
+	cost := queryCost{
+		Depth:      max(depth+1, children.Depth),
+		Complexity: 1 + children.Complexity*a.multiplier(field),
+	}
+	if depth == 0 {
+		cost.RootFields = 1
+	}
+	return cost
+}
+
+func (a *costAnalyzer) multiplier(field *ast.Field) int {
+	if field.Name.Value != "products" {
+		return 1
+	}
+
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "first" {
+			continue
+		}
+		if first, ok := a.intValue(argument.Value); ok && first > 0 {
+			return first
+		}
+	}
+	return defaultPageSize
+}
+
+func (a *costAnalyzer) intValue(value ast.Value) (int, bool) {
+	switch v := value.(type) {
+	case *ast.IntValue:
+		n, err := strconv.Atoi(v.Value)
+		return n, err == nil
+	case *ast.Variable:
+		switch n := a.variables[v.Name.Value].(type) {
+		case int:
+			return n, true
+		case float64:
+			return int(n), true
+		}
+	}
+	return 0, false
+}
+
+func (l Limits) check(cost queryCost) error {
+	if l.MaxDepth > 0 && cost.Depth > l.MaxDepth {
+		return fmt.Errorf("query depth %d exceeds the maximum of %d", cost.Depth, l.MaxDepth)
+	}
+	if l.MaxComplexity > 0 && cost.Complexity > l.MaxComplexity {
+		return fmt.Errorf("query complexity %d exceeds the maximum of %d", cost.Complexity, l.MaxComplexity)
+	}
+	return nil
+}
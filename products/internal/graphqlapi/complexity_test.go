@@ -0,0 +1,206 @@
+package graphqlapi
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+func analyzeQuery(t *testing.T, query, operationName string, variables map[string]interface{}) (queryCost, error) {
+	t.Helper()
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(query)}),
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse query: %v", err)
+	}
+	return analyzeCost(document, operationName, variables)
+}
+
+func TestAnalyzeCost(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		variables      map[string]interface{}
+		wantDepth      int
+		wantComplexity int
+		wantRootFields int
+	}{
+		{
+			name:           "single field",
+			query:          `{ product(id: 1) { id name } }`,
+			wantDepth:      2,
+			wantComplexity: 3,
+			wantRootFields: 1,
+		},
+		{
+			name:           "literal first",
+			query:          `{ products(first: 5) { nodes { id } } }`,
+			wantDepth:      3,
+			wantComplexity: 11,
+			wantRootFields: 1,
+		},
+		{
+			name:           "first from a variable",
+			query:          `query($n: Int) { products(first: $n) { nodes { id } } }`,
+			variables:      map[string]interface{}{"n": float64(50)},
+			wantDepth:      3,
+			wantComplexity: 101,
+			wantRootFields: 1,
+		},
+		{
+			name:           "first from an int variable",
+			query:          `query($n: Int) { products(first: $n) { nodes { id } } }`,
+			variables:      map[string]interface{}{"n": 20},
+			wantDepth:      3,
+			wantComplexity: 41,
+			wantRootFields: 1,
+		},
+		{
+			name:           "missing variable uses the default page size",
+			query:          `query($n: Int) { products(first: $n) { nodes { id } } }`,
+			wantDepth:      3,
+			wantComplexity: 1 + 2*defaultPageSize,
+			wantRootFields: 1,
+		},
+		{
+			name:           "non-positive first uses the default page size",
+			query:          `{ products(first: 0) { nodes { id } } }`,
+			wantDepth:      3,
+			wantComplexity: 1 + 2*defaultPageSize,
+			wantRootFields: 1,
+		},
+		{
+			name:           "nested products multiply",
+			query:          `{ products(first: 3) { nodes { parent { id } } } }`,
+			wantDepth:      4,
+			wantComplexity: 1 + 3*(1+1+1),
+			wantRootFields: 1,
+		},
+		{
+			name:           "introspection is free",
+			query:          `{ __typename product(id: 1) { __typename id } }`,
+			wantDepth:      2,
+			wantComplexity: 2,
+			wantRootFields: 1,
+		},
+		{
+			name:           "fragments and inline fragments",
+			query:          `{ ...root } fragment root on Query { a: product(id: 1) { ... on Product { id } } b: product(id: 2) { id } }`,
+			wantDepth:      2,
+			wantComplexity: 4,
+			wantRootFields: 2,
+		},
+		{
+			name:           "fragment cycle terminates",
+			query:          `{ ...a } fragment a on Query { product(id: 1) { id } ...b } fragment b on Query { ...a }`,
+			wantDepth:      2,
+			wantComplexity: 2,
+			wantRootFields: 1,
+		},
+		{
+			name:           "fragment reused in siblings",
+			query:          `{ a: product(id: 1) { ...fields } b: product(id: 2) { ...fields } } fragment fields on Product { id name }`,
+			wantDepth:      2,
+			wantComplexity: 6,
+			wantRootFields: 2,
+		},
+		{
+			name:           "unknown fragment is ignored",
+			query:          `{ product(id: 1) { ...missing id } }`,
+			wantDepth:      2,
+			wantComplexity: 2,
+			wantRootFields: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, err := analyzeQuery(t, tt.query, "", tt.variables)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if cost.Depth != tt.wantDepth || cost.Complexity != tt.wantComplexity || cost.RootFields != tt.wantRootFields {
+				t.Errorf("Expected depth=%d complexity=%d rootFields=%d, got depth=%d complexity=%d rootFields=%d",
+					tt.wantDepth, tt.wantComplexity, tt.wantRootFields, cost.Depth, cost.Complexity, cost.RootFields)
+			}
+		})
+	}
+}
+
+func TestAnalyzeCost_OperationSelection(t *testing.T) {
+	query := `query small { product(id: 1) { id } } query large { products(first: 40) { nodes { id } } }`
+
+	cost, err := analyzeQuery(t, query, "large", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if cost.Complexity != 81 {
+		t.Errorf("Expected the named operation to be analyzed, got complexity %d", cost.Complexity)
+	}
+
+	if _, err := analyzeQuery(t, query, "", nil); err == nil {
+		t.Error("Expected an error when several operations are sent without operationName")
+	}
+	if _, err := analyzeQuery(t, query, "missing", nil); err == nil {
+		t.Error("Expected an error for an unknown operationName")
+	}
+}
+
+func TestLimits_Check(t *testing.T) {
+	cost := queryCost{Depth: 4, Complexity: 120}
+
+	tests := []struct {
+		name    string
+		limits  Limits
+		wantErr string
+	}{
+		{name: "no limits", limits: Limits{}},
+		{name: "within limits", limits: Limits{MaxDepth: 4, MaxComplexity: 120}},
+		{name: "too deep", limits: Limits{MaxDepth: 3, MaxComplexity: 500}, wantErr: "query depth 4 exceeds the maximum of 3"},
+		{name: "too complex", limits: Limits{MaxDepth: 8, MaxComplexity: 100}, wantErr: "query complexity 120 exceeds the maximum of 100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limits.check(cost)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Expected %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHandler_RejectsQueriesOverLimits(t *testing.T) {
+	env := newGraphQLTestEnv(t, Limits{MaxDepth: 3, MaxComplexity: 100})
+
+	tests := []struct {
+		name    string
+		query   string
+		wantErr string
+	}{
+		{name: "complexity from first", query: `{ products(first: 60) { nodes { id } } }`, wantErr: "query complexity 121 exceeds the maximum of 100"},
+		{name: "depth", query: `{ products(first: 1) { nodes { parent { id } } } }`, wantErr: "query depth 4 exceeds the maximum of 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := postGraphQL(env.handler, tt.query, "")
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("Expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantErr) {
+				t.Errorf("Expected %q in the response, got %s", tt.wantErr, rec.Body.String())
+			}
+		})
+	}
+}
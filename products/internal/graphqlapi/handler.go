@@ -0,0 +1,296 @@
+package graphqlapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+
+	"product_service/products/internal/handler"
+	"product_service/products/internal/middleware"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+)
+
+const maxRequestBodySize = 1 << 20
+
+type Handler struct {
+	schema         graphql.Schema
+	useCase        usecase.ProductUseCase
+	errorMapper    *handler.ErrorMapper
+	logger         ports.Logger
+	limits         Limits
+	requestTimeout time.Duration
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type response struct {
+	Data   interface{}                `json:"data,omitempty"`
+	Errors []gqlerrors.FormattedError `json:"errors,omitempty"`
+}
+
+func NewHandler(
+	useCase usecase.ProductUseCase,
+	errorMapper *handler.ErrorMapper,
+	logger ports.Logger,
+	metrics ports.MetricsCollector,
+	limits Limits,
+	requestTimeout time.Duration,
+) (*Handler, error) {
+	schema, err := newSchema(&resolver{
+		useCase: useCase,
+		metrics: metrics,
+		logger:  logger,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{
+		schema:         schema,
+		useCase:        useCase,
+		errorMapper:    errorMapper,
+		logger:         logger,
+		limits:         limits,
+		requestTimeout: requestTimeout,
+	}, nil
+}
+
+func (h *Handler) Handle(c *gin.Context) {
+	if !h.serve(c.Writer, c.Request) {
+		middleware.SkipIdempotentResponse(c)
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r)
+}
+
+func (h *Handler) serve(w http.ResponseWriter, r *http.Request) bool {
+	req, err := readRequest(w, r)
+	if err != nil {
+		h.writeErrors(w, http.StatusBadRequest, err)
+		return false
+	}
+	if req.Query == "" {
+		h.writeErrors(w, http.StatusBadRequest, errors.New("query is required"))
+		return false
+	}
+
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(req.Query),
+			Name: "GraphQL request",
+		}),
+	})
+	if err != nil {
+		h.writeResponse(w, http.StatusBadRequest, response{Errors: gqlerrors.FormatErrors(err)})
+		return false
+	}
+
+	validation := graphql.ValidateDocument(&h.schema, document, nil)
+	if !validation.IsValid {
+		h.writeResponse(w, http.StatusBadRequest, response{Errors: validation.Errors})
+		return false
+	}
+
+	cost, err := analyzeCost(document, req.OperationName, req.Variables)
+	if err == nil {
+		err = h.limits.check(cost)
+	}
+	if err != nil {
+		h.logger.Warn("GraphQL query rejected",
+			ports.NewField("error", err),
+			ports.NewField("depth", cost.Depth),
+			ports.NewField("complexity", cost.Complexity),
+			ports.NewField("request_id", ports.RequestIDFromContext(r.Context())),
+		)
+		h.writeErrors(w, http.StatusBadRequest, err)
+		return false
+	}
+
+	idempotencyKey := strings.TrimSpace(r.Header.Get("Idempotency-Key"))
+	if hasMutation(document, req.OperationName) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Allow", http.MethodPost)
+			h.writeErrors(w, http.StatusMethodNotAllowed, errors.New("mutations must be sent with POST"))
+			return false
+		}
+		if idempotencyKey != "" && cost.RootFields > 1 {
+			h.writeErrors(w, http.StatusBadRequest, errors.New("an Idempotency-Key can only be sent with a single mutation field"))
+			return false
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+	ctx = withRequestState(ctx, h.useCase, idempotencyKey)
+
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           document,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+
+	for i := range result.Errors {
+		result.Errors[i] = h.present(ctx, result.Errors[i])
+	}
+	h.writeResponse(w, http.StatusOK, response{Data: result.Data, Errors: result.Errors})
+	return len(result.Errors) == 0
+}
+
+func readRequest(w http.ResponseWriter, r *http.Request) (request, error) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			decoder := json.NewDecoder(strings.NewReader(variables))
+			decoder.UseNumber()
+			if err := decoder.Decode(&req.Variables); err != nil {
+				return request{}, errors.New("variables must be a JSON object")
+			}
+		}
+	default:
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+		decoder.UseNumber()
+		if err := decoder.Decode(&req); err != nil {
+			return request{}, errors.New("Invalid request body")
+		}
+	}
+	normalizeNumbers(req.Variables)
+	return req, nil
+}
+
+func normalizeNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return int(n)
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeNumbers(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeNumbers(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func hasMutation(document *ast.Document, operationName string) bool {
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok || operation.Operation != ast.OperationTypeMutation {
+			continue
+		}
+		if operationName == "" || (operation.Name != nil && operation.Name.Value == operationName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Handler) present(ctx context.Context, formatted gqlerrors.FormattedError) gqlerrors.FormattedError {
+	err := originalError(formatted.OriginalError())
+	if err == nil {
+		return formatted
+	}
+
+	recorder := &errorRecorder{header: make(http.Header)}
+	h.errorMapper.MapToHTTPError(recorder, err, ctx)
+
+	var problem handler.ProblemDetails
+	if decodeErr := json.Unmarshal(recorder.body.Bytes(), &problem); decodeErr != nil {
+		return formatted
+	}
+
+	formatted.Message = problem.Title
+	if problem.Detail != "" {
+		formatted.Message = problem.Detail
+	}
+	formatted.Extensions = map[string]interface{}{
+		"status": problem.Status,
+		"code":   problem.Code,
+		"type":   problem.Type,
+	}
+	if len(problem.Errors) > 0 {
+		formatted.Extensions["errors"] = problem.Errors
+	}
+	if problem.RequestID != "" {
+		formatted.Extensions["request_id"] = problem.RequestID
+	}
+	return formatted
+}
+
+func originalError(err error) error {
+	for err != nil {
+		switch e := err.(type) {
+		case gqlerrors.FormattedError:
+			err = e.OriginalError()
+		case *gqlerrors.Error:
+			if e.OriginalError == nil {
+				return err
+			}
+			err = e.OriginalError
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Handler) writeErrors(w http.ResponseWriter, status int, err error) {
+	h.writeResponse(w, status, response{Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(err.Error())}})
+}
+
+func (h *Handler) writeResponse(w http.ResponseWriter, status int, body response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		h.logger.Error("Failed to encode GraphQL response",
+			ports.NewField("error", err),
+		)
+	}
+}
+
+type errorRecorder struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (r *errorRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *errorRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+func (r *errorRecorder) WriteHeader(int) {}
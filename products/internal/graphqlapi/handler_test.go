@@ -0,0 +1,105 @@
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"product_service/products/internal/domain"
+	domainServices "product_service/products/internal/domain/services"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/usecase"
+	"product_service/products/mocks"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+)
+
+type graphqlTestEnv struct {
+	handler    *Handler
+	repo       *mocks.MockProductRepository
+	appService *mocks.MockProductApplicationService
+}
+
+func newGraphQLTestEnv(t *testing.T, limits Limits) graphqlTestEnv {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockProductRepository(ctrl)
+	appService := mocks.NewMockProductApplicationService(ctrl)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := usecase.NewProductUseCase(repo, appService, domainServices.NewProductDomainService(nil), logger)
+	h, err := NewHandler(useCase, handler.NewErrorMapper(logger), logger, nil, limits, time.Second)
+	if err != nil {
+		t.Fatalf("Failed to build GraphQL handler: %v", err)
+	}
+	return graphqlTestEnv{handler: h, repo: repo, appService: appService}
+}
+
+func postGraphQL(h *Handler, query, idempotencyKey string) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_IdempotencyKeyRequiresSingleMutation(t *testing.T) {
+	env := newGraphQLTestEnv(t, Limits{})
+
+	rec := postGraphQL(env.handler, `mutation {
+		a: createProduct(input: {name: "A", price: {amount: "1.00"}}) { id }
+		b: createProduct(input: {name: "B", price: {amount: "2.00"}}) { id }
+	}`, "retry-1")
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected 400 for several mutations with one key, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = postGraphQL(env.handler, `mutation {
+		...create
+	}
+	fragment create on Mutation {
+		a: createProduct(input: {name: "A", price: {amount: "1.00"}}) { id }
+		b: createProduct(input: {name: "B", price: {amount: "2.00"}}) { id }
+	}`, "retry-2")
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected 400 for mutations spread through a fragment, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	env.appService.EXPECT().
+		CreateProductWithEvent(gomock.Any(), gomock.Any(), "retry-3").
+		DoAndReturn(func(ctx context.Context, p *domain.Product, key string) error {
+			p.ID = 11
+			return nil
+		})
+	rec = postGraphQL(env.handler, `mutation { createProduct(input: {name: "A", price: {amount: "1.00"}}) { id } }`, "retry-3")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"id":11`) {
+		t.Fatalf("Expected the single mutation to run, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestHandler_SeveralMutationsWithoutIdempotencyKey(t *testing.T) {
+	env := newGraphQLTestEnv(t, Limits{})
+
+	env.appService.EXPECT().
+		CreateProductWithEvent(gomock.Any(), gomock.Any(), "").
+		Return(nil).
+		Times(2)
+	rec := postGraphQL(env.handler, `mutation {
+		a: createProduct(input: {name: "A", price: {amount: "1.00"}}) { name }
+		b: createProduct(input: {name: "B", price: {amount: "2.00"}}) { name }
+	}`, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
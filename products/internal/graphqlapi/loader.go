@@ -0,0 +1,93 @@
+package graphqlapi
+
+import (
+	"context"
+	"sync"
+
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase"
+)
+
+type productLoader struct {
+	useCase        usecase.ProductUseCase
+	includeDeleted bool
+
+	mu      sync.Mutex
+	pending []int
+	loaded  map[int]*domain.Product
+	errs    map[int]error
+}
+
+func newProductLoader(useCase usecase.ProductUseCase, includeDeleted bool) *productLoader {
+	return &productLoader{
+		useCase:        useCase,
+		includeDeleted: includeDeleted,
+		loaded:         make(map[int]*domain.Product),
+		errs:           make(map[int]error),
+	}
+}
+
+func (l *productLoader) Load(ctx context.Context, id int) func() (*domain.Product, error) {
+	l.mu.Lock()
+	if _, ok := l.loaded[id]; !ok {
+		if _, failed := l.errs[id]; !failed {
+			l.pending = append(l.pending, id)
+		}
+	}
+	l.mu.Unlock()
+
+	return func() (*domain.Product, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		l.flush(ctx)
+		if err, ok := l.errs[id]; ok {
+			return nil, err
+		}
+		return l.loaded[id], nil
+	}
+}
+
+func (l *productLoader) Prime(products []*domain.Product) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, product := range products {
+		if _, ok := l.loaded[product.ID]; !ok {
+			l.loaded[product.ID] = product
+		}
+	}
+}
+
+func (l *productLoader) flush(ctx context.Context) {
+	var ids []int
+	seen := make(map[int]struct{}, len(l.pending))
+	for _, id := range l.pending {
+		if _, ok := l.loaded[id]; ok {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	l.pending = nil
+
+	for len(ids) > 0 {
+		batch := ids[:min(len(ids), usecase.MaxProductLookupSize)]
+		ids = ids[len(batch):]
+
+		products, err := l.useCase.GetProductsByIDs(ctx, batch, l.includeDeleted)
+		for _, id := range batch {
+			if err != nil {
+				l.errs[id] = err
+				continue
+			}
+			l.loaded[id] = nil
+		}
+		for i := range products {
+			l.loaded[products[i].ID] = &products[i]
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase"
+)
+
+type lookupUseCase struct {
+	usecase.ProductUseCase
+	batches [][]int
+	missing map[int]bool
+	failOn  int
+}
+
+func (u *lookupUseCase) GetProductsByIDs(_ context.Context, ids []int, _ bool) ([]domain.Product, error) {
+	u.batches = append(u.batches, append([]int(nil), ids...))
+	if len(u.batches) == u.failOn {
+		return nil, errors.New("database unavailable")
+	}
+
+	products := make([]domain.Product, 0, len(ids))
+	for _, id := range ids {
+		if !u.missing[id] {
+			products = append(products, domain.Product{ID: id})
+		}
+	}
+	return products, nil
+}
+
+func TestProductLoader_SplitsBatchesAtLookupLimit(t *testing.T) {
+	useCase := &lookupUseCase{missing: map[int]bool{150: true}}
+	loader := newProductLoader(useCase, false)
+	ctx := context.Background()
+
+	total := 2*usecase.MaxProductLookupSize + 50
+	thunks := make([]func() (*domain.Product, error), 0, total+1)
+	for id := 1; id <= total; id++ {
+		thunks = append(thunks, loader.Load(ctx, id))
+	}
+	thunks = append(thunks, loader.Load(ctx, 1))
+
+	for i, thunk := range thunks {
+		product, err := thunk()
+		if err != nil {
+			t.Fatalf("Expected no error for thunk %d, got: %v", i, err)
+		}
+		id := i + 1
+		if i == total {
+			id = 1
+		}
+		if id == 150 {
+			if product != nil {
+				t.Errorf("Expected product 150 to be missing, got %v", product)
+			}
+			continue
+		}
+		if product == nil || product.ID != id {
+			t.Fatalf("Expected product %d, got %v", id, product)
+		}
+	}
+
+	wantSizes := []int{usecase.MaxProductLookupSize, usecase.MaxProductLookupSize, 50}
+	if len(useCase.batches) != len(wantSizes) {
+		t.Fatalf("Expected %d lookups, got %d", len(wantSizes), len(useCase.batches))
+	}
+	for i, size := range wantSizes {
+		if len(useCase.batches[i]) != size {
+			t.Errorf("Expected lookup %d to have %d ids, got %d", i, size, len(useCase.batches[i]))
+		}
+	}
+}
+
+func TestProductLoader_SkipsPrimedProducts(t *testing.T) {
+	useCase := &lookupUseCase{}
+	loader := newProductLoader(useCase, false)
+	ctx := context.Background()
+
+	loader.Prime([]*domain.Product{{ID: 1}})
+	first := loader.Load(ctx, 1)
+	second := loader.Load(ctx, 2)
+
+	if product, err := first(); err != nil || product == nil || product.ID != 1 {
+		t.Fatalf("Expected primed product 1, got %v, %v", product, err)
+	}
+	if product, err := second(); err != nil || product == nil || product.ID != 2 {
+		t.Fatalf("Expected product 2, got %v, %v", product, err)
+	}
+	if len(useCase.batches) != 1 || len(useCase.batches[0]) != 1 || useCase.batches[0][0] != 2 {
+		t.Errorf("Expected a single lookup of product 2, got %v", useCase.batches)
+	}
+}
+
+func TestProductLoader_FailedBatchOnlyFailsItsIDs(t *testing.T) {
+	useCase := &lookupUseCase{failOn: 2}
+	loader := newProductLoader(useCase, false)
+	ctx := context.Background()
+
+	thunks := make(map[int]func() (*domain.Product, error))
+	for id := 1; id <= usecase.MaxProductLookupSize+1; id++ {
+		thunks[id] = loader.Load(ctx, id)
+	}
+
+	if _, err := thunks[usecase.MaxProductLookupSize+1](); err == nil {
+		t.Error("Expected the id in the failed lookup to return its error")
+	}
+	if product, err := thunks[1](); err != nil || product == nil {
+		t.Errorf("Expected the first lookup to succeed, got %v, %v", product, err)
+	}
+
+	retry := loader.Load(ctx, usecase.MaxProductLookupSize+1)
+	if _, err := retry(); err == nil {
+		t.Error("Expected a failed id to keep its error within the request")
+	}
+	if len(useCase.batches) != 2 {
+		t.Errorf("Expected failed ids not to be looked up again, got %d lookups", len(useCase.batches))
+	}
+}
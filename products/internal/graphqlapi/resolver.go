@@ -0,0 +1,311 @@
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+type resolver struct {
+	useCase usecase.ProductUseCase
+	metrics ports.MetricsCollector
+	logger  ports.Logger
+}
+
+func (r *resolver) product(p graphql.ResolveParams) (interface{}, error) {
+	id, _ := p.Args["id"].(int)
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+	includeDeleted, _ := p.Args["includeDeleted"].(bool)
+
+	load := requestFrom(p.Context).loader(includeDeleted).Load(p.Context, id)
+	return func() (interface{}, error) {
+		product, err := load()
+		if err != nil {
+			return nil, err
+		}
+		if product == nil {
+			return nil, fmt.Errorf("product %d: %w", id, domain.ErrProductNotFound)
+		}
+		return product, nil
+	}, nil
+}
+
+func (r *resolver) parent(p graphql.ResolveParams) (interface{}, error) {
+	child := p.Source.(*domain.Product)
+	if child.ParentID == nil {
+		return nil, nil
+	}
+
+	load := requestFrom(p.Context).loader(false).Load(p.Context, *child.ParentID)
+	return func() (interface{}, error) {
+		product, err := load()
+		if err != nil || product == nil {
+			return nil, err
+		}
+		return product, nil
+	}, nil
+}
+
+func (r *resolver) products(p graphql.ResolveParams) (interface{}, error) {
+	query, err := listQuery(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.useCase.GetProducts(p.Context, query)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*domain.Product, len(result.Products))
+	for i := range result.Products {
+		nodes[i] = &result.Products[i]
+	}
+	requestFrom(p.Context).loader(query.IncludeDeleted).Prime(nodes)
+
+	connection := map[string]interface{}{
+		"nodes":      nodes,
+		"nextCursor": optionalString(handler.EncodeCursor(result.NextCursor)),
+		"prevCursor": optionalString(handler.EncodeCursor(result.PrevCursor)),
+	}
+	if !query.IsCursorMode() {
+		connection["totalCount"] = result.Total
+	}
+	return connection, nil
+}
+
+func (r *resolver) createProduct(p graphql.ResolveParams) (interface{}, error) {
+	input, _ := p.Args["input"].(map[string]interface{})
+	name, _ := input["name"].(string)
+
+	price, prices, err := createPrices(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes domain.Attributes
+	if raw, ok := input["attributes"]; ok && raw != nil {
+		values, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: attributes must be an object", domain.ErrInvalidAttribute)
+		}
+		if attributes, err = domain.NewAttributes(values); err != nil {
+			return nil, err
+		}
+	}
+
+	product, err := r.useCase.CreateProduct(p.Context, name, price, prices, attributes, requestFrom(p.Context).idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.metrics != nil {
+		r.metrics.IncrementProductsCreated()
+	}
+
+	r.logger.Info("Product created",
+		ports.NewField("id", product.ID),
+		ports.NewField("name", product.Name.Value()),
+	)
+	return product, nil
+}
+
+func (r *resolver) deleteProduct(p graphql.ResolveParams) (interface{}, error) {
+	id, _ := p.Args["id"].(int)
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+	expectedVersion, _ := p.Args["expectedVersion"].(int)
+	if expectedVersion < 0 {
+		return nil, fmt.Errorf("invalid expected version: %w", domain.ErrInvalidInput)
+	}
+
+	if err := r.useCase.DeleteProduct(p.Context, id, expectedVersion, requestFrom(p.Context).idempotencyKey); err != nil {
+		return nil, err
+	}
+
+	if r.metrics != nil {
+		r.metrics.IncrementProductsDeleted()
+	}
+
+	r.logger.Info("Product deleted",
+		ports.NewField("id", id),
+	)
+	return map[string]interface{}{"id": id}, nil
+}
+
+func listQuery(args map[string]interface{}) (ports.ProductListQuery, error) {
+	query := ports.ProductListQuery{
+		Page:  1,
+		Limit: defaultPageSize,
+		Sort:  ports.DefaultProductSort(),
+	}
+	query.IncludeDeleted, _ = args["includeDeleted"].(bool)
+
+	if first, ok := args["first"].(int); ok {
+		if first < 1 || first > maxPageSize {
+			return ports.ProductListQuery{}, fmt.Errorf("%w: first must be between 1 and %d", domain.ErrInvalidInput, maxPageSize)
+		}
+		query.Limit = first
+	}
+
+	if page, ok := args["page"].(int); ok {
+		if page < 1 {
+			return ports.ProductListQuery{}, fmt.Errorf("%w: page must be positive", domain.ErrInvalidInput)
+		}
+		query.Page = page
+	}
+
+	if sort, ok := args["sort"].(string); ok && sort != "" {
+		parsed, err := handler.ParseSort(sort)
+		if err != nil {
+			return ports.ProductListQuery{}, fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
+		}
+		query.Sort = parsed
+	}
+
+	if token, ok := args["cursor"].(string); ok && token != "" {
+		if _, ok := args["page"]; ok {
+			return ports.ProductListQuery{}, fmt.Errorf("%w: page and cursor cannot be combined", domain.ErrInvalidInput)
+		}
+		cursor, err := handler.DecodeCursor(token)
+		if err != nil {
+			return ports.ProductListQuery{}, fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
+		}
+		if sort, ok := args["sort"].(string); ok && sort != "" && cursor.Sort != query.Sort {
+			return ports.ProductListQuery{}, fmt.Errorf("%w: sort does not match cursor sort %q", domain.ErrInvalidInput, cursor.Sort.String())
+		}
+		query.Cursor = cursor
+		query.Sort = cursor.Sort
+	}
+
+	if raw, ok := args["filter"].(map[string]interface{}); ok {
+		filter, err := listFilter(raw)
+		if err != nil {
+			return ports.ProductListQuery{}, err
+		}
+		query.Filter = filter
+	}
+
+	return query, nil
+}
+
+func listFilter(raw map[string]interface{}) (ports.ProductListFilter, error) {
+	var filter ports.ProductListFilter
+	filter.NameContains, _ = raw["nameContains"].(string)
+	filter.NamePrefix, _ = raw["namePrefix"].(string)
+
+	if parentID, ok := raw["parentId"].(int); ok {
+		if parentID <= 0 {
+			return ports.ProductListFilter{}, fmt.Errorf("invalid parentId: %w", domain.ErrInvalidInput)
+		}
+		filter.ParentID = &parentID
+	}
+
+	statuses, _ := raw["statuses"].([]interface{})
+	for _, value := range statuses {
+		name, _ := value.(string)
+		status, err := domain.ParseProductStatus(name)
+		if err != nil {
+			return ports.ProductListFilter{}, err
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	return filter, nil
+}
+
+func createPrices(input map[string]interface{}) (domain.Money, []domain.Money, error) {
+	price, err := parseMoney(input["price"])
+	if err != nil {
+		return domain.Money{}, nil, err
+	}
+
+	values, _ := input["prices"].([]interface{})
+	prices := make([]domain.Money, 0, len(values))
+	for _, value := range values {
+		money, err := parseMoney(value)
+		if err != nil {
+			return domain.Money{}, nil, err
+		}
+		prices = append(prices, money)
+	}
+	slices.SortFunc(prices, func(a, b domain.Money) int {
+		return strings.Compare(a.Currency(), b.Currency())
+	})
+
+	return price, prices, nil
+}
+
+func parseMoney(value interface{}) (domain.Money, error) {
+	input, _ := value.(map[string]interface{})
+	amount, _ := input["amount"].(string)
+	currency, _ := input["currency"].(string)
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+	return domain.ParseMoney(amount, currency)
+}
+
+func sortedPrices(p *domain.Product) []domain.Money {
+	currencies := make([]string, 0, len(p.Prices))
+	for currency := range p.Prices {
+		currencies = append(currencies, currency)
+	}
+	slices.Sort(currencies)
+
+	prices := make([]domain.Money, 0, len(currencies))
+	for _, currency := range currencies {
+		prices = append(prices, p.Prices[currency])
+	}
+	return prices
+}
+
+func optionalString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+type requestState struct {
+	idempotencyKey string
+	active         *productLoader
+	withDeleted    *productLoader
+}
+
+type requestStateKey struct{}
+
+func withRequestState(ctx context.Context, useCase usecase.ProductUseCase, idempotencyKey string) context.Context {
+	return context.WithValue(ctx, requestStateKey{}, &requestState{
+		idempotencyKey: idempotencyKey,
+		active:         newProductLoader(useCase, false),
+		withDeleted:    newProductLoader(useCase, true),
+	})
+}
+
+func requestFrom(ctx context.Context) *requestState {
+	return ctx.Value(requestStateKey{}).(*requestState)
+}
+
+func (s *requestState) loader(includeDeleted bool) *productLoader {
+	if includeDeleted {
+		return s.withDeleted
+	}
+	return s.active
+}
@@ -0,0 +1,55 @@
+package graphqlapi
+
+import (
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var jsonScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "Arbitrary JSON value.",
+	Serialize: func(value interface{}) interface{} {
+		return value
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return value
+	},
+	ParseLiteral: func(value ast.Value) interface{} {
+		return parseJSONLiteral(value)
+	},
+})
+
+func parseJSONLiteral(value ast.Value) interface{} {
+	switch v := value.(type) {
+	case *ast.StringValue:
+		return v.Value
+	case *ast.BooleanValue:
+		return v.Value
+	case *ast.IntValue:
+		if n, err := strconv.ParseFloat(v.Value, 64); err == nil {
+			return n
+		}
+		return nil
+	case *ast.FloatValue:
+		if n, err := strconv.ParseFloat(v.Value, 64); err == nil {
+			return n
+		}
+		return nil
+	case *ast.ListValue:
+		values := make([]interface{}, 0, len(v.Values))
+		for _, item := range v.Values {
+			values = append(values, parseJSONLiteral(item))
+		}
+		return values
+	case *ast.ObjectValue:
+		values := make(map[string]interface{}, len(v.Fields))
+		for _, field := range v.Fields {
+			values[field.Name.Value] = parseJSONLiteral(field.Value)
+		}
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,195 @@
+package graphqlapi
+
+import (
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"product_service/products/internal/domain"
+)
+
+func newSchema(r *resolver) (graphql.Schema, error) {
+	money := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Money",
+		Fields: graphql.Fields{
+			"amount": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.Money).Amount(), nil
+				},
+			},
+			"currency": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.Money).Currency(), nil
+				},
+			},
+		},
+	})
+
+	product := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Product",
+		Fields: graphql.Fields{
+			"id": productField(graphql.NewNonNull(graphql.Int), func(p *domain.Product) interface{} {
+				return p.ID
+			}),
+			"name": productField(graphql.NewNonNull(graphql.String), func(p *domain.Product) interface{} {
+				return p.Name.Value()
+			}),
+			"price": productField(graphql.NewNonNull(money), func(p *domain.Product) interface{} {
+				return p.Price
+			}),
+			"prices": productField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(money))), func(p *domain.Product) interface{} {
+				return sortedPrices(p)
+			}),
+			"status": productField(graphql.NewNonNull(graphql.String), func(p *domain.Product) interface{} {
+				return p.Status.String()
+			}),
+			"version": productField(graphql.NewNonNull(graphql.Int), func(p *domain.Product) interface{} {
+				return p.Version
+			}),
+			"parentId": productField(graphql.Int, func(p *domain.Product) interface{} {
+				if p.ParentID == nil {
+					return nil
+				}
+				return *p.ParentID
+			}),
+			"attributes": productField(graphql.NewNonNull(jsonScalar), func(p *domain.Product) interface{} {
+				return p.EffectiveAttributes().Values()
+			}),
+			"inheritedAttributes": productField(jsonScalar, func(p *domain.Product) interface{} {
+				if len(p.InheritedAttributes) == 0 {
+					return nil
+				}
+				return p.InheritedAttributes.Values()
+			}),
+			"createdAt": productField(graphql.NewNonNull(graphql.String), func(p *domain.Product) interface{} {
+				return p.CreatedAt.UTC().Format(time.RFC3339Nano)
+			}),
+			"deletedAt": productField(graphql.String, func(p *domain.Product) interface{} {
+				if p.DeletedAt == nil {
+					return nil
+				}
+				return p.DeletedAt.UTC().Format(time.RFC3339Nano)
+			}),
+		},
+	})
+	product.AddFieldConfig("parent", &graphql.Field{
+		Type:    product,
+		Resolve: r.parent,
+	})
+
+	connection := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProductConnection",
+		Fields: graphql.Fields{
+			"nodes": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(product))),
+			},
+			"totalCount": &graphql.Field{
+				Type: graphql.Int,
+			},
+			"nextCursor": &graphql.Field{
+				Type: graphql.String,
+			},
+			"prevCursor": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+
+	filter := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ProductFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"nameContains": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"namePrefix":   &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"statuses":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			"parentId":     &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		},
+	})
+
+	moneyInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "MoneyInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"amount":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"currency": &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+
+	createInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateProductInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"price":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(moneyInput)},
+			"prices":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(moneyInput))},
+			"attributes": &graphql.InputObjectFieldConfig{Type: jsonScalar},
+		},
+	})
+
+	deletePayload := graphql.NewObject(graphql.ObjectConfig{
+		Name: "DeleteProductPayload",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"product": &graphql.Field{
+				Type: product,
+				Args: graphql.FieldConfigArgument{
+					"id":             &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"includeDeleted": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+				},
+				Resolve: r.product,
+			},
+			"products": &graphql.Field{
+				Type: graphql.NewNonNull(connection),
+				Args: graphql.FieldConfigArgument{
+					"filter":         &graphql.ArgumentConfig{Type: filter},
+					"first":          &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultPageSize},
+					"page":           &graphql.ArgumentConfig{Type: graphql.Int},
+					"cursor":         &graphql.ArgumentConfig{Type: graphql.String},
+					"sort":           &graphql.ArgumentConfig{Type: graphql.String},
+					"includeDeleted": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+				},
+				Resolve: r.products,
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createProduct": &graphql.Field{
+				Type: graphql.NewNonNull(product),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(createInput)},
+				},
+				Resolve: r.createProduct,
+			},
+			"deleteProduct": &graphql.Field{
+				Type: graphql.NewNonNull(deletePayload),
+				Args: graphql.FieldConfigArgument{
+					"id":              &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"expectedVersion": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.deleteProduct,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:    query,
+		Mutation: mutation,
+	})
+}
+
+func productField(fieldType graphql.Output, value func(*domain.Product) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: fieldType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return value(p.Source.(*domain.Product)), nil
+		},
+	}
+}
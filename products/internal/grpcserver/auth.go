@@ -0,0 +1,148 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"product_service/products/internal/domain"
+	"product_service/products/internal/middleware"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	authorizationMetadata = "authorization"
+	bearerPrefix          = "bearer "
+)
+
+type authInterceptor struct {
+	verifier    ports.TokenVerifier
+	keys        middleware.APIKeyAuthenticator
+	errors      *ErrorMapper
+	tenantClaim string
+	rolesClaim  string
+	logger      ports.Logger
+}
+
+func NewAuthInterceptor(
+	verifier ports.TokenVerifier,
+	keys middleware.APIKeyAuthenticator,
+	errors *ErrorMapper,
+	tenantClaim, rolesClaim string,
+	logger ports.Logger,
+) Interceptor {
+	auth := &authInterceptor{
+		verifier:    verifier,
+		keys:        keys,
+		errors:      errors,
+		tenantClaim: tenantClaim,
+		rolesClaim:  rolesClaim,
+		logger:      logger,
+	}
+	return interceptorFunc(auth.authenticate)
+}
+
+func (a *authInterceptor) authenticate(ctx context.Context, method string, next func(context.Context) error) error {
+	if plaintext := firstMetadata(ctx, apiKeyMetadata); plaintext != "" {
+		authenticated, err := a.authenticateAPIKey(ctx, plaintext)
+		if err != nil {
+			return a.reject(ctx, method, err)
+		}
+		return next(authenticated)
+	}
+
+	if a.verifier == nil {
+		return next(ctx)
+	}
+
+	authenticated, err := a.authenticateToken(ctx)
+	if err != nil {
+		return a.reject(ctx, method, err)
+	}
+	return next(authenticated)
+}
+
+func (a *authInterceptor) authenticateAPIKey(ctx context.Context, plaintext string) (context.Context, error) {
+	key, err := a.keys.AuthenticateAPIKey(ctx, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	if !middleware.ValidTenantID(key.TenantID) {
+		return nil, fmt.Errorf("api key %s has an invalid tenant: %w", key.Prefix, domain.ErrInvalidAPIKey)
+	}
+	if err := middleware.CheckTenantBinding(firstMetadata(ctx, tenantMetadata), key.TenantID); err != nil {
+		return nil, err
+	}
+
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = scope.String()
+	}
+	principal := &domain.Principal{
+		Subject: "api_key:" + key.Prefix,
+		Claims: map[string]interface{}{
+			"api_key_id": strconv.FormatInt(key.ID, 10),
+			"scope":      strings.Join(scopes, " "),
+			"tenant_id":  key.TenantID,
+		},
+	}
+
+	ctx = ports.WithAPIKey(ctx, key)
+	ctx = ports.WithPrincipal(ctx, principal)
+	ctx = ports.WithActor(ctx, principal.Subject)
+	return ports.WithTenant(ctx, key.TenantID), nil
+}
+
+func (a *authInterceptor) authenticateToken(ctx context.Context) (context.Context, error) {
+	token, err := bearerToken(firstMetadata(ctx, authorizationMetadata))
+	if err != nil {
+		return nil, err
+	}
+
+	principal, err := a.verifier.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	if a.rolesClaim != "" {
+		principal.Roles = principal.StringsClaim(a.rolesClaim)
+	}
+
+	ctx = ports.WithPrincipal(ctx, principal)
+	ctx = ports.WithActor(ctx, "user:"+principal.Subject)
+
+	if a.tenantClaim != "" {
+		tenant, ok := principal.StringClaim(a.tenantClaim)
+		if !ok {
+			return nil, fmt.Errorf("missing %s claim: %w", a.tenantClaim, domain.ErrInvalidToken)
+		}
+		tenant = strings.ToLower(tenant)
+		if !middleware.ValidTenantID(tenant) {
+			return nil, fmt.Errorf("invalid %s claim: %w", a.tenantClaim, domain.ErrInvalidToken)
+		}
+		if err := middleware.CheckTenantBinding(firstMetadata(ctx, tenantMetadata), tenant); err != nil {
+			return nil, err
+		}
+		ctx = ports.WithTenant(ctx, tenant)
+	}
+	return ctx, nil
+}
+
+func (a *authInterceptor) reject(ctx context.Context, method string, err error) error {
+	a.logger.Warn("gRPC authentication failed",
+		ports.NewField("error", err),
+		ports.NewField("method", method),
+		ports.NewField("request_id", ports.RequestIDFromContext(ctx)),
+	)
+	return a.errors.ToStatus(ctx, err)
+}
+
+func bearerToken(value string) (string, error) {
+	if value == "" {
+		return "", fmt.Errorf("missing bearer token: %w", domain.ErrUnauthenticated)
+	}
+	if len(value) <= len(bearerPrefix) || !strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+		return "", fmt.Errorf("authorization metadata is not a bearer token: %w", domain.ErrInvalidToken)
+	}
+	return strings.TrimSpace(value[len(bearerPrefix):]), nil
+}
@@ -0,0 +1,159 @@
+package grpcserver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+)
+
+const errorDomain = "products.v1"
+
+type ErrorMapper struct {
+	logger ports.Logger
+}
+
+func NewErrorMapper(logger ports.Logger) *ErrorMapper {
+	return &ErrorMapper{
+		logger: logger,
+	}
+}
+
+func (m *ErrorMapper) ToStatus(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code, message, reason := classify(ctx, err)
+	requestID := ports.RequestIDFromContext(ctx)
+
+	fields := []ports.Field{
+		ports.NewField("error", err),
+		ports.NewField("code", reason),
+		ports.NewField("request_id", requestID),
+	}
+	switch code {
+	case codes.Internal, codes.Unavailable:
+		m.logger.Error("gRPC request failed", fields...)
+	default:
+		m.logger.Warn("gRPC request rejected", fields...)
+	}
+
+	st := status.New(code, message)
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}
+	if requestID != "" {
+		info.Metadata = map[string]string{"request_id": requestID}
+	}
+	if detailed, detailErr := st.WithDetails(info); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func (m *ErrorMapper) InvalidArgument(ctx context.Context, message string) error {
+	return m.ToStatus(ctx, &invalidArgumentError{message: message})
+}
+
+type invalidArgumentError struct {
+	message string
+}
+
+func (e *invalidArgumentError) Error() string {
+	return e.message
+}
+
+func classify(ctx context.Context, err error) (codes.Code, string, string) {
+	var invalid *invalidArgumentError
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || ctx.Err() == context.DeadlineExceeded:
+		return codes.DeadlineExceeded, "Request timeout", "TIMEOUT"
+	case errors.Is(err, context.Canceled) || ctx.Err() == context.Canceled:
+		return codes.Canceled, "Request canceled", "CANCELED"
+	case errors.As(err, &invalid):
+		return codes.InvalidArgument, invalid.message, "INVALID_INPUT"
+
+	case errors.Is(err, domain.ErrTokenExpired):
+		return codes.Unauthenticated, "Token has expired", "TOKEN_EXPIRED"
+	case errors.Is(err, domain.ErrInvalidToken):
+		return codes.Unauthenticated, "Invalid token", "INVALID_TOKEN"
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return codes.Unauthenticated, "Authentication required", "UNAUTHENTICATED"
+	case errors.Is(err, domain.ErrInvalidAPIKey):
+		return codes.Unauthenticated, "Invalid API key", "INVALID_API_KEY"
+	case errors.Is(err, domain.ErrInsufficientScope):
+		return codes.PermissionDenied, err.Error(), "INSUFFICIENT_SCOPE"
+	case errors.Is(err, domain.ErrForbidden):
+		return codes.PermissionDenied, "You do not have permission to perform this action", "FORBIDDEN"
+
+	case errors.Is(err, domain.ErrProductNotFound):
+		return codes.NotFound, "Product not found", "PRODUCT_NOT_FOUND"
+	case errors.Is(err, domain.ErrInvalidProductName):
+		return codes.InvalidArgument, "Invalid product name", "INVALID_PRODUCT_NAME"
+	case errors.Is(err, domain.ErrMalformedPrice),
+		errors.Is(err, domain.ErrInvalidPriceScale),
+		errors.Is(err, domain.ErrPriceOutOfRange):
+		return codes.InvalidArgument, err.Error(), "INVALID_PRODUCT_PRICE"
+	case errors.Is(err, domain.ErrInvalidProductPrice):
+		return codes.InvalidArgument, "Invalid product price", "INVALID_PRODUCT_PRICE"
+	case errors.Is(err, domain.ErrVersionConflict):
+		return codes.Aborted, "Product has been modified", "PRECONDITION_FAILED"
+	case errors.Is(err, domain.ErrProductNotDeleted):
+		return codes.FailedPrecondition, "Product is not deleted", "PRODUCT_NOT_DELETED"
+	case errors.Is(err, domain.ErrPriceNotAvailable):
+		return codes.NotFound, "Price not available in requested currency", "PRICE_NOT_AVAILABLE"
+	case errors.Is(err, domain.ErrInvalidCurrency):
+		return codes.InvalidArgument, "Invalid currency code", "INVALID_CURRENCY"
+	case errors.Is(err, domain.ErrInvalidAttribute):
+		return codes.InvalidArgument, "Invalid product attribute", "INVALID_ATTRIBUTE"
+	case errors.Is(err, domain.ErrInvalidProductStatus):
+		return codes.InvalidArgument, "Invalid product status", "INVALID_PRODUCT_STATUS"
+	case errors.Is(err, domain.ErrInvalidStatusTransition):
+		return codes.FailedPrecondition, err.Error(), "INVALID_STATUS_TRANSITION"
+	case errors.Is(err, domain.ErrInvalidVariantParent):
+		return codes.FailedPrecondition, "Product cannot have variants", "INVALID_VARIANT_PARENT"
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		return codes.NotFound, "Category not found", "CATEGORY_NOT_FOUND"
+	case errors.Is(err, domain.ErrInvalidCategory):
+		return codes.InvalidArgument, "Invalid category", "INVALID_CATEGORY"
+	case errors.Is(err, domain.ErrInvalidInput):
+		return codes.InvalidArgument, "Invalid input", "INVALID_INPUT"
+
+	case usecase.IsProductNotFound(err):
+		return codes.NotFound, "Product not found", "PRODUCT_NOT_FOUND"
+	case usecase.IsInvalidProductName(err):
+		return codes.InvalidArgument, err.Error(), "INVALID_PRODUCT_NAME"
+	case usecase.IsInvalidProductPrice(err):
+		return codes.InvalidArgument, err.Error(), "INVALID_PRODUCT_PRICE"
+
+	case errors.Is(err, sql.ErrNoRows):
+		return codes.NotFound, "Resource not found", "NOT_FOUND"
+	case errors.Is(err, sql.ErrConnDone) || errors.Is(err, sql.ErrTxDone):
+		return codes.Unavailable, "Database connection error", "DATABASE_CONNECTION_ERROR"
+	case isDatabaseError(err):
+		return codes.Internal, "Database error occurred", "DATABASE_ERROR"
+	default:
+		return codes.Internal, "Internal server error", "INTERNAL_ERROR"
+	}
+}
+
+func isDatabaseError(err error) bool {
+	message := err.Error()
+	for _, keyword := range []string{"database", "sql", "connection", "transaction", "postgres", "pgx"} {
+		if strings.Contains(message, keyword) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,222 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"product_service/products/internal/middleware"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	requestIDMetadata = "x-request-id"
+	tenantMetadata    = "x-tenant-id"
+	userMetadata      = "x-user-id"
+	apiKeyMetadata    = "x-api-key"
+
+	anonymousActor = "anonymous"
+	maxActorLength = 255
+)
+
+type Interceptor interface {
+	Unary() grpc.UnaryServerInterceptor
+	Stream() grpc.StreamServerInterceptor
+}
+
+type interceptorFunc func(ctx context.Context, method string, next func(context.Context) error) error
+
+func (f interceptorFunc) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var resp interface{}
+		err := f(ctx, info.FullMethod, func(ctx context.Context) error {
+			var err error
+			resp, err = handler(ctx, req)
+			return err
+		})
+		return resp, err
+	}
+}
+
+func (f interceptorFunc) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return f(ss.Context(), info.FullMethod, func(ctx context.Context) error {
+			return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func TracingInterceptor() Interceptor {
+	tracer := otel.Tracer("product_service")
+	return interceptorFunc(func(ctx context.Context, method string, next func(context.Context) error) error {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(incomingMetadata(ctx)))
+		ctx, span := tracer.Start(ctx, strings.TrimPrefix(method, "/"),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "grpc"),
+				attribute.String("rpc.method", method),
+			),
+		)
+		defer span.End()
+
+		err := next(ctx)
+		span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(status.Code(err))))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, status.Convert(err).Message())
+		}
+		return err
+	})
+}
+
+func RequestIDInterceptor() Interceptor {
+	return interceptorFunc(func(ctx context.Context, method string, next func(context.Context) error) error {
+		requestID := firstMetadata(ctx, requestIDMetadata)
+		if requestID == "" {
+			requestID = generateRequestID()
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadata, requestID))
+
+		return next(ports.WithRequestID(ctx, requestID))
+	})
+}
+
+func TenantInterceptor() Interceptor {
+	return interceptorFunc(func(ctx context.Context, method string, next func(context.Context) error) error {
+		tenant := strings.ToLower(firstMetadata(ctx, tenantMetadata))
+		if tenant == "" {
+			tenant = ports.DefaultTenant
+		}
+		if !middleware.ValidTenantID(tenant) {
+			return status.Error(codes.InvalidArgument, "Invalid x-tenant-id metadata")
+		}
+
+		ctx = ports.WithTenant(ctx, tenant)
+		return next(ports.WithActor(ctx, resolveActor(ctx)))
+	})
+}
+
+func LoggingInterceptor(logger ports.Logger) Interceptor {
+	return interceptorFunc(func(ctx context.Context, method string, next func(context.Context) error) error {
+		start := time.Now()
+
+		err := next(ctx)
+
+		logger.Info("gRPC request",
+			ports.NewField("request_id", ports.RequestIDFromContext(ctx)),
+			ports.NewField("method", method),
+			ports.NewField("code", status.Code(err).String()),
+			ports.NewField("duration", time.Since(start)),
+		)
+		return err
+	})
+}
+
+func RecoveryInterceptor(logger ports.Logger) Interceptor {
+	return interceptorFunc(func(ctx context.Context, method string, next func(context.Context) error) (err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				logger.Error("Panic recovered",
+					ports.NewField("error", fmt.Sprint(recovered)),
+					ports.NewField("method", method),
+					ports.NewField("request_id", ports.RequestIDFromContext(ctx)),
+				)
+				err = status.Error(codes.Internal, "Internal server error")
+			}
+		}()
+
+		return next(ctx)
+	})
+}
+
+func MetricsInterceptor(metricsCollector ports.MetricsCollector) Interceptor {
+	return interceptorFunc(func(ctx context.Context, method string, next func(context.Context) error) error {
+		start := time.Now()
+
+		err := next(ctx)
+
+		code := status.Code(err).String()
+		metricsCollector.RecordGRPCRequestDuration(method, code, time.Since(start))
+		metricsCollector.IncrementGRPCRequestCount(method, code)
+		return err
+	})
+}
+
+func incomingMetadata(ctx context.Context) metadata.MD {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return md
+}
+
+func firstMetadata(ctx context.Context, key string) string {
+	if values := incomingMetadata(ctx).Get(key); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+func resolveActor(ctx context.Context) string {
+	if apiKey := firstMetadata(ctx, apiKeyMetadata); apiKey != "" {
+		sum := sha256.Sum256([]byte(apiKey))
+		return "api_key:" + hex.EncodeToString(sum[:6])
+	}
+
+	if user := firstMetadata(ctx, userMetadata); user != "" {
+		if len(user) > maxActorLength-len("user:") {
+			user = user[:maxActorLength-len("user:")]
+		}
+		return "user:" + user
+	}
+
+	return anonymousActor
+}
+
+func generateRequestID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().UTC().Format(time.RFC3339Nano)))
+	}
+	return hex.EncodeToString(bytes)
+}
+
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,170 @@
+package grpcserver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	productsv1 "product_service/products/api/proto/products/v1"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/usecase/ports"
+)
+
+const defaultPageSize = 10
+
+func toProductMessage(p *domain.Product) (*productsv1.Product, error) {
+	attributes, err := structpb.NewStruct(p.EffectiveAttributes().Values())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode product attributes: %w", err)
+	}
+
+	message := &productsv1.Product{
+		Id:         int64(p.ID),
+		Name:       p.Name.Value(),
+		Price:      toMoneyMessage(p.Price),
+		Status:     p.Status.String(),
+		Version:    int64(p.Version),
+		Attributes: attributes,
+		CreatedAt:  timestamppb.New(p.CreatedAt),
+	}
+	if len(p.InheritedAttributes) > 0 {
+		if message.InheritedAttributes, err = structpb.NewStruct(p.InheritedAttributes.Values()); err != nil {
+			return nil, fmt.Errorf("failed to encode inherited attributes: %w", err)
+		}
+	}
+	if p.ParentID != nil {
+		message.ParentId = int64(*p.ParentID)
+	}
+	if p.DeletedAt != nil {
+		message.DeletedAt = timestamppb.New(*p.DeletedAt)
+	}
+
+	currencies := make([]string, 0, len(p.Prices))
+	for currency := range p.Prices {
+		currencies = append(currencies, currency)
+	}
+	slices.Sort(currencies)
+	for _, currency := range currencies {
+		message.Prices = append(message.Prices, toMoneyMessage(p.Prices[currency]))
+	}
+
+	return message, nil
+}
+
+func toMoneyMessage(m domain.Money) *productsv1.Money {
+	return &productsv1.Money{
+		Amount:   m.Amount(),
+		Currency: m.Currency(),
+	}
+}
+
+func createPrices(req *productsv1.CreateProductRequest) (domain.Money, []domain.Money, error) {
+	prices := make([]domain.Money, 0, len(req.GetPrices()))
+	for _, input := range req.GetPrices() {
+		price, err := domain.ParseMoney(input.GetAmount(), input.GetCurrency())
+		if err != nil {
+			return domain.Money{}, nil, err
+		}
+		prices = append(prices, price)
+	}
+	slices.SortFunc(prices, func(a, b domain.Money) int {
+		return strings.Compare(a.Currency(), b.Currency())
+	})
+
+	if req.GetPrice() != nil {
+		currency := req.GetPrice().GetCurrency()
+		if currency == "" {
+			currency = domain.DefaultCurrency
+		}
+		price, err := domain.ParseMoney(req.GetPrice().GetAmount(), currency)
+		return price, prices, err
+	}
+
+	for _, price := range prices {
+		if price.Currency() == domain.DefaultCurrency {
+			return price, prices, nil
+		}
+	}
+	if len(prices) == 0 {
+		return domain.Money{}, nil, &invalidArgumentError{message: "price or prices is required"}
+	}
+	return domain.Money{}, nil, &invalidArgumentError{message: fmt.Sprintf("price in base currency %s is required", domain.DefaultCurrency)}
+}
+
+func createAttributes(values *structpb.Struct) (domain.Attributes, error) {
+	if values == nil {
+		return nil, nil
+	}
+	return domain.NewAttributes(values.AsMap())
+}
+
+func listQuery(req *productsv1.ListProductsRequest) (ports.ProductListQuery, error) {
+	query := ports.ProductListQuery{
+		Page:           1,
+		Limit:          int(req.GetPageSize()),
+		Sort:           ports.DefaultProductSort(),
+		IncludeDeleted: req.GetIncludeDeleted(),
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultPageSize
+	}
+
+	if req.GetSort() != "" {
+		sort, err := handler.ParseSort(req.GetSort())
+		if err != nil {
+			return ports.ProductListQuery{}, err
+		}
+		query.Sort = sort
+	}
+
+	if req.GetPageToken() != "" {
+		cursor, err := handler.DecodeCursor(req.GetPageToken())
+		if err != nil {
+			return ports.ProductListQuery{}, fmt.Errorf("invalid page_token: %w", err)
+		}
+		if req.GetSort() != "" && cursor.Sort != query.Sort {
+			return ports.ProductListQuery{}, fmt.Errorf("sort %q does not match page_token sort %q", query.Sort.String(), cursor.Sort.String())
+		}
+		query.Cursor = cursor
+		query.Sort = cursor.Sort
+	}
+
+	filter, err := listFilter(req.GetFilter())
+	if err != nil {
+		return ports.ProductListQuery{}, err
+	}
+	query.Filter = filter
+
+	return query, nil
+}
+
+func listFilter(filter *productsv1.ProductFilter) (ports.ProductListFilter, error) {
+	if filter == nil {
+		return ports.ProductListFilter{}, nil
+	}
+
+	result := ports.ProductListFilter{
+		NameContains: filter.GetNameContains(),
+		NamePrefix:   filter.GetNamePrefix(),
+	}
+	if filter.GetParentId() < 0 {
+		return ports.ProductListFilter{}, fmt.Errorf("invalid parent_id: %d", filter.GetParentId())
+	}
+	if filter.GetParentId() > 0 {
+		parentID := int(filter.GetParentId())
+		result.ParentID = &parentID
+	}
+	for _, value := range filter.GetStatuses() {
+		status, err := domain.ParseProductStatus(value)
+		if err != nil {
+			return ports.ProductListFilter{}, err
+		}
+		result.Statuses = append(result.Statuses, status)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,185 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	productsv1 "product_service/products/api/proto/products/v1"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+)
+
+type ProductService struct {
+	productsv1.UnimplementedProductServiceServer
+
+	useCase        usecase.ProductUseCase
+	errors         *ErrorMapper
+	logger         ports.Logger
+	metrics        ports.MetricsCollector
+	requestTimeout time.Duration
+}
+
+func NewProductService(
+	useCase usecase.ProductUseCase,
+	errors *ErrorMapper,
+	logger ports.Logger,
+	metrics ports.MetricsCollector,
+	requestTimeout time.Duration,
+) *ProductService {
+	return &ProductService{
+		useCase:        useCase,
+		errors:         errors,
+		logger:         logger,
+		metrics:        metrics,
+		requestTimeout: requestTimeout,
+	}
+}
+
+func (s *ProductService) CreateProduct(ctx context.Context, req *productsv1.CreateProductRequest) (*productsv1.Product, error) {
+	if req.GetName() == "" {
+		return nil, s.errors.InvalidArgument(ctx, "name is required")
+	}
+
+	price, prices, err := createPrices(req)
+	if err != nil {
+		return nil, s.errors.ToStatus(ctx, err)
+	}
+
+	attributes, err := createAttributes(req.GetAttributes())
+	if err != nil {
+		return nil, s.errors.ToStatus(ctx, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	product, err := s.useCase.CreateProduct(ctx, req.GetName(), price, prices, attributes, req.GetIdempotencyKey())
+	if err != nil {
+		return nil, s.errors.ToStatus(ctx, err)
+	}
+
+	if s.metrics != nil {
+		s.metrics.IncrementProductsCreated()
+	}
+
+	s.logger.Info("Product created",
+		ports.NewField("id", product.ID),
+		ports.NewField("name", product.Name.Value()),
+	)
+	return s.toMessage(ctx, product)
+}
+
+func (s *ProductService) GetProduct(ctx context.Context, req *productsv1.GetProductRequest) (*productsv1.Product, error) {
+	if req.GetId() <= 0 {
+		return nil, s.errors.InvalidArgument(ctx, "Invalid product ID")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	product, err := s.useCase.GetProduct(ctx, int(req.GetId()), req.GetIncludeDeleted())
+	if err != nil {
+		return nil, s.errors.ToStatus(ctx, err)
+	}
+	return s.toMessage(ctx, product)
+}
+
+func (s *ProductService) ListProducts(ctx context.Context, req *productsv1.ListProductsRequest) (*productsv1.ListProductsResponse, error) {
+	query, err := listQuery(req)
+	if err != nil {
+		return nil, s.errors.InvalidArgument(ctx, err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	result, err := s.useCase.GetProducts(ctx, query)
+	if err != nil {
+		return nil, s.errors.ToStatus(ctx, err)
+	}
+
+	response := &productsv1.ListProductsResponse{
+		Products:      make([]*productsv1.Product, 0, len(result.Products)),
+		NextPageToken: handler.EncodeCursor(result.NextCursor),
+	}
+	for i := range result.Products {
+		message, err := s.toMessage(ctx, &result.Products[i])
+		if err != nil {
+			return nil, err
+		}
+		response.Products = append(response.Products, message)
+	}
+	return response, nil
+}
+
+func (s *ProductService) DeleteProduct(ctx context.Context, req *productsv1.DeleteProductRequest) (*productsv1.DeleteProductResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, s.errors.InvalidArgument(ctx, "Invalid product ID")
+	}
+	if req.GetExpectedVersion() < 0 {
+		return nil, s.errors.InvalidArgument(ctx, "Invalid expected_version")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	if err := s.useCase.DeleteProduct(ctx, int(req.GetId()), int(req.GetExpectedVersion()), req.GetIdempotencyKey()); err != nil {
+		return nil, s.errors.ToStatus(ctx, err)
+	}
+
+	if s.metrics != nil {
+		s.metrics.IncrementProductsDeleted()
+	}
+
+	s.logger.Info("Product deleted",
+		ports.NewField("id", req.GetId()),
+	)
+	return &productsv1.DeleteProductResponse{}, nil
+}
+
+func (s *ProductService) StreamProducts(req *productsv1.StreamProductsRequest, stream grpc.ServerStreamingServer[productsv1.Product]) error {
+	ctx := stream.Context()
+
+	filter, err := listFilter(req.GetFilter())
+	if err != nil {
+		return s.errors.InvalidArgument(ctx, err.Error())
+	}
+
+	query := ports.ProductListQuery{
+		Filter:         filter,
+		IncludeDeleted: req.GetIncludeDeleted(),
+	}
+
+	streamed, err := s.useCase.ExportProducts(ctx, query, func(product *domain.Product) error {
+		message, err := s.toMessage(ctx, product)
+		if err != nil {
+			return err
+		}
+		return stream.Send(message)
+	})
+	if err != nil {
+		s.logger.Warn("Product stream aborted",
+			ports.NewField("error", err),
+			ports.NewField("streamed", streamed),
+			ports.NewField("request_id", ports.RequestIDFromContext(ctx)),
+		)
+		return s.errors.ToStatus(ctx, err)
+	}
+
+	s.logger.Info("Products streamed",
+		ports.NewField("count", streamed),
+	)
+	return nil
+}
+
+func (s *ProductService) toMessage(ctx context.Context, product *domain.Product) (*productsv1.Product, error) {
+	message, err := toProductMessage(product)
+	if err != nil {
+		return nil, s.errors.ToStatus(ctx, err)
+	}
+	return message, nil
+}
@@ -0,0 +1,76 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	productsv1 "product_service/products/api/proto/products/v1"
+	"product_service/products/internal/usecase/ports"
+)
+
+type Server struct {
+	server *grpc.Server
+	addr   string
+	logger ports.Logger
+}
+
+func NewServer(addr string, enableReflection bool, products *ProductService, logger ports.Logger, interceptors ...Interceptor) *Server {
+	unary := make([]grpc.UnaryServerInterceptor, 0, len(interceptors))
+	stream := make([]grpc.StreamServerInterceptor, 0, len(interceptors))
+	for _, interceptor := range interceptors {
+		unary = append(unary, interceptor.Unary())
+		stream = append(stream, interceptor.Stream())
+	}
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
+	productsv1.RegisterProductServiceServer(server, products)
+	if enableReflection {
+		reflection.Register(server)
+	}
+
+	return &Server{
+		server: server,
+		addr:   addr,
+		logger: logger,
+	}
+}
+
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen for gRPC on %s: %w", s.addr, err)
+	}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			s.logger.Error("gRPC server stopped unexpectedly",
+				ports.NewField("error", err),
+			)
+		}
+	}()
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase/ports"
+	"strconv"
+)
+
+func (h *HTTPProductHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.CreateAPIKeyRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	key, plaintext, err := h.apiKeys.CreateAPIKey(ctx, req.Name, req.Scopes, req.RateLimit)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "create_api_key", err)
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	h.writeJSON(w, http.StatusCreated, dto.CreateAPIKeyResponse{
+		APIKeyResponse: dto.ToAPIKeyResponse(key),
+		Key:            plaintext,
+	})
+}
+
+func (h *HTTPProductHandler) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	page, limit := parsePageParams(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
+	defer cancel()
+
+	keys, err := h.apiKeys.ListAPIKeys(ctx, page, limit)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "list_api_keys", err)
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	response := dto.APIKeyListResponse{
+		Keys:  make([]dto.APIKeyResponse, len(keys.Keys)),
+		Page:  keys.Page,
+		Limit: keys.Limit,
+		Total: keys.Total,
+	}
+	for i := range keys.Keys {
+		response.Keys[i] = dto.ToAPIKeyResponse(&keys.Keys[i])
+	}
+
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+func (h *HTTPProductHandler) SetAPIKeyScopes(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseAPIKeyID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.APIKeyScopesRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	key, err := h.apiKeys.SetAPIKeyScopes(ctx, id, req.Scopes)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "set_api_key_scopes", err, ports.NewField("api_key_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.ToAPIKeyResponse(key))
+}
+
+func (h *HTTPProductHandler) RevokeAPIKey(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseAPIKeyID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	key, err := h.apiKeys.RevokeAPIKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "revoke_api_key", err, ports.NewField("api_key_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.ToAPIKeyResponse(key))
+}
+
+func (h *HTTPProductHandler) parseAPIKeyID(idStr string, w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		h.logger.Warn("Invalid api key ID",
+			ports.NewField("id", idStr),
+		)
+		h.writeError(w, r, CodeInvalidInput, "Invalid api key ID")
+		return 0, false
+	}
+	return id, true
+}
+
+func parsePageParams(r *http.Request) (int, int) {
+	page, limit := 1, 0
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			page = p
+		}
+	}
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+	return page, limit
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"product_service/products/api/openapi"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	batchItemCreated = "created"
+	batchItemFailed  = "failed"
+	batchItemSkipped = "skipped"
+)
+
+func (h *HTTPProductHandler) BatchCreateProducts(w http.ResponseWriter, r *http.Request) {
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.BatchCreateProductsRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	mode, err := usecase.ParseBatchMode(req.Mode)
+	if err != nil {
+		h.writeError(w, r, requestErrorCode(err), err.Error())
+		return
+	}
+	if len(req.Products) == 0 || len(req.Products) > usecase.MaxBatchCreateSize {
+		h.writeError(w, r, CodeInvalidInput, fmt.Sprintf("products must contain between 1 and %d items", usecase.MaxBatchCreateSize))
+		return
+	}
+
+	results := make([]dto.BatchItemResponse, len(req.Products))
+	inputs := make([]usecase.ProductInput, 0, len(req.Products))
+	indexes := make([]int, 0, len(req.Products))
+	failed := 0
+	for i, item := range req.Products {
+		results[i] = dto.BatchItemResponse{Index: i, Status: batchItemSkipped}
+
+		input, err := parseBatchItem(item)
+		if err != nil {
+			results[i].Status = batchItemFailed
+			results[i].Error = &dto.BatchItemError{Code: ItemErrorCode(err), Message: err.Error()}
+			failed++
+			continue
+		}
+		inputs = append(inputs, input)
+		indexes = append(indexes, i)
+	}
+
+	if len(inputs) == 0 || (mode == usecase.BatchModeAtomic && failed > 0) {
+		h.logger.Warn("Invalid product batch",
+			ports.NewField("mode", string(mode)),
+			ports.NewField("failed", failed),
+		)
+		h.writeBatchResponse(w, mode, results)
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	result, err := h.useCase.CreateProducts(ctx, inputs, mode, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "batch_create_products", err)
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	priceOptions := dto.PriceOptions{Format: ParsePriceFormat(r)}
+	for _, item := range result.Items {
+		response := &results[indexes[item.Index]]
+		switch {
+		case item.Err != nil:
+			response.Status = batchItemFailed
+			response.Error = &dto.BatchItemError{Code: ItemErrorCode(item.Err), Message: item.Err.Error()}
+		case item.Product != nil:
+			product := dto.ToProductResponse(item.Product, priceOptions)
+			response.Status = batchItemCreated
+			response.Product = &product
+		}
+	}
+
+	if h.metrics != nil {
+		for i := 0; i < result.Created; i++ {
+			h.metrics.IncrementProductsCreated()
+		}
+	}
+
+	h.logger.Info("Product batch processed",
+		ports.NewField("mode", string(mode)),
+		ports.NewField("created", result.Created),
+		ports.NewField("failed", failed+result.Failed),
+	)
+	h.writeBatchResponse(w, mode, results)
+}
+
+func parseBatchItem(item dto.CreateProductRequest) (usecase.ProductInput, error) {
+	if err := openapi.ValidateSchema("CreateProductRequest", item); err != nil {
+		return usecase.ProductInput{}, err
+	}
+
+	price, prices, err := createPrices(item)
+	if err != nil {
+		return usecase.ProductInput{}, err
+	}
+
+	input := usecase.ProductInput{Name: item.Name, Price: price, Prices: prices}
+	if item.Attributes != nil {
+		if input.Attributes, err = domain.NewAttributes(item.Attributes); err != nil {
+			return usecase.ProductInput{}, err
+		}
+	}
+	return input, nil
+}
+
+func (h *HTTPProductHandler) writeBatchResponse(w http.ResponseWriter, mode usecase.BatchMode, results []dto.BatchItemResponse) {
+	response := dto.BatchCreateProductsResponse{Mode: string(mode), Results: results}
+	for _, result := range results {
+		switch result.Status {
+		case batchItemCreated:
+			response.Created++
+		case batchItemFailed:
+			response.Failed++
+		}
+	}
+
+	status := http.StatusCreated
+	switch {
+	case response.Created == 0:
+		status = http.StatusUnprocessableEntity
+	case response.Failed > 0:
+		status = http.StatusMultiStatus
+	}
+	h.writeJSON(w, status, response)
+}
@@ -0,0 +1,289 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+	"strconv"
+)
+
+func (h *HTTPProductHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.CreateCategoryRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	category, err := h.categories.CreateCategory(ctx, req.Name, req.Slug, req.ParentID, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "create_category", err)
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Category created",
+		ports.NewField("id", category.ID),
+		ports.NewField("path", category.Path),
+	)
+	setETag(w, category.Version)
+	h.writeJSON(w, http.StatusCreated, dto.ToCategoryResponse(category))
+}
+
+func (h *HTTPProductHandler) GetCategories(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
+	defer cancel()
+
+	categories, err := h.categories.ListCategories(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "get_categories", err)
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.CategoryListResponse{Categories: dto.ToCategoryResponseList(categories)})
+}
+
+func (h *HTTPProductHandler) GetCategory(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseCategoryID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
+	defer cancel()
+
+	category, err := h.categories.GetCategory(ctx, id)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "get_category", err, ports.NewField("category_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	setETag(w, category.Version)
+	h.writeJSON(w, http.StatusOK, dto.ToCategoryResponse(category))
+}
+
+func (h *HTTPProductHandler) PatchCategory(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseCategoryID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.PatchCategoryRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	expectedVersion, ok := h.parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	category, err := h.categories.UpdateCategory(ctx, id, usecase.CategoryUpdate{Name: req.Name, Slug: req.Slug}, expectedVersion, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "patch_category", err, ports.NewField("category_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Category updated",
+		ports.NewField("id", category.ID),
+	)
+	setETag(w, category.Version)
+	h.writeJSON(w, http.StatusOK, dto.ToCategoryResponse(category))
+}
+
+func (h *HTTPProductHandler) MoveCategory(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseCategoryID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.MoveCategoryRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	expectedVersion, ok := h.parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	category, err := h.categories.MoveCategory(ctx, id, req.ParentID, expectedVersion, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "move_category", err, ports.NewField("category_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Category moved",
+		ports.NewField("id", category.ID),
+		ports.NewField("path", category.Path),
+	)
+	setETag(w, category.Version)
+	h.writeJSON(w, http.StatusOK, dto.ToCategoryResponse(category))
+}
+
+func (h *HTTPProductHandler) DeleteCategory(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseCategoryID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	expectedVersion, ok := h.parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	if err := h.categories.DeleteCategory(ctx, id, expectedVersion, idempotencyKey); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "delete_category", err, ports.NewField("category_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Category deleted",
+		ports.NewField("id", id),
+	)
+	h.writeJSON(w, http.StatusOK, map[string]string{"message": "Category deleted successfully"})
+}
+
+func (h *HTTPProductHandler) GetProductCategories(idStr string, w http.ResponseWriter, r *http.Request) {
+	productID, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
+	defer cancel()
+
+	categories, err := h.categories.GetProductCategories(ctx, productID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "get_product_categories", err, ports.NewField("product_id", productID))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.ProductCategoriesResponse{
+		ProductID:  productID,
+		Categories: dto.ToCategoryResponseList(categories),
+	})
+}
+
+func (h *HTTPProductHandler) SetProductCategories(idStr string, w http.ResponseWriter, r *http.Request) {
+	productID, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.ProductCategoriesRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	categories, err := h.categories.SetProductCategories(ctx, productID, req.CategoryIDs)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "set_product_categories", err, ports.NewField("product_id", productID))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Product categories assigned",
+		ports.NewField("product_id", productID),
+		ports.NewField("count", len(categories)),
+	)
+	h.writeJSON(w, http.StatusOK, dto.ProductCategoriesResponse{
+		ProductID:  productID,
+		Categories: dto.ToCategoryResponseList(categories),
+	})
+}
+
+func (h *HTTPProductHandler) parseCategoryID(idStr string, w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id <= 0 {
+		h.logger.Warn("Invalid category ID",
+			ports.NewField("id", idStr),
+		)
+		h.writeError(w, r, CodeInvalidInput, "Invalid category ID")
+		return 0, false
+	}
+	return id, true
+}
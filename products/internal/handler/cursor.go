@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"time"
+)
+
+type cursorPayload struct {
+	Sort      string `json:"s"`
+	CreatedAt string `json:"t"`
+	Price     string `json:"p"`
+	Currency  string `json:"c"`
+	Name      string `json:"n"`
+	ID        int    `json:"id"`
+	Direction string `json:"d"`
+}
+
+func EncodeCursor(cursor *ports.ProductCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(cursorPayload{
+		Sort:      cursor.Sort.String(),
+		CreatedAt: cursor.CreatedAt.UTC().Format(time.RFC3339Nano),
+		Price:     cursor.Price.Amount(),
+		Currency:  cursor.Price.Currency(),
+		Name:      cursor.Name,
+		ID:        cursor.ID,
+		Direction: string(cursor.Direction),
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(value string) (*ports.ProductCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor encoding: %w", err)
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("invalid cursor payload: %w", err)
+	}
+
+	sort, ok := allowedSorts[payload.Sort]
+	if !ok {
+		return nil, fmt.Errorf("invalid cursor sort: %q", payload.Sort)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, payload.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor timestamp: %w", err)
+	}
+
+	price, err := domain.ParseMoney(payload.Price, payload.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor price: %w", err)
+	}
+
+	if payload.ID <= 0 {
+		return nil, fmt.Errorf("invalid cursor id: %d", payload.ID)
+	}
+
+	direction := ports.CursorDirection(payload.Direction)
+	if direction != ports.CursorNext && direction != ports.CursorPrev {
+		return nil, fmt.Errorf("invalid cursor direction: %q", payload.Direction)
+	}
+
+	return &ports.ProductCursor{
+		Sort:      sort,
+		CreatedAt: createdAt,
+		Price:     price,
+		Name:      payload.Name,
+		ID:        payload.ID,
+		Direction: direction,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package dto
+
+import "encoding/json"
+
+type CreateAPIKeyRequest struct {
+	Name      string   `json:"name" binding:"required,min=1,max=255"`
+	Scopes    []string `json:"scopes"`
+	RateLimit int      `json:"rate_limit,omitempty"`
+}
+
+type APIKeyScopesRequest struct {
+	Scopes []string `json:"scopes"`
+}
+
+type APIKeyResponse struct {
+	ID         int64    `json:"id"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`
+	Scopes     []string `json:"scopes"`
+	RateLimit  int      `json:"rate_limit"`
+	CreatedBy  string   `json:"created_by"`
+	CreatedAt  string   `json:"created_at"`
+	LastUsedAt *string  `json:"last_used_at"`
+	RevokedAt  *string  `json:"revoked_at"`
+}
+
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+type APIKeyListResponse struct {
+	Keys  []APIKeyResponse `json:"keys"`
+	Page  int              `json:"page"`
+	Limit int              `json:"limit"`
+	Total int              `json:"total"`
+}
+
+type DeadLetterResponse struct {
+	ID             int64           `json:"id"`
+	EventType      string          `json:"event_type"`
+	EventData      json.RawMessage `json:"event_data"`
+	IdempotencyKey string          `json:"idempotency_key,omitempty"`
+	RetryCount     int             `json:"retry_count"`
+	Reason         string          `json:"reason"`
+	CreatedAt      string          `json:"created_at"`
+}
+
+type DeadLetterListResponse struct {
+	Events []DeadLetterResponse `json:"events"`
+	Page   int                  `json:"page"`
+	Limit  int                  `json:"limit"`
+	Total  int                  `json:"total"`
+}
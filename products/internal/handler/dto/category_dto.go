@@ -0,0 +1,41 @@
+package dto
+
+type CreateCategoryRequest struct {
+	Name     string `json:"name" binding:"required,min=1,max=255"`
+	Slug     string `json:"slug,omitempty"`
+	ParentID *int   `json:"parent_id,omitempty"`
+}
+
+type PatchCategoryRequest struct {
+	Name *string `json:"name,omitempty" binding:"omitempty,min=1,max=255"`
+	Slug *string `json:"slug,omitempty"`
+}
+
+type MoveCategoryRequest struct {
+	ParentID *int `json:"parent_id"`
+}
+
+type ProductCategoriesRequest struct {
+	CategoryIDs []int `json:"category_ids"`
+}
+
+type CategoryResponse struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+	ParentID  *int   `json:"parent_id"`
+	Path      string `json:"path"`
+	Depth     int    `json:"depth"`
+	Version   int    `json:"version"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type CategoryListResponse struct {
+	Categories []CategoryResponse `json:"categories"`
+}
+
+type ProductCategoriesResponse struct {
+	ProductID  int                `json:"product_id"`
+	Categories []CategoryResponse `json:"categories"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+type ProductHistoryResponse struct {
+	ProductID int                           `json:"product_id"`
+	Entries   []ProductHistoryEntryResponse `json:"entries"`
+	Page      int                           `json:"page"`
+	Limit     int                           `json:"limit"`
+	Total     int                           `json:"total"`
+}
+
+type ProductHistoryEntryResponse struct {
+	ID        int64                          `json:"id"`
+	Action    string                         `json:"action"`
+	Changes   map[string]FieldChangeResponse `json:"changes"`
+	Actor     string                         `json:"actor"`
+	RequestID string                         `json:"request_id,omitempty"`
+	CreatedAt string                         `json:"created_at"`
+}
+
+type FieldChangeResponse struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+type StockAdjustmentRequest struct {
+	Delta  int    `json:"delta"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type CreateReservationRequest struct {
+	Quantity   int `json:"quantity"`
+	TTLSeconds int `json:"ttl_seconds,omitempty"`
+}
+
+type InventoryResponse struct {
+	ProductID int     `json:"product_id"`
+	OnHand    int     `json:"on_hand"`
+	Reserved  int     `json:"reserved"`
+	Available int     `json:"available"`
+	Version   int     `json:"version"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+type ReservationResponse struct {
+	ID        int64  `json:"id"`
+	ProductID int    `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+	Status    string `json:"status"`
+	ExpiresAt string `json:"expires_at"`
+	CreatedAt string `json:"created_at"`
+}
+
+type ReservationResultResponse struct {
+	Reservation ReservationResponse `json:"reservation"`
+	Inventory   InventoryResponse   `json:"inventory"`
+}
@@ -1,24 +1,185 @@
 package dto
 
 import (
+	"encoding/json"
 	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
 	"time"
 )
 
-func ToProductResponse(p *domain.Product) ProductResponse {
-	return ProductResponse{
+func ToProductResponse(p *domain.Product, opts PriceOptions) ProductResponse {
+	price := p.Price
+	if opts.Currency != "" {
+		if selected, ok := p.PriceIn(opts.Currency); ok {
+			price = selected
+		}
+	}
+
+	response := ProductResponse{
 		ID:        p.ID,
 		Name:      p.Name.Value(),
-		Price:     p.Price.Value(),
+		Price:     NewPriceValue(price, opts.Format),
+		Currency:  price.Currency(),
+		ParentID:  p.ParentID,
+		Status:    p.Status.String(),
+		Version:   p.Version,
 		CreatedAt: p.CreatedAt.Format(time.RFC3339),
+
+		Attributes:          p.EffectiveAttributes().Values(),
+		InheritedAttributes: p.InheritedAttributes.Values(),
+	}
+	if len(p.Prices) > 0 {
+		response.Prices = make(map[string]PriceValue, len(p.Prices))
+		for currency, money := range p.Prices {
+			response.Prices[currency] = NewPriceValue(money, opts.Format)
+		}
+	}
+	if p.DeletedAt != nil {
+		deletedAt := p.DeletedAt.Format(time.RFC3339)
+		response.DeletedAt = &deletedAt
 	}
+	return response
 }
 
-func ToProductResponseList(products []domain.Product) []ProductResponse {
+func ToProductExportRecord(p *domain.Product) ProductExportRecord {
+	return ProductExportRecord{
+		ID:         p.ID,
+		Name:       p.Name.Value(),
+		Price:      p.Price.Amount(),
+		Currency:   p.Price.Currency(),
+		Prices:     p.PriceAmounts(),
+		Attributes: p.Attributes.Values(),
+		ParentID:   p.ParentID,
+		Status:     p.Status.String(),
+		Version:    p.Version,
+		CreatedAt:  p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToProductResponseList(products []domain.Product, opts PriceOptions) []ProductResponse {
 	responses := make([]ProductResponse, len(products))
 	for i, p := range products {
-		responses[i] = ToProductResponse(&p)
+		responses[i] = ToProductResponse(&p, opts)
 	}
 	return responses
 }
 
+func ToProductSearchResponse(query string, result ports.ProductSearchResult, opts PriceOptions) ProductSearchResponse {
+	results := make([]ProductSearchResult, len(result.Hits))
+	for i, hit := range result.Hits {
+		results[i] = ProductSearchResult{
+			Product:   ToProductResponse(&hit.Product, opts),
+			Rank:      hit.Rank,
+			Highlight: hit.Highlight,
+		}
+	}
+	return ProductSearchResponse{
+		Query:   query,
+		Fuzzy:   result.Fuzzy,
+		Results: results,
+	}
+}
+
+func ToInventoryResponse(inventory *domain.Inventory) InventoryResponse {
+	response := InventoryResponse{
+		ProductID: inventory.ProductID,
+		OnHand:    inventory.OnHand,
+		Reserved:  inventory.Reserved,
+		Available: inventory.Available(),
+		Version:   inventory.Version,
+	}
+	if !inventory.UpdatedAt.IsZero() {
+		updatedAt := inventory.UpdatedAt.Format(time.RFC3339)
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
+
+func ToReservationResultResponse(reservation *domain.Reservation, inventory *domain.Inventory) ReservationResultResponse {
+	return ReservationResultResponse{
+		Reservation: ReservationResponse{
+			ID:        reservation.ID,
+			ProductID: reservation.ProductID,
+			Quantity:  reservation.Quantity,
+			Status:    string(reservation.Status),
+			ExpiresAt: reservation.ExpiresAt.Format(time.RFC3339),
+			CreatedAt: reservation.CreatedAt.Format(time.RFC3339),
+		},
+		Inventory: ToInventoryResponse(inventory),
+	}
+}
+
+func ToCategoryResponse(category *domain.Category) CategoryResponse {
+	return CategoryResponse{
+		ID:        category.ID,
+		Name:      category.Name,
+		Slug:      category.Slug,
+		ParentID:  category.ParentID,
+		Path:      category.Path,
+		Depth:     category.Depth(),
+		Version:   category.Version,
+		CreatedAt: category.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: category.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToCategoryResponseList(categories []domain.Category) []CategoryResponse {
+	responses := make([]CategoryResponse, len(categories))
+	for i := range categories {
+		responses[i] = ToCategoryResponse(&categories[i])
+	}
+	return responses
+}
+
+func ToProductHistoryEntryResponse(entry domain.ProductHistoryEntry) ProductHistoryEntryResponse {
+	changes := make(map[string]FieldChangeResponse, len(entry.Changes))
+	for field, change := range entry.Changes {
+		changes[field] = FieldChangeResponse{From: change.From, To: change.To}
+	}
+	return ProductHistoryEntryResponse{
+		ID:        entry.ID,
+		Action:    entry.Action,
+		Changes:   changes,
+		Actor:     entry.Actor,
+		RequestID: entry.RequestID,
+		CreatedAt: entry.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToAPIKeyResponse(key *domain.APIKey) APIKeyResponse {
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = scope.String()
+	}
+	return APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     scopes,
+		RateLimit:  key.RateLimit,
+		CreatedBy:  key.CreatedBy,
+		CreatedAt:  key.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: formatOptionalTime(key.LastUsedAt),
+		RevokedAt:  formatOptionalTime(key.RevokedAt),
+	}
+}
+
+func ToDeadLetterResponse(event ports.OutboxEvent) DeadLetterResponse {
+	return DeadLetterResponse{
+		ID:             event.ID,
+		EventType:      event.EventType,
+		EventData:      json.RawMessage(event.EventData),
+		IdempotencyKey: event.IdempotencyKey,
+		RetryCount:     event.RetryCount,
+		Reason:         event.DLQReason,
+		CreatedAt:      event.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
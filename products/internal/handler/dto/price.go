@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"product_service/products/internal/domain"
+)
+
+type PriceFormat int
+
+const (
+	PriceFormatNumber PriceFormat = iota
+	PriceFormatString
+)
+
+type PriceOptions struct {
+	Format   PriceFormat
+	Currency string
+}
+
+type DecimalInput string
+
+func (d *DecimalInput) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*d = DecimalInput(s)
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("price must be a decimal number or string: %w", err)
+	}
+	*d = DecimalInput(number)
+	return nil
+}
+
+func (d DecimalInput) Money(currency string) (domain.Money, error) {
+	return domain.ParseMoney(string(d), currency)
+}
+
+type PriceValue struct {
+	money  domain.Money
+	format PriceFormat
+}
+
+func NewPriceValue(money domain.Money, format PriceFormat) PriceValue {
+	return PriceValue{money: money, format: format}
+}
+
+func (p PriceValue) MarshalJSON() ([]byte, error) {
+	if p.format == PriceFormatString {
+		return json.Marshal(p.money.Amount())
+	}
+	return []byte(p.money.Amount()), nil
+}
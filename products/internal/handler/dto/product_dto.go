@@ -1,21 +1,130 @@
 package dto
 
 type CreateProductRequest struct {
-	Name  string  `json:"name" binding:"required,min=1,max=255"`
-	Price float64 `json:"price" binding:"required,gt=0"`
+	Name       string                  `json:"name" binding:"required,min=1,max=255"`
+	Price      DecimalInput            `json:"price,omitempty"`
+	Currency   string                  `json:"currency,omitempty"`
+	Prices     map[string]DecimalInput `json:"prices,omitempty"`
+	Attributes map[string]interface{}  `json:"attributes,omitempty"`
+}
+
+type CreateVariantRequest struct {
+	Name       string                 `json:"name" binding:"required,min=1,max=255"`
+	Price      DecimalInput           `json:"price,omitempty"`
+	Currency   string                 `json:"currency,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+type TransitionProductRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+type UpdateProductRequest struct {
+	Name       string                 `json:"name" binding:"required,min=1,max=255"`
+	Price      DecimalInput           `json:"price" binding:"required"`
+	Currency   string                 `json:"currency,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+type PatchProductRequest struct {
+	Name       *string                `json:"name,omitempty" binding:"omitempty,min=1,max=255"`
+	Price      *DecimalInput          `json:"price,omitempty"`
+	Currency   string                 `json:"currency,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
 
 type ProductListResponse struct {
-	Products []ProductResponse `json:"products"`
-	Page     int               `json:"page"`
-	Limit    int               `json:"limit"`
-	Total    int               `json:"total"`
+	Products   []ProductResponse `json:"products"`
+	Page       int               `json:"page,omitempty"`
+	Limit      int               `json:"limit"`
+	Total      *int              `json:"total,omitempty"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	PrevCursor string            `json:"prev_cursor,omitempty"`
 }
 
 type ProductResponse struct {
-	ID        int     `json:"id"`
-	Name      string  `json:"name"`
-	Price     float64 `json:"price"`
-	CreatedAt string  `json:"created_at"`
+	ID        int                   `json:"id"`
+	Name      string                `json:"name"`
+	Price     PriceValue            `json:"price"`
+	Currency  string                `json:"currency"`
+	Prices    map[string]PriceValue `json:"prices,omitempty"`
+	ParentID  *int                  `json:"parent_id,omitempty"`
+	Status    string                `json:"status"`
+	Version   int                   `json:"version"`
+	CreatedAt string                `json:"created_at"`
+	DeletedAt *string               `json:"deleted_at,omitempty"`
+
+	Attributes          map[string]interface{} `json:"attributes,omitempty"`
+	InheritedAttributes map[string]interface{} `json:"inherited_attributes,omitempty"`
+}
+
+type ProductSearchResponse struct {
+	Query   string                `json:"query"`
+	Fuzzy   bool                  `json:"fuzzy"`
+	Results []ProductSearchResult `json:"results"`
+}
+
+type ProductSearchResult struct {
+	Product   ProductResponse `json:"product"`
+	Rank      float64         `json:"rank"`
+	Highlight string          `json:"highlight"`
+}
+
+type BatchCreateProductsRequest struct {
+	Mode     string                 `json:"mode,omitempty"`
+	Products []CreateProductRequest `json:"products"`
+}
+
+type BatchCreateProductsResponse struct {
+	Mode    string              `json:"mode"`
+	Created int                 `json:"created"`
+	Failed  int                 `json:"failed"`
+	Results []BatchItemResponse `json:"results"`
+}
+
+type BatchItemResponse struct {
+	Index   int              `json:"index"`
+	Status  string           `json:"status"`
+	Product *ProductResponse `json:"product,omitempty"`
+	Error   *BatchItemError  `json:"error,omitempty"`
+}
+
+type BatchItemError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
+type ProductExportRecord struct {
+	ID         int                    `json:"id"`
+	Name       string                 `json:"name"`
+	Price      string                 `json:"price"`
+	Currency   string                 `json:"currency"`
+	Prices     map[string]string      `json:"prices,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	ParentID   *int                   `json:"parent_id,omitempty"`
+	Status     string                 `json:"status"`
+	Version    int                    `json:"version"`
+	CreatedAt  string                 `json:"created_at"`
+}
+
+type ImportProductRow struct {
+	ID      int `json:"id,omitempty"`
+	Version int `json:"version,omitempty"`
+	CreateProductRequest
+}
+
+type ImportProductsResponse struct {
+	Format    string                   `json:"format"`
+	Created   int                      `json:"created"`
+	Updated   int                      `json:"updated"`
+	Unchanged int                      `json:"unchanged"`
+	Rejected  int                      `json:"rejected"`
+	Errors    []ImportRowErrorResponse `json:"errors"`
+	Error     string                   `json:"error,omitempty"`
+}
+
+type ImportRowErrorResponse struct {
+	Line    int    `json:"line"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,290 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+
+	"product_service/products/api/openapi"
+	"product_service/products/internal/application"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase"
+)
+
+type ErrorCode string
+
+const (
+	CodeInvalidInput          ErrorCode = "INVALID_INPUT"
+	CodeInvalidRequestBody    ErrorCode = "INVALID_REQUEST_BODY"
+	CodeValidationFailed      ErrorCode = "VALIDATION_FAILED"
+	CodeInvalidIfMatch        ErrorCode = "INVALID_IF_MATCH"
+	CodeInvalidTenant         ErrorCode = "INVALID_TENANT"
+	CodeNotFound              ErrorCode = "NOT_FOUND"
+	CodeMethodNotAllowed      ErrorCode = "METHOD_NOT_ALLOWED"
+	CodeNotAcceptable         ErrorCode = "NOT_ACCEPTABLE"
+	CodeUnsupportedMediaType  ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	CodePayloadTooLarge       ErrorCode = "PAYLOAD_TOO_LARGE"
+	CodeAlreadyExists         ErrorCode = "ALREADY_EXISTS"
+	CodeRateLimited           ErrorCode = "RATE_LIMITED"
+	CodeTimeout               ErrorCode = "TIMEOUT"
+	CodeCanceled              ErrorCode = "CANCELED"
+	CodeServiceUnavailable    ErrorCode = "SERVICE_UNAVAILABLE"
+	CodeInternal              ErrorCode = "INTERNAL_ERROR"
+	CodeOperationFailed       ErrorCode = "OPERATION_FAILED"
+	CodeDatabaseError         ErrorCode = "DATABASE_ERROR"
+	CodeDatabaseConnection    ErrorCode = "DATABASE_CONNECTION_ERROR"
+	CodeEventPublishFailed    ErrorCode = "EVENT_PUBLISH_FAILED"
+	CodeResponseValidation    ErrorCode = "RESPONSE_VALIDATION_FAILED"
+	CodeInvalidIdempotencyKey ErrorCode = "INVALID_IDEMPOTENCY_KEY"
+	CodeIdempotencyKeyReused  ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	CodeIdempotencyInProgress ErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+
+	CodeUnauthenticated   ErrorCode = "UNAUTHENTICATED"
+	CodeInvalidToken      ErrorCode = "INVALID_TOKEN"
+	CodeTokenExpired      ErrorCode = "TOKEN_EXPIRED"
+	CodeInvalidAPIKey     ErrorCode = "INVALID_API_KEY"
+	CodeInsufficientScope ErrorCode = "INSUFFICIENT_SCOPE"
+	CodeForbidden         ErrorCode = "FORBIDDEN"
+
+	CodeProductNotFound         ErrorCode = "PRODUCT_NOT_FOUND"
+	CodeInvalidProductName      ErrorCode = "INVALID_PRODUCT_NAME"
+	CodeInvalidProductPrice     ErrorCode = "INVALID_PRODUCT_PRICE"
+	CodePreconditionFailed      ErrorCode = "PRECONDITION_FAILED"
+	CodeProductNotDeleted       ErrorCode = "PRODUCT_NOT_DELETED"
+	CodePriceNotAvailable       ErrorCode = "PRICE_NOT_AVAILABLE"
+	CodeInvalidCurrency         ErrorCode = "INVALID_CURRENCY"
+	CodeInvalidAttribute        ErrorCode = "INVALID_ATTRIBUTE"
+	CodeInvalidProductStatus    ErrorCode = "INVALID_PRODUCT_STATUS"
+	CodeInvalidStatusTransition ErrorCode = "INVALID_STATUS_TRANSITION"
+	CodeInvalidVariantParent    ErrorCode = "INVALID_VARIANT_PARENT"
+	CodeProductHasVariants      ErrorCode = "PRODUCT_HAS_VARIANTS"
+	CodeInsufficientStock       ErrorCode = "INSUFFICIENT_STOCK"
+	CodeReservationNotFound     ErrorCode = "RESERVATION_NOT_FOUND"
+	CodeReservationExpired      ErrorCode = "RESERVATION_EXPIRED"
+	CodeReservationNotActive    ErrorCode = "RESERVATION_NOT_ACTIVE"
+	CodeCategoryNotFound        ErrorCode = "CATEGORY_NOT_FOUND"
+	CodeCategoryInUse           ErrorCode = "CATEGORY_IN_USE"
+	CodeInvalidCategoryMove     ErrorCode = "INVALID_CATEGORY_MOVE"
+	CodeCategorySlugConflict    ErrorCode = "CATEGORY_SLUG_CONFLICT"
+	CodeInvalidCategory         ErrorCode = "INVALID_CATEGORY"
+	CodeAPIKeyNotFound          ErrorCode = "API_KEY_NOT_FOUND"
+	CodeInvalidScope            ErrorCode = "INVALID_SCOPE"
+	CodeInvalidAPIKeySettings   ErrorCode = "INVALID_API_KEY_SETTINGS"
+	CodeDeadLetterNotFound      ErrorCode = "DEAD_LETTER_NOT_FOUND"
+)
+
+type errorDefinition struct {
+	status int
+	title  string
+}
+
+var errorCatalog = map[ErrorCode]errorDefinition{
+	CodeInvalidInput:          {http.StatusBadRequest, "Invalid input"},
+	CodeInvalidRequestBody:    {http.StatusBadRequest, "Invalid request body"},
+	CodeValidationFailed:      {http.StatusBadRequest, "Request validation failed"},
+	CodeInvalidIfMatch:        {http.StatusBadRequest, "Invalid If-Match header"},
+	CodeInvalidTenant:         {http.StatusBadRequest, "Invalid X-Tenant-ID header"},
+	CodeNotFound:              {http.StatusNotFound, "Resource not found"},
+	CodeMethodNotAllowed:      {http.StatusMethodNotAllowed, "Method not allowed"},
+	CodeNotAcceptable:         {http.StatusNotAcceptable, "Requested representation is not available"},
+	CodeUnsupportedMediaType:  {http.StatusUnsupportedMediaType, "Unsupported media type"},
+	CodePayloadTooLarge:       {http.StatusRequestEntityTooLarge, "Request body too large"},
+	CodeAlreadyExists:         {http.StatusConflict, "Resource already exists"},
+	CodeRateLimited:           {http.StatusTooManyRequests, "Rate limit exceeded"},
+	CodeTimeout:               {http.StatusRequestTimeout, "Request timeout"},
+	CodeCanceled:              {http.StatusRequestTimeout, "Request canceled"},
+	CodeServiceUnavailable:    {http.StatusServiceUnavailable, "Service temporarily unavailable"},
+	CodeInternal:              {http.StatusInternalServerError, "Internal server error"},
+	CodeOperationFailed:       {http.StatusInternalServerError, "Operation failed"},
+	CodeDatabaseError:         {http.StatusInternalServerError, "Database error occurred"},
+	CodeDatabaseConnection:    {http.StatusInternalServerError, "Database connection error"},
+	CodeEventPublishFailed:    {http.StatusInternalServerError, "Failed to publish event"},
+	CodeResponseValidation:    {http.StatusInternalServerError, "Response does not match the API specification"},
+	CodeInvalidIdempotencyKey: {http.StatusBadRequest, "Invalid Idempotency-Key header"},
+	CodeIdempotencyKeyReused:  {http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request"},
+	CodeIdempotencyInProgress: {http.StatusConflict, "A request with this Idempotency-Key is still in progress"},
+
+	CodeUnauthenticated:   {http.StatusUnauthorized, "Authentication required"},
+	CodeInvalidToken:      {http.StatusUnauthorized, "Invalid token"},
+	CodeTokenExpired:      {http.StatusUnauthorized, "Token has expired"},
+	CodeInvalidAPIKey:     {http.StatusUnauthorized, "Invalid API key"},
+	CodeInsufficientScope: {http.StatusForbidden, "Insufficient API key scope"},
+	CodeForbidden:         {http.StatusForbidden, "You do not have permission to perform this action"},
+
+	CodeProductNotFound:         {http.StatusNotFound, "Product not found"},
+	CodeInvalidProductName:      {http.StatusBadRequest, "Invalid product name"},
+	CodeInvalidProductPrice:     {http.StatusBadRequest, "Invalid product price"},
+	CodePreconditionFailed:      {http.StatusPreconditionFailed, "Product has been modified"},
+	CodeProductNotDeleted:       {http.StatusConflict, "Product is not deleted"},
+	CodePriceNotAvailable:       {http.StatusNotFound, "Price not available in requested currency"},
+	CodeInvalidCurrency:         {http.StatusBadRequest, "Invalid currency code"},
+	CodeInvalidAttribute:        {http.StatusBadRequest, "Invalid product attribute"},
+	CodeInvalidProductStatus:    {http.StatusBadRequest, "Invalid product status"},
+	CodeInvalidStatusTransition: {http.StatusConflict, "Invalid product status transition"},
+	CodeInvalidVariantParent:    {http.StatusConflict, "Product cannot have variants"},
+	CodeProductHasVariants:      {http.StatusConflict, "Product still has variants"},
+	CodeInsufficientStock:       {http.StatusConflict, "Insufficient stock"},
+	CodeReservationNotFound:     {http.StatusNotFound, "Reservation not found"},
+	CodeReservationExpired:      {http.StatusConflict, "Reservation has expired"},
+	CodeReservationNotActive:    {http.StatusConflict, "Reservation is no longer pending"},
+	CodeCategoryNotFound:        {http.StatusNotFound, "Category not found"},
+	CodeCategoryInUse:           {http.StatusConflict, "Category still has products or subcategories"},
+	CodeInvalidCategoryMove:     {http.StatusConflict, "Category cannot be moved there"},
+	CodeCategorySlugConflict:    {http.StatusConflict, "Category slug already exists"},
+	CodeInvalidCategory:         {http.StatusBadRequest, "Invalid category"},
+	CodeAPIKeyNotFound:          {http.StatusNotFound, "API key not found"},
+	CodeInvalidScope:            {http.StatusBadRequest, "Invalid API key scope"},
+	CodeInvalidAPIKeySettings:   {http.StatusBadRequest, "Invalid API key settings"},
+	CodeDeadLetterNotFound:      {http.StatusNotFound, "Dead-letter event not found"},
+}
+
+func (c ErrorCode) Status() int {
+	if definition, ok := errorCatalog[c]; ok {
+		return definition.status
+	}
+	return http.StatusInternalServerError
+}
+
+func (c ErrorCode) Title() string {
+	if definition, ok := errorCatalog[c]; ok {
+		return definition.title
+	}
+	return errorCatalog[CodeInternal].title
+}
+
+func (c ErrorCode) Type() string {
+	return "urn:problem:products:" + strings.ToLower(strings.ReplaceAll(string(c), "_", "-"))
+}
+
+type errorRule struct {
+	target error
+	code   ErrorCode
+	detail bool
+}
+
+var errorRules = []errorRule{
+	{domain.ErrTokenExpired, CodeTokenExpired, false},
+	{domain.ErrInvalidToken, CodeInvalidToken, false},
+	{domain.ErrUnauthenticated, CodeUnauthenticated, false},
+	{domain.ErrInvalidAPIKey, CodeInvalidAPIKey, false},
+	{domain.ErrInsufficientScope, CodeInsufficientScope, true},
+	{domain.ErrForbidden, CodeForbidden, false},
+
+	{domain.ErrAPIKeyNotFound, CodeAPIKeyNotFound, false},
+	{domain.ErrInvalidAPIKeyScope, CodeInvalidScope, true},
+	{domain.ErrInvalidAPIKeyConfig, CodeInvalidAPIKeySettings, true},
+	{domain.ErrDeadLetterNotFound, CodeDeadLetterNotFound, false},
+	{domain.ErrIdempotencyKeyReused, CodeIdempotencyKeyReused, false},
+	{domain.ErrIdempotencyKeyInProgress, CodeIdempotencyInProgress, false},
+
+	{domain.ErrProductNotFound, CodeProductNotFound, false},
+	{domain.ErrInvalidProductName, CodeInvalidProductName, true},
+	{domain.ErrInvalidProductPrice, CodeInvalidProductPrice, true},
+	{domain.ErrVersionConflict, CodePreconditionFailed, false},
+	{domain.ErrProductNotDeleted, CodeProductNotDeleted, false},
+	{domain.ErrPriceNotAvailable, CodePriceNotAvailable, false},
+	{domain.ErrInvalidCurrency, CodeInvalidCurrency, false},
+	{domain.ErrInvalidAttribute, CodeInvalidAttribute, true},
+	{domain.ErrInvalidProductStatus, CodeInvalidProductStatus, true},
+	{domain.ErrInvalidStatusTransition, CodeInvalidStatusTransition, true},
+	{domain.ErrInvalidVariantParent, CodeInvalidVariantParent, true},
+	{domain.ErrProductHasVariants, CodeProductHasVariants, true},
+
+	{domain.ErrInsufficientStock, CodeInsufficientStock, false},
+	{domain.ErrReservationNotFound, CodeReservationNotFound, false},
+	{domain.ErrReservationExpired, CodeReservationExpired, false},
+	{domain.ErrReservationNotActive, CodeReservationNotActive, false},
+
+	{domain.ErrCategoryNotFound, CodeCategoryNotFound, false},
+	{domain.ErrCategoryInUse, CodeCategoryInUse, true},
+	{domain.ErrInvalidCategoryMove, CodeInvalidCategoryMove, true},
+	{domain.ErrCategorySlugConflict, CodeCategorySlugConflict, false},
+	{domain.ErrInvalidCategory, CodeInvalidCategory, true},
+
+	{domain.ErrInvalidInput, CodeInvalidInput, true},
+	{usecase.ErrInvalidInput, CodeInvalidInput, true},
+	{usecase.ErrNotFound, CodeNotFound, false},
+	{usecase.ErrAlreadyExists, CodeAlreadyExists, false},
+	{usecase.ErrOperationFailed, CodeOperationFailed, false},
+
+	{sql.ErrNoRows, CodeNotFound, false},
+	{sql.ErrConnDone, CodeDatabaseConnection, false},
+	{sql.ErrTxDone, CodeDatabaseConnection, false},
+}
+
+var useCaseErrorCodes = map[string]ErrorCode{
+	usecase.ErrCodeValidation:      CodeInvalidInput,
+	usecase.ErrCodeNotFound:        CodeNotFound,
+	usecase.ErrCodeAlreadyExists:   CodeAlreadyExists,
+	usecase.ErrCodeOperationFailed: CodeOperationFailed,
+	usecase.ErrCodeDatabaseError:   CodeDatabaseError,
+	usecase.ErrCodeTimeout:         CodeTimeout,
+}
+
+type classifiedError struct {
+	code   ErrorCode
+	detail string
+	fields map[string]string
+}
+
+func classifyError(ctx context.Context, err error) classifiedError {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || (ctx != nil && ctx.Err() == context.DeadlineExceeded):
+		return classifiedError{code: CodeTimeout}
+	case errors.Is(err, context.Canceled) || (ctx != nil && ctx.Err() == context.Canceled):
+		return classifiedError{code: CodeCanceled}
+	}
+
+	var validationErr *openapi.ValidationError
+	if errors.As(err, &validationErr) {
+		return classifiedError{code: CodeValidationFailed, detail: validationErr.Message, fields: validationErr.Details}
+	}
+
+	for _, rule := range errorRules {
+		if errors.Is(err, rule.target) {
+			classified := classifiedError{code: rule.code}
+			if rule.detail {
+				classified.detail = err.Error()
+			}
+			return classified
+		}
+	}
+
+	var useCaseErr *usecase.UseCaseError
+	if errors.As(err, &useCaseErr) {
+		if code, ok := useCaseErrorCodes[useCaseErr.Code]; ok {
+			return classifiedError{code: code}
+		}
+	}
+
+	var retryErr *application.RetryExhaustedError
+	var publishErr *application.EventPublishError
+	var transactionErr *application.TransactionError
+	switch {
+	case errors.As(err, &retryErr):
+		return classifiedError{code: CodeServiceUnavailable}
+	case errors.As(err, &publishErr):
+		return classifiedError{code: CodeEventPublishFailed}
+	case errors.As(err, &transactionErr):
+		return classifiedError{code: CodeDatabaseError}
+	}
+
+	if containsAny(err.Error(), []string{"database", "sql", "connection", "transaction", "postgres", "pgx"}) {
+		return classifiedError{code: CodeDatabaseError}
+	}
+	return classifiedError{code: CodeInternal}
+}
+
+func ItemErrorCode(err error) string {
+	return string(requestErrorCode(err))
+}
+
+func requestErrorCode(err error) ErrorCode {
+	code := classifyError(context.Background(), err).code
+	if code.Status() >= http.StatusInternalServerError {
+		return CodeInvalidInput
+	}
+	return code
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase"
+	"product_service/products/mocks"
+)
+
+func TestErrorMapper_MapsSentinelErrors(t *testing.T) {
+	tests := []struct {
+		err        error
+		wantStatus int
+		wantCode   ErrorCode
+		wantDetail bool
+	}{
+		{domain.ErrTokenExpired, http.StatusUnauthorized, CodeTokenExpired, false},
+		{domain.ErrInvalidToken, http.StatusUnauthorized, CodeInvalidToken, false},
+		{domain.ErrUnauthenticated, http.StatusUnauthorized, CodeUnauthenticated, false},
+		{domain.ErrInvalidAPIKey, http.StatusUnauthorized, CodeInvalidAPIKey, false},
+		{domain.ErrInsufficientScope, http.StatusForbidden, CodeInsufficientScope, true},
+		{domain.ErrForbidden, http.StatusForbidden, CodeForbidden, false},
+
+		{domain.ErrAPIKeyNotFound, http.StatusNotFound, CodeAPIKeyNotFound, false},
+		{domain.ErrInvalidAPIKeyScope, http.StatusBadRequest, CodeInvalidScope, true},
+		{domain.ErrInvalidAPIKeyConfig, http.StatusBadRequest, CodeInvalidAPIKeySettings, true},
+		{domain.ErrDeadLetterNotFound, http.StatusNotFound, CodeDeadLetterNotFound, false},
+		{domain.ErrIdempotencyKeyReused, http.StatusUnprocessableEntity, CodeIdempotencyKeyReused, false},
+		{domain.ErrIdempotencyKeyInProgress, http.StatusConflict, CodeIdempotencyInProgress, false},
+
+		{domain.ErrProductNotFound, http.StatusNotFound, CodeProductNotFound, false},
+		{domain.ErrInvalidProductName, http.StatusBadRequest, CodeInvalidProductName, true},
+		{domain.ErrInvalidProductPrice, http.StatusBadRequest, CodeInvalidProductPrice, true},
+		{domain.ErrVersionConflict, http.StatusPreconditionFailed, CodePreconditionFailed, false},
+		{domain.ErrProductNotDeleted, http.StatusConflict, CodeProductNotDeleted, false},
+		{domain.ErrPriceNotAvailable, http.StatusNotFound, CodePriceNotAvailable, false},
+		{domain.ErrInvalidCurrency, http.StatusBadRequest, CodeInvalidCurrency, false},
+		{domain.ErrInvalidAttribute, http.StatusBadRequest, CodeInvalidAttribute, true},
+		{domain.ErrInvalidProductStatus, http.StatusBadRequest, CodeInvalidProductStatus, true},
+		{domain.ErrInvalidStatusTransition, http.StatusConflict, CodeInvalidStatusTransition, true},
+		{domain.ErrInvalidVariantParent, http.StatusConflict, CodeInvalidVariantParent, true},
+		{domain.ErrProductHasVariants, http.StatusConflict, CodeProductHasVariants, true},
+
+		{domain.ErrInsufficientStock, http.StatusConflict, CodeInsufficientStock, false},
+		{domain.ErrReservationNotFound, http.StatusNotFound, CodeReservationNotFound, false},
+		{domain.ErrReservationExpired, http.StatusConflict, CodeReservationExpired, false},
+		{domain.ErrReservationNotActive, http.StatusConflict, CodeReservationNotActive, false},
+
+		{domain.ErrCategoryNotFound, http.StatusNotFound, CodeCategoryNotFound, false},
+		{domain.ErrCategoryInUse, http.StatusConflict, CodeCategoryInUse, true},
+		{domain.ErrInvalidCategoryMove, http.StatusConflict, CodeInvalidCategoryMove, true},
+		{domain.ErrCategorySlugConflict, http.StatusConflict, CodeCategorySlugConflict, false},
+		{domain.ErrInvalidCategory, http.StatusBadRequest, CodeInvalidCategory, true},
+
+		{domain.ErrInvalidInput, http.StatusBadRequest, CodeInvalidInput, true},
+		{usecase.ErrInvalidInput, http.StatusBadRequest, CodeInvalidInput, true},
+		{usecase.ErrNotFound, http.StatusNotFound, CodeNotFound, false},
+		{usecase.ErrAlreadyExists, http.StatusConflict, CodeAlreadyExists, false},
+		{usecase.ErrOperationFailed, http.StatusInternalServerError, CodeOperationFailed, false},
+
+		{sql.ErrNoRows, http.StatusNotFound, CodeNotFound, false},
+		{sql.ErrConnDone, http.StatusInternalServerError, CodeDatabaseConnection, false},
+		{sql.ErrTxDone, http.StatusInternalServerError, CodeDatabaseConnection, false},
+	}
+
+	if len(tests) != len(errorRules) {
+		t.Fatalf("Expected a case for each of the %d error rules, got %d", len(errorRules), len(tests))
+	}
+
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	mapper := NewErrorMapper(logger)
+
+	for _, tt := range tests {
+		t.Run(string(tt.wantCode)+"/"+tt.err.Error(), func(t *testing.T) {
+			err := fmt.Errorf("operation failed: %w", tt.err)
+			rec := httptest.NewRecorder()
+			mapper.MapToHTTPError(rec, err, context.Background())
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if contentType := rec.Header().Get("Content-Type"); contentType != ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", contentType, ProblemContentType)
+			}
+
+			var problem ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("decode problem: %v", err)
+			}
+			if problem.Code != tt.wantCode || problem.Status != tt.wantStatus {
+				t.Errorf("problem code/status = %s/%d, want %s/%d", problem.Code, problem.Status, tt.wantCode, tt.wantStatus)
+			}
+			if problem.Type != tt.wantCode.Type() {
+				t.Errorf("type = %q, want %q", problem.Type, tt.wantCode.Type())
+			}
+			if tt.wantDetail && problem.Detail != err.Error() {
+				t.Errorf("detail = %q, want %q", problem.Detail, err.Error())
+			}
+			if !tt.wantDetail && strings.Contains(problem.Detail, "operation failed") {
+				t.Errorf("Expected the error text not to be exposed, got detail %q", problem.Detail)
+			}
+		})
+	}
+}
@@ -2,15 +2,15 @@ package handler
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
-	"errors"
 	"net/http"
-	"product_service/products/internal/domain"
-	"product_service/products/internal/usecase"
+	"sort"
+
 	"product_service/products/internal/usecase/ports"
 )
 
+const ProblemContentType = "application/problem+json"
+
 type ErrorMapper struct {
 	logger ports.Logger
 }
@@ -21,166 +21,87 @@ func NewErrorMapper(logger ports.Logger) *ErrorMapper {
 	}
 }
 
-type HTTPErrorResponse struct {
-	Error     string            `json:"error"`
-	Code      string            `json:"code,omitempty"`
-	Details   map[string]string `json:"details,omitempty"`
-	RequestID string            `json:"request_id,omitempty"`
+type ProblemDetails struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Code      ErrorCode    `json:"code"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
 }
 
-func (m *ErrorMapper) MapToHTTPError(w http.ResponseWriter, err error, ctx context.Context) {
-	if err == nil {
-		return
-	}
-
-	requestID := ""
-	if reqID, ok := ctx.Value("request_id").(string); ok {
-		requestID = reqID
-	}
-
-	if ctx.Err() == context.DeadlineExceeded {
-		m.logger.Error("Request timeout",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		m.writeErrorResponse(w, http.StatusRequestTimeout, "Request timeout", "TIMEOUT", nil, requestID)
-		return
-	}
-
-	if ctx.Err() == context.Canceled {
-		m.logger.Warn("Request canceled",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		m.writeErrorResponse(w, http.StatusRequestTimeout, "Request canceled", "CANCELED", nil, requestID)
-		return
-	}
-
-	if errors.Is(err, domain.ErrProductNotFound) {
-		m.logger.Warn("Product not found",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		m.writeErrorResponse(w, http.StatusNotFound, "Product not found", "PRODUCT_NOT_FOUND", nil, requestID)
-		return
-	}
-
-	if errors.Is(err, domain.ErrInvalidProductName) {
-		m.logger.Warn("Invalid product name",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		m.writeErrorResponse(w, http.StatusBadRequest, "Invalid product name", "INVALID_PRODUCT_NAME", nil, requestID)
-		return
-	}
-
-	if errors.Is(err, domain.ErrInvalidProductPrice) {
-		m.logger.Warn("Invalid product price",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		m.writeErrorResponse(w, http.StatusBadRequest, "Invalid product price", "INVALID_PRODUCT_PRICE", nil, requestID)
-		return
-	}
+type FieldError struct {
+	Field  string `json:"field"`
+	Detail string `json:"detail"`
+}
 
-	if usecase.IsProductNotFound(err) {
-		m.logger.Warn("Product not found",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		m.writeErrorResponse(w, http.StatusNotFound, "Product not found", "PRODUCT_NOT_FOUND", nil, requestID)
-		return
-	}
+func NewProblemDetails(code ErrorCode, detail string, fields map[string]string, requestID string) ProblemDetails {
+	problem := ProblemDetails{
+		Type:      code.Type(),
+		Title:     code.Title(),
+		Status:    code.Status(),
+		Detail:    detail,
+		Code:      code,
+		RequestID: requestID,
+	}
+	for field, reason := range fields {
+		problem.Errors = append(problem.Errors, FieldError{Field: field, Detail: reason})
+	}
+	sort.Slice(problem.Errors, func(i, j int) bool {
+		return problem.Errors[i].Field < problem.Errors[j].Field
+	})
+	return problem
+}
 
-	if usecase.IsInvalidProductName(err) {
-		m.logger.Warn("Invalid product name",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		message := err.Error()
-		m.writeErrorResponse(w, http.StatusBadRequest, message, "INVALID_PRODUCT_NAME", nil, requestID)
+func (m *ErrorMapper) MapToHTTPError(w http.ResponseWriter, err error, ctx context.Context) {
+	if err == nil {
 		return
 	}
 
-	if usecase.IsInvalidProductPrice(err) {
-		m.logger.Warn("Invalid product price",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		message := err.Error()
-		m.writeErrorResponse(w, http.StatusBadRequest, message, "INVALID_PRODUCT_PRICE", nil, requestID)
-		return
-	}
+	classified := classifyError(ctx, err)
+	requestID := ports.RequestIDFromContext(ctx)
 
-	if errors.Is(err, sql.ErrNoRows) {
-		m.logger.Warn("Resource not found (database)",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		m.writeErrorResponse(w, http.StatusNotFound, "Resource not found", "NOT_FOUND", nil, requestID)
-		return
+	fields := []ports.Field{
+		ports.NewField("error", err),
+		ports.NewField("code", classified.code),
+		ports.NewField("request_id", requestID),
 	}
-
-	if errors.Is(err, sql.ErrConnDone) || errors.Is(err, sql.ErrTxDone) {
-		m.logger.Error("Database connection error",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		m.writeErrorResponse(w, http.StatusInternalServerError, "Database connection error", "DATABASE_CONNECTION_ERROR", nil, requestID)
-		return
+	if classified.code.Status() >= http.StatusInternalServerError {
+		m.logger.Error(classified.code.Title(), fields...)
+	} else {
+		m.logger.Warn(classified.code.Title(), fields...)
 	}
 
-	errStr := err.Error()
-	if containsAny(errStr, []string{"database", "sql", "connection", "transaction", "postgres", "pgx"}) {
-		m.logger.Error("Database-related error",
-			ports.NewField("error", err),
-			ports.NewField("request_id", requestID),
-		)
-		m.writeErrorResponse(w, http.StatusInternalServerError, "Database error occurred", "DATABASE_ERROR", nil, requestID)
-		return
-	}
+	m.writeProblem(w, NewProblemDetails(classified.code, classified.detail, classified.fields, requestID))
+}
 
-	m.logger.Error("Internal server error",
-		ports.NewField("error", err),
-		ports.NewField("error_type", "UNKNOWN"),
-		ports.NewField("request_id", requestID),
-	)
-	m.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", "INTERNAL_ERROR", nil, requestID)
+func (m *ErrorMapper) WriteError(w http.ResponseWriter, ctx context.Context, code ErrorCode, detail string) {
+	m.writeProblem(w, NewProblemDetails(code, detail, nil, ports.RequestIDFromContext(ctx)))
 }
 
-func (m *ErrorMapper) writeErrorResponse(
-	w http.ResponseWriter,
-	status int,
-	message string,
-	code string,
-	details map[string]string,
-	requestID string,
-) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-
-	response := HTTPErrorResponse{
-		Error: message,
-		Code:  code,
-		Details: details,
+func (m *ErrorMapper) writeProblem(w http.ResponseWriter, problem ProblemDetails) {
+	switch problem.Code {
+	case CodeTokenExpired:
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token", error_description="token expired"`)
+	case CodeInvalidToken:
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+	case CodeUnauthenticated:
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	case CodeInvalidAPIKey:
+		w.Header().Set("WWW-Authenticate", `APIKey realm="products"`)
 	}
 
-	if requestID != "" {
-		response.RequestID = requestID
-	}
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
 
-	if err := json.NewEncoder(w).Encode(response); err != nil {
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
 		m.logger.Error("Failed to encode error response",
 			ports.NewField("error", err),
 		)
-		http.Error(w, message, status)
 	}
 }
 
-func (m *ErrorMapper) writeError(w http.ResponseWriter, status int, message string) {
-	m.writeErrorResponse(w, status, message, "", nil, "")
-}
-
 func containsAny(s string, substrings []string) bool {
 	for _, substr := range substrings {
 		if len(s) >= len(substr) {
@@ -193,4 +114,3 @@ func containsAny(s string, substrings []string) bool {
 	}
 	return false
 }
-
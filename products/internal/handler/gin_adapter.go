@@ -12,9 +12,9 @@ type GinProductHandler struct {
 	httpHandler *HTTPProductHandler
 }
 
-func NewGinProductHandler(useCase usecase.ProductUseCase, logger ports.Logger, metrics ports.MetricsCollector, requestTimeout, readTimeout time.Duration) *GinProductHandler {
+func NewGinProductHandler(useCase usecase.ProductUseCase, inventory usecase.InventoryUseCase, categories usecase.CategoryUseCase, history usecase.ProductHistoryUseCase, apiKeys usecase.APIKeyUseCase, outbox usecase.OutboxAdminUseCase, logger ports.Logger, metrics ports.MetricsCollector, errorMapper *ErrorMapper, requestTimeout, readTimeout time.Duration) *GinProductHandler {
 	return &GinProductHandler{
-		httpHandler: NewHTTPProductHandler(useCase, logger, metrics, requestTimeout, readTimeout),
+		httpHandler: NewHTTPProductHandler(useCase, inventory, categories, history, apiKeys, outbox, logger, metrics, errorMapper, requestTimeout, readTimeout),
 	}
 }
 
@@ -26,8 +26,149 @@ func (h *GinProductHandler) GetProducts(c *gin.Context) {
 	h.httpHandler.GetProducts(c.Writer, c.Request)
 }
 
+func (h *GinProductHandler) SearchProducts(c *gin.Context) {
+	h.httpHandler.SearchProducts(c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) GetProduct(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.GetProduct(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) UpdateProduct(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.UpdateProduct(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) PatchProduct(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.PatchProduct(id, c.Writer, c.Request)
+}
+
 func (h *GinProductHandler) DeleteProduct(c *gin.Context) {
 	id := c.Param("id")
 	h.httpHandler.DeleteProduct(id, c.Writer, c.Request)
 }
 
+
+func (h *GinProductHandler) RestoreProduct(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.RestoreProduct(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) TransitionProduct(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.TransitionProduct(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) CreateVariant(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.CreateVariant(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) GetInventory(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.GetInventory(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) AdjustStock(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.AdjustStock(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) ReserveStock(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.ReserveStock(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) ConfirmReservation(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.ConfirmReservation(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) ReleaseReservation(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.ReleaseReservation(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) CreateCategory(c *gin.Context) {
+	h.httpHandler.CreateCategory(c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) GetCategories(c *gin.Context) {
+	h.httpHandler.GetCategories(c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) GetCategory(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.GetCategory(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) PatchCategory(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.PatchCategory(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) MoveCategory(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.MoveCategory(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) DeleteCategory(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.DeleteCategory(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) GetProductCategories(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.GetProductCategories(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) SetProductCategories(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.SetProductCategories(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) GetProductHistory(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.GetProductHistory(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) ExportProducts(c *gin.Context) {
+	h.httpHandler.ExportProducts(c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) ImportProducts(c *gin.Context) {
+	h.httpHandler.ImportProducts(c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) BatchCreateProducts(c *gin.Context) {
+	h.httpHandler.BatchCreateProducts(c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) CreateAPIKey(c *gin.Context) {
+	h.httpHandler.CreateAPIKey(c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) GetAPIKeys(c *gin.Context) {
+	h.httpHandler.GetAPIKeys(c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) SetAPIKeyScopes(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.SetAPIKeyScopes(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) RevokeAPIKey(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.RevokeAPIKey(id, c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) GetDeadLetters(c *gin.Context) {
+	h.httpHandler.GetDeadLetters(c.Writer, c.Request)
+}
+
+func (h *GinProductHandler) RetryDeadLetter(c *gin.Context) {
+	id := c.Param("id")
+	h.httpHandler.RetryDeadLetter(id, c.Writer, c.Request)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase/ports"
+)
+
+func (h *HTTPProductHandler) GetProductHistory(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	page, limit := parsePageParams(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
+	defer cancel()
+
+	history, err := h.history.GetProductHistory(ctx, id, page, limit)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "get_product_history", err, ports.NewField("product_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	response := dto.ProductHistoryResponse{
+		ProductID: id,
+		Entries:   make([]dto.ProductHistoryEntryResponse, len(history.Entries)),
+		Page:      history.Page,
+		Limit:     history.Limit,
+		Total:     history.Total,
+	}
+	for i, entry := range history.Entries {
+		response.Entries[i] = dto.ToProductHistoryEntryResponse(entry)
+	}
+
+	h.writeJSON(w, http.StatusOK, response)
+}
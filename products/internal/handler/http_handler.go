@@ -5,11 +5,15 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"fmt"
 	"net/http"
+	"product_service/products/internal/domain"
 	"product_service/products/internal/handler/dto"
 	"product_service/products/internal/usecase"
 	"product_service/products/internal/usecase/ports"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,6 +23,11 @@ const (
 
 type HTTPProductHandler struct {
 	useCase        usecase.ProductUseCase
+	inventory      usecase.InventoryUseCase
+	categories     usecase.CategoryUseCase
+	history        usecase.ProductHistoryUseCase
+	apiKeys        usecase.APIKeyUseCase
+	outbox         usecase.OutboxAdminUseCase
 	logger         ports.Logger
 	metrics        ports.MetricsCollector
 	errorMapper    *ErrorMapper
@@ -26,12 +35,17 @@ type HTTPProductHandler struct {
 	readTimeout    time.Duration
 }
 
-func NewHTTPProductHandler(useCase usecase.ProductUseCase, logger ports.Logger, metrics ports.MetricsCollector, requestTimeout, readTimeout time.Duration) *HTTPProductHandler {
+func NewHTTPProductHandler(useCase usecase.ProductUseCase, inventory usecase.InventoryUseCase, categories usecase.CategoryUseCase, history usecase.ProductHistoryUseCase, apiKeys usecase.APIKeyUseCase, outbox usecase.OutboxAdminUseCase, logger ports.Logger, metrics ports.MetricsCollector, errorMapper *ErrorMapper, requestTimeout, readTimeout time.Duration) *HTTPProductHandler {
 	return &HTTPProductHandler{
 		useCase:        useCase,
+		inventory:      inventory,
+		categories:     categories,
+		history:        history,
+		apiKeys:        apiKeys,
+		outbox:         outbox,
 		logger:         logger,
 		metrics:        metrics,
-		errorMapper:    NewErrorMapper(logger),
+		errorMapper:    errorMapper,
 		requestTimeout: requestTimeout,
 		readTimeout:    readTimeout,
 	}
@@ -46,15 +60,17 @@ func (h *HTTPProductHandler) CreateProduct(w http.ResponseWriter, r *http.Reques
 		h.logger.Warn("Invalid request body",
 			ports.NewField("error", err),
 		)
-		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		h.writeError(w, r, CodeInvalidRequestBody, "")
 		return
 	}
 
-	if err := ValidateCreateProductRequest(req); err != nil {
-		h.logger.Warn("Invalid product data",
-			ports.NewField("error", err),
-		)
-		h.writeError(w, http.StatusBadRequest, err.Error())
+	price, prices, ok := h.parseCreatePrices(w, r, req)
+	if !ok {
+		return
+	}
+
+	attributes, ok := h.parseAttributes(w, r, req.Attributes)
+	if !ok {
 		return
 	}
 
@@ -63,7 +79,7 @@ func (h *HTTPProductHandler) CreateProduct(w http.ResponseWriter, r *http.Reques
 	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
 	defer cancel()
 
-	product, err := h.useCase.CreateProduct(ctx, req.Name, req.Price, idempotencyKey)
+	product, err := h.useCase.CreateProduct(ctx, req.Name, price, prices, attributes, idempotencyKey)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 			h.handleContextError(ctx, w, "create_product", err)
@@ -73,7 +89,7 @@ func (h *HTTPProductHandler) CreateProduct(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	response := dto.ToProductResponse(product)
+	response := dto.ToProductResponse(product, dto.PriceOptions{Format: ParsePriceFormat(r)})
 
 	if h.metrics != nil {
 		h.metrics.IncrementProductsCreated()
@@ -83,16 +99,30 @@ func (h *HTTPProductHandler) CreateProduct(w http.ResponseWriter, r *http.Reques
 		ports.NewField("id", product.ID),
 		ports.NewField("name", product.Name.Value()),
 	)
+	setETag(w, product.Version)
 	h.writeJSON(w, http.StatusCreated, response)
 }
 
 func (h *HTTPProductHandler) GetProducts(w http.ResponseWriter, r *http.Request) {
-	page, limit := ParsePaginationParams(r)
+	query, err := ParseListQuery(r)
+	if err != nil {
+		h.logger.Warn("Invalid product list query",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, requestErrorCode(err), err.Error())
+		return
+	}
+
+	priceOptions, err := ParsePriceOptions(r)
+	if err != nil {
+		h.errorMapper.MapToHTTPError(w, err, r.Context())
+		return
+	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
 	defer cancel()
 
-	products, total, err := h.useCase.GetProducts(ctx, page, limit)
+	result, err := h.useCase.GetProducts(ctx, query)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 			h.handleContextError(ctx, w, "get_products", err)
@@ -103,28 +133,151 @@ func (h *HTTPProductHandler) GetProducts(w http.ResponseWriter, r *http.Request)
 	}
 
 	response := dto.ProductListResponse{
-		Products: dto.ToProductResponseList(products),
-		Page:     page,
-		Limit:    limit,
-		Total:    total,
+		Products:   dto.ToProductResponseList(result.Products, priceOptions),
+		Limit:      result.Limit,
+		NextCursor: EncodeCursor(result.NextCursor),
+		PrevCursor: EncodeCursor(result.PrevCursor),
+	}
+	if !query.IsCursorMode() {
+		total := result.Total
+		response.Page = result.Page
+		response.Total = &total
 	}
 
 	h.writeJSON(w, http.StatusOK, response)
 }
 
-func (h *HTTPProductHandler) DeleteProduct(idStr string, w http.ResponseWriter, r *http.Request) {
-	if idStr == "" {
-		h.writeError(w, http.StatusBadRequest, "Product ID is required")
+func (h *HTTPProductHandler) SearchProducts(w http.ResponseWriter, r *http.Request) {
+	query := ports.ProductSearchQuery{
+		Text: r.URL.Query().Get("q"),
+	}
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			query.Limit = l
+		}
+	}
+
+	priceOptions, err := ParsePriceOptions(r)
+	if err != nil {
+		h.errorMapper.MapToHTTPError(w, err, r.Context())
 		return
 	}
 
-	id, err := strconv.Atoi(idStr)
+	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
+	defer cancel()
+
+	result, err := h.useCase.SearchProducts(ctx, query)
 	if err != nil {
-		h.logger.Warn("Invalid product ID",
-			ports.NewField("id", idStr),
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "search_products", err)
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.ToProductSearchResponse(query.Text, result, priceOptions))
+}
+
+func (h *HTTPProductHandler) GetProduct(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	priceOptions, err := ParsePriceOptions(r)
+	if err != nil {
+		h.errorMapper.MapToHTTPError(w, err, r.Context())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
+	defer cancel()
+
+	product, err := h.useCase.GetProduct(ctx, id, ParseIncludeDeleted(r))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "get_product", err, ports.NewField("product_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	if priceOptions.Currency != "" {
+		if _, ok := product.PriceIn(priceOptions.Currency); !ok {
+			h.errorMapper.MapToHTTPError(w, fmt.Errorf("product %d has no %s price: %w", id, priceOptions.Currency, domain.ErrPriceNotAvailable), ctx)
+			return
+		}
+	}
+
+	setETag(w, product.Version)
+	h.writeJSON(w, http.StatusOK, dto.ToProductResponse(product, priceOptions))
+}
+
+func (h *HTTPProductHandler) UpdateProduct(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.UpdateProductRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
 			ports.NewField("error", err),
 		)
-		h.writeError(w, http.StatusBadRequest, "Invalid product ID")
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	attributes, ok := h.parseAttributes(w, r, req.Attributes)
+	if !ok {
+		return
+	}
+
+	price := usecase.PriceInput{Amount: string(req.Price), Currency: req.Currency}
+	h.applyProductUpdate(w, r, id, usecase.ProductUpdate{Name: &req.Name, Price: &price, Attributes: attributes}, "update_product")
+}
+
+func (h *HTTPProductHandler) PatchProduct(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.PatchProductRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	update := usecase.ProductUpdate{Name: req.Name}
+	if req.Price != nil {
+		update.Price = &usecase.PriceInput{Amount: string(*req.Price), Currency: req.Currency}
+	} else if req.Currency != "" {
+		h.writeError(w, r, CodeInvalidInput, "currency can only be changed together with price")
+		return
+	}
+
+	if update.Attributes, ok = h.parseAttributes(w, r, req.Attributes); !ok {
+		return
+	}
+
+	h.applyProductUpdate(w, r, id, update, "patch_product")
+}
+
+func (h *HTTPProductHandler) applyProductUpdate(w http.ResponseWriter, r *http.Request, id int, update usecase.ProductUpdate, operation string) {
+	expectedVersion, ok := h.parseIfMatch(w, r)
+	if !ok {
 		return
 	}
 
@@ -133,7 +286,45 @@ func (h *HTTPProductHandler) DeleteProduct(idStr string, w http.ResponseWriter,
 	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
 	defer cancel()
 
-	err = h.useCase.DeleteProduct(ctx, id, idempotencyKey)
+	product, err := h.useCase.UpdateProduct(ctx, id, update, expectedVersion, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, operation, err, ports.NewField("product_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	if h.metrics != nil {
+		h.metrics.IncrementProductsUpdated()
+	}
+
+	h.logger.Info("Product updated",
+		ports.NewField("id", product.ID),
+		ports.NewField("name", product.Name.Value()),
+	)
+	setETag(w, product.Version)
+	h.writeJSON(w, http.StatusOK, dto.ToProductResponse(product, dto.PriceOptions{Format: ParsePriceFormat(r)}))
+}
+
+func (h *HTTPProductHandler) DeleteProduct(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	expectedVersion, ok := h.parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	err := h.useCase.DeleteProduct(ctx, id, expectedVersion, idempotencyKey)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 			h.handleContextError(ctx, w, "delete_product", err, ports.NewField("product_id", id))
@@ -153,6 +344,133 @@ func (h *HTTPProductHandler) DeleteProduct(idStr string, w http.ResponseWriter,
 	h.writeJSON(w, http.StatusOK, map[string]string{"message": "Product deleted successfully"})
 }
 
+func (h *HTTPProductHandler) RestoreProduct(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	expectedVersion, ok := h.parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	product, err := h.useCase.RestoreProduct(ctx, id, expectedVersion, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "restore_product", err, ports.NewField("product_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	if h.metrics != nil {
+		h.metrics.IncrementProductsRestored()
+	}
+
+	h.logger.Info("Product restored",
+		ports.NewField("id", id),
+	)
+	setETag(w, product.Version)
+	h.writeJSON(w, http.StatusOK, dto.ToProductResponse(product, dto.PriceOptions{Format: ParsePriceFormat(r)}))
+}
+
+func (h *HTTPProductHandler) parseCreatePrices(w http.ResponseWriter, r *http.Request, req dto.CreateProductRequest) (domain.Money, []domain.Money, bool) {
+	price, prices, err := createPrices(req)
+	if err != nil {
+		h.logger.Warn("Invalid product price",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, requestErrorCode(err), err.Error())
+		return domain.Money{}, nil, false
+	}
+	return price, prices, true
+}
+
+func createPrices(req dto.CreateProductRequest) (domain.Money, []domain.Money, error) {
+	currency := req.Currency
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+
+	prices := make([]domain.Money, 0, len(req.Prices))
+	for priceCurrency, amount := range req.Prices {
+		price, err := amount.Money(priceCurrency)
+		if err != nil {
+			return domain.Money{}, nil, err
+		}
+		prices = append(prices, price)
+	}
+	slices.SortFunc(prices, func(a, b domain.Money) int {
+		return strings.Compare(a.Currency(), b.Currency())
+	})
+
+	if req.Price != "" {
+		price, err := req.Price.Money(currency)
+		return price, prices, err
+	}
+
+	for _, price := range prices {
+		if strings.EqualFold(price.Currency(), currency) {
+			return price, prices, nil
+		}
+	}
+
+	return domain.Money{}, nil, fmt.Errorf("price in base currency %s is required", strings.ToUpper(currency))
+}
+
+func (h *HTTPProductHandler) parseAttributes(w http.ResponseWriter, r *http.Request, values map[string]interface{}) (domain.Attributes, bool) {
+	if values == nil {
+		return nil, true
+	}
+
+	attributes, err := domain.NewAttributes(values)
+	if err != nil {
+		h.logger.Warn("Invalid product attributes",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, requestErrorCode(err), err.Error())
+		return nil, false
+	}
+	return attributes, true
+}
+
+func (h *HTTPProductHandler) parseProductID(idStr string, w http.ResponseWriter, r *http.Request) (int, bool) {
+	if idStr == "" {
+		h.writeError(w, r, CodeInvalidInput, "Product ID is required")
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warn("Invalid product ID",
+			ports.NewField("id", idStr),
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidInput, "Invalid product ID")
+		return 0, false
+	}
+
+	return id, true
+}
+
+func (h *HTTPProductHandler) parseIfMatch(w http.ResponseWriter, r *http.Request) (int, bool) {
+	version, err := ParseIfMatch(r)
+	if err != nil {
+		h.logger.Warn("Invalid If-Match header",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidIfMatch, "")
+		return 0, false
+	}
+	return version, true
+}
 
 func (h *HTTPProductHandler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -172,10 +490,10 @@ func (h *HTTPProductHandler) handleContextError(ctx context.Context, w http.Resp
 	fields = append(fields, additionalFields...)
 	
 	h.logger.Warn("Request timeout or cancelled", fields...)
-	h.writeError(w, http.StatusRequestTimeout, "Request timeout")
+	h.errorMapper.WriteError(w, ctx, classifyError(ctx, err).code, "")
 }
 
-func (h *HTTPProductHandler) writeError(w http.ResponseWriter, status int, message string) {
-	h.writeJSON(w, status, map[string]string{"error": message})
+func (h *HTTPProductHandler) writeError(w http.ResponseWriter, r *http.Request, code ErrorCode, detail string) {
+	h.errorMapper.WriteError(w, r.Context(), code, detail)
 }
 
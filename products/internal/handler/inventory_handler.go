@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase/ports"
+	"strconv"
+	"time"
+)
+
+func (h *HTTPProductHandler) GetInventory(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
+	defer cancel()
+
+	inventory, err := h.inventory.GetInventory(ctx, id)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "get_inventory", err, ports.NewField("product_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, dto.ToInventoryResponse(inventory))
+}
+
+func (h *HTTPProductHandler) AdjustStock(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.StockAdjustmentRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	inventory, err := h.inventory.AdjustStock(ctx, id, req.Delta, req.Reason, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "adjust_stock", err, ports.NewField("product_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Stock adjusted",
+		ports.NewField("product_id", id),
+		ports.NewField("delta", req.Delta),
+	)
+	h.writeJSON(w, http.StatusOK, dto.ToInventoryResponse(inventory))
+}
+
+func (h *HTTPProductHandler) ReserveStock(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.CreateReservationRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	ttl := time.Duration(req.TTLSeconds) * time.Second
+	reservation, inventory, err := h.inventory.ReserveStock(ctx, id, req.Quantity, ttl, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "reserve_stock", err, ports.NewField("product_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Stock reserved",
+		ports.NewField("product_id", id),
+		ports.NewField("reservation_id", reservation.ID),
+	)
+	h.writeJSON(w, http.StatusCreated, dto.ToReservationResultResponse(reservation, inventory))
+}
+
+func (h *HTTPProductHandler) ConfirmReservation(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseReservationID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	reservation, inventory, err := h.inventory.ConfirmReservation(ctx, id, r.Header.Get("Idempotency-Key"))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "confirm_reservation", err, ports.NewField("reservation_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Reservation confirmed",
+		ports.NewField("reservation_id", id),
+	)
+	h.writeJSON(w, http.StatusOK, dto.ToReservationResultResponse(reservation, inventory))
+}
+
+func (h *HTTPProductHandler) ReleaseReservation(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseReservationID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	reservation, inventory, err := h.inventory.ReleaseReservation(ctx, id, r.Header.Get("Idempotency-Key"))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "release_reservation", err, ports.NewField("reservation_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Reservation released",
+		ports.NewField("reservation_id", id),
+	)
+	h.writeJSON(w, http.StatusOK, dto.ToReservationResultResponse(reservation, inventory))
+}
+
+func (h *HTTPProductHandler) parseReservationID(idStr string, w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		h.logger.Warn("Invalid reservation ID",
+			ports.NewField("id", idStr),
+		)
+		h.writeError(w, r, CodeInvalidInput, "Invalid reservation ID")
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase/ports"
+)
+
+func (h *HTTPProductHandler) TransitionProduct(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	expectedVersion, ok := h.parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.TransitionProductRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	target, err := domain.ParseProductStatus(req.Status)
+	if err != nil {
+		h.logger.Warn("Invalid product status",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, requestErrorCode(err), err.Error())
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	product, err := h.useCase.TransitionProduct(ctx, id, target, expectedVersion, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "transition_product", err, ports.NewField("product_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	h.logger.Info("Product status changed",
+		ports.NewField("id", id),
+		ports.NewField("status", product.Status.String()),
+	)
+	setETag(w, product.Version)
+	h.writeJSON(w, http.StatusOK, dto.ToProductResponse(product, dto.PriceOptions{Format: ParsePriceFormat(r)}))
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase/ports"
+	"strconv"
+)
+
+func (h *HTTPProductHandler) GetDeadLetters(w http.ResponseWriter, r *http.Request) {
+	page, limit := parsePageParams(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.readTimeout)
+	defer cancel()
+
+	deadLetters, err := h.outbox.ListDeadLetters(ctx, page, limit)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "list_dead_letters", err)
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	response := dto.DeadLetterListResponse{
+		Events: make([]dto.DeadLetterResponse, len(deadLetters.Events)),
+		Page:   deadLetters.Page,
+		Limit:  deadLetters.Limit,
+		Total:  deadLetters.Total,
+	}
+	for i, event := range deadLetters.Events {
+		response.Events[i] = dto.ToDeadLetterResponse(event)
+	}
+
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+func (h *HTTPProductHandler) RetryDeadLetter(idStr string, w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		h.logger.Warn("Invalid event ID",
+			ports.NewField("id", idStr),
+		)
+		h.writeError(w, r, CodeInvalidInput, "Invalid event ID")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	if err := h.outbox.RetryDeadLetter(ctx, id); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "retry_dead_letter", err, ports.NewField("event_id", id))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
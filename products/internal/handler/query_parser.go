@@ -1,26 +1,284 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase/ports"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 )
 
-func ParsePaginationParams(r *http.Request) (page, limit int) {
-	page = 1
-	limit = 10
+const attributeFilterPrefix = "attr."
+
+var allowedSorts = map[string]ports.ProductSort{
+	"created_at":  {Field: ports.SortByCreatedAt},
+	"-created_at": {Field: ports.SortByCreatedAt, Descending: true},
+	"price":       {Field: ports.SortByPrice},
+	"-price":      {Field: ports.SortByPrice, Descending: true},
+	"name":        {Field: ports.SortByName},
+	"-name":       {Field: ports.SortByName, Descending: true},
+}
+
+func ParsePaginationParams(r *http.Request) (ports.ProductListQuery, error) {
+	query := ports.ProductListQuery{
+		Page:  1,
+		Limit: 10,
+	}
 
 	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
 		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
-			page = p
+			query.Page = p
 		}
 	}
 
 	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
 		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
-			limit = l
+			query.Limit = l
+		}
+	}
+
+	if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+		cursor, err := DecodeCursor(cursorStr)
+		if err != nil {
+			return ports.ProductListQuery{}, err
+		}
+		query.Cursor = cursor
+	}
+
+	return query, nil
+}
+
+func ParsePriceFormat(r *http.Request) dto.PriceFormat {
+	format := r.URL.Query().Get("price_format")
+	if format == "" {
+		format = r.Header.Get("X-Price-Format")
+	}
+	if format == "string" {
+		return dto.PriceFormatString
+	}
+	return dto.PriceFormatNumber
+}
+
+func ParsePriceOptions(r *http.Request) (dto.PriceOptions, error) {
+	opts := dto.PriceOptions{Format: ParsePriceFormat(r)}
+	if currency := r.URL.Query().Get("currency"); currency != "" {
+		normalized, err := domain.NormalizeCurrency(currency)
+		if err != nil {
+			return dto.PriceOptions{}, fmt.Errorf("invalid currency %q: %w", currency, err)
+		}
+		opts.Currency = normalized
+	}
+	return opts, nil
+}
+
+func ParseIncludeDeleted(r *http.Request) bool {
+	includeDeleted, err := strconv.ParseBool(r.URL.Query().Get("include_deleted"))
+	return err == nil && includeDeleted
+}
+
+func ParseSortParam(r *http.Request) (ports.ProductSort, error) {
+	sortStr := r.URL.Query().Get("sort")
+	if sortStr == "" {
+		return ports.DefaultProductSort(), nil
+	}
+	return ParseSort(sortStr)
+}
+
+func ParseSort(value string) (ports.ProductSort, error) {
+	sort, ok := allowedSorts[value]
+	if !ok {
+		return ports.ProductSort{}, fmt.Errorf("unsupported sort: %q", value)
+	}
+	return sort, nil
+}
+
+func ParseListFilter(r *http.Request) (ports.ProductListFilter, error) {
+	values := r.URL.Query()
+	filter := ports.ProductListFilter{
+		NameContains: values.Get("name_contains"),
+		NamePrefix:   values.Get("name_prefix"),
+	}
+
+	if currency := values.Get("currency"); currency != "" {
+		normalized, err := domain.NormalizeCurrency(currency)
+		if err != nil {
+			return ports.ProductListFilter{}, fmt.Errorf("invalid currency %q: %w", currency, err)
+		}
+		filter.Currency = normalized
+	}
+
+	var err error
+	if filter.MinPrice, err = parseOptionalMoney(values.Get("min_price"), "min_price", filter.PriceCurrency()); err != nil {
+		return ports.ProductListFilter{}, err
+	}
+	if filter.MaxPrice, err = parseOptionalMoney(values.Get("max_price"), "max_price", filter.PriceCurrency()); err != nil {
+		return ports.ProductListFilter{}, err
+	}
+	if filter.CreatedAfter, err = parseOptionalTime(values.Get("created_after"), "created_after"); err != nil {
+		return ports.ProductListFilter{}, err
+	}
+	if filter.CreatedBefore, err = parseOptionalTime(values.Get("created_before"), "created_before"); err != nil {
+		return ports.ProductListFilter{}, err
+	}
+	if parentIDStr := values.Get("parent_id"); parentIDStr != "" {
+		parentID, err := strconv.Atoi(parentIDStr)
+		if err != nil || parentID <= 0 {
+			return ports.ProductListFilter{}, fmt.Errorf("invalid parent_id: %q", parentIDStr)
+		}
+		filter.ParentID = &parentID
+	}
+	if filter.Statuses, err = parseStatusFilter(values); err != nil {
+		return ports.ProductListFilter{}, err
+	}
+	if filter.Attributes, err = parseAttributeFilter(values); err != nil {
+		return ports.ProductListFilter{}, err
+	}
+	if filter.Category, err = parseCategoryFilter(values); err != nil {
+		return ports.ProductListFilter{}, err
+	}
+
+	return filter, nil
+}
+
+func ParseListQuery(r *http.Request) (ports.ProductListQuery, error) {
+	query, err := ParsePaginationParams(r)
+	if err != nil {
+		return ports.ProductListQuery{}, err
+	}
+
+	if query.Sort, err = ParseSortParam(r); err != nil {
+		return ports.ProductListQuery{}, err
+	}
+	if query.Cursor != nil {
+		if r.URL.Query().Get("sort") != "" && query.Cursor.Sort != query.Sort {
+			return ports.ProductListQuery{}, fmt.Errorf("sort %q does not match cursor sort %q", query.Sort.String(), query.Cursor.Sort.String())
+		}
+		query.Sort = query.Cursor.Sort
+	}
+
+	if query.Filter, err = ParseListFilter(r); err != nil {
+		return ports.ProductListQuery{}, err
+	}
+	query.IncludeDeleted = ParseIncludeDeleted(r)
+
+	return query, nil
+}
+
+func parseStatusFilter(values url.Values) ([]domain.ProductStatus, error) {
+	var statuses []domain.ProductStatus
+	for _, value := range values["status"] {
+		for _, part := range strings.Split(value, ",") {
+			if strings.TrimSpace(part) == "" {
+				continue
+			}
+			status, err := domain.ParseProductStatus(part)
+			if err != nil {
+				return nil, fmt.Errorf("invalid status: %q", part)
+			}
+			if !slices.Contains(statuses, status) {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	return statuses, nil
+}
+
+func parseCategoryFilter(values url.Values) (*ports.CategoryFilter, error) {
+	category := values.Get("category")
+	includeDescendants := values.Get("include_descendants")
+	if category == "" {
+		if includeDescendants != "" {
+			return nil, fmt.Errorf("include_descendants requires category")
 		}
+		return nil, nil
 	}
 
-	return page, limit
+	filter := &ports.CategoryFilter{}
+	if id, err := strconv.Atoi(category); err == nil {
+		if id <= 0 {
+			return nil, fmt.Errorf("invalid category: %q", category)
+		}
+		filter.ID = id
+	} else {
+		if err := domain.ValidateCategorySlug(category); err != nil {
+			return nil, fmt.Errorf("invalid category: %w", err)
+		}
+		filter.Slug = category
+	}
+
+	if includeDescendants != "" {
+		include, err := strconv.ParseBool(includeDescendants)
+		if err != nil {
+			return nil, fmt.Errorf("invalid include_descendants: %q", includeDescendants)
+		}
+		filter.IncludeDescendants = include
+	}
+	return filter, nil
 }
 
+func parseAttributeFilter(values url.Values) (domain.Attributes, error) {
+	var attributes domain.Attributes
+	for param, raw := range values {
+		key, ok := strings.CutPrefix(param, attributeFilterPrefix)
+		if !ok {
+			continue
+		}
+		if err := domain.ValidateAttributeKey(key); err != nil {
+			return nil, err
+		}
+		if len(raw) > 1 {
+			return nil, fmt.Errorf("%s must be given at most once", param)
+		}
+
+		value, err := parseAttributeFilterValue(key, raw[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", param, err)
+		}
+		if attributes == nil {
+			attributes = make(domain.Attributes)
+		}
+		attributes[key] = value
+	}
+	return attributes, nil
+}
+
+func parseAttributeFilterValue(key, raw string) (domain.AttributeValue, error) {
+	if attributeType, ok := domain.WellKnownAttributeType(key); ok {
+		return domain.ParseAttributeValue(raw, attributeType)
+	}
+	if raw == "true" || raw == "false" {
+		return domain.ParseAttributeValue(raw, domain.AttributeTypeBoolean)
+	}
+	if value, err := domain.ParseAttributeValue(raw, domain.AttributeTypeNumber); err == nil {
+		return value, nil
+	}
+	return domain.StringAttribute(raw), nil
+}
+
+func parseOptionalMoney(value, name, currency string) (*domain.Money, error) {
+	if value == "" {
+		return nil, nil
+	}
+	money, err := domain.ParsePriceBound(value, currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %q: %w", name, value, err)
+	}
+	return &money, nil
+}
+
+func parseOptionalTime(value, name string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %q, expected RFC3339", name, value)
+	}
+	t = t.UTC()
+	return &t, nil
+}
@@ -0,0 +1,446 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxImportBodySize = 32 << 20
+
+	formatCSV    = "csv"
+	formatNDJSON = "ndjson"
+
+	contentTypeCSV    = "text/csv"
+	contentTypeNDJSON = "application/x-ndjson"
+)
+
+var productCSVHeader = []string{"id", "name", "price", "currency", "prices", "attributes", "parent_id", "status", "version", "created_at"}
+
+var transferMediaTypes = map[string]string{
+	"text/csv":             formatCSV,
+	"application/x-ndjson": formatNDJSON,
+	"application/ndjson":   formatNDJSON,
+	"application/jsonl":    formatNDJSON,
+}
+
+func (h *HTTPProductHandler) ExportProducts(w http.ResponseWriter, r *http.Request) {
+	format, ok := negotiateExportFormat(r.Header.Get("Accept"))
+	if !ok {
+		h.writeError(w, r, CodeNotAcceptable, "Export is available as text/csv or application/x-ndjson")
+		return
+	}
+
+	filter, err := ParseListFilter(r)
+	if err != nil {
+		h.logger.Warn("Invalid product export query",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, requestErrorCode(err), err.Error())
+		return
+	}
+	query := ports.ProductListQuery{Filter: filter, IncludeDeleted: ParseIncludeDeleted(r)}
+
+	writer := newProductExportWriter(w, format)
+	started := false
+	exported, err := h.useCase.ExportProducts(r.Context(), query, func(product *domain.Product) error {
+		if !started {
+			writer.Start()
+			started = true
+		}
+		if err := writer.Write(product); err != nil {
+			return err
+		}
+		if writer.Rows()%usecase.ExportChunkSize == 0 {
+			return writer.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		if started {
+			h.logger.Error("Product export aborted",
+				ports.NewField("error", err),
+				ports.NewField("exported", exported),
+			)
+			return
+		}
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(r.Context(), w, "export_products", err)
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, r.Context())
+		return
+	}
+
+	if !started {
+		writer.Start()
+	}
+	if err := writer.Flush(); err != nil {
+		h.logger.Error("Failed to flush product export",
+			ports.NewField("error", err),
+		)
+		return
+	}
+
+	h.logger.Info("Products exported",
+		ports.NewField("format", format),
+		ports.NewField("count", exported),
+	)
+}
+
+func (h *HTTPProductHandler) ImportProducts(w http.ResponseWriter, r *http.Request) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	format, ok := transferMediaTypes[mediaType]
+	if err != nil || !ok {
+		h.writeError(w, r, CodeUnsupportedMediaType, "Import accepts text/csv or application/x-ndjson")
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxImportBodySize)
+	defer r.Body.Close()
+
+	var source usecase.ProductImportSource
+	if format == formatCSV {
+		source, err = newCSVImportSource(body)
+		if err != nil {
+			h.logger.Warn("Invalid CSV import header",
+				ports.NewField("error", err),
+			)
+			h.writeError(w, r, requestErrorCode(err), err.Error())
+			return
+		}
+	} else {
+		source = newNDJSONImportSource(body)
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	report, err := h.useCase.ImportProducts(r.Context(), source, idempotencyKey)
+	if err != nil && (errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)) {
+		h.handleContextError(r.Context(), w, "import_products", err)
+		return
+	}
+
+	if h.metrics != nil {
+		for i := 0; i < report.Created; i++ {
+			h.metrics.IncrementProductsCreated()
+		}
+	}
+
+	response := dto.ImportProductsResponse{
+		Format:    format,
+		Created:   report.Created,
+		Updated:   report.Updated,
+		Unchanged: report.Unchanged,
+		Rejected:  len(report.Rejected),
+		Errors:    make([]dto.ImportRowErrorResponse, len(report.Rejected)),
+	}
+	for i, rejected := range report.Rejected {
+		response.Errors[i] = dto.ImportRowErrorResponse{
+			Line:    rejected.Line,
+			Code:    ItemErrorCode(rejected.Err),
+			Message: rejected.Err.Error(),
+		}
+	}
+
+	status := http.StatusOK
+	if err != nil {
+		response.Error = err.Error()
+		status = http.StatusUnprocessableEntity
+	}
+
+	h.logger.Info("Products imported",
+		ports.NewField("format", format),
+		ports.NewField("created", response.Created),
+		ports.NewField("updated", response.Updated),
+		ports.NewField("rejected", response.Rejected),
+	)
+	h.writeJSON(w, status, response)
+}
+
+func negotiateExportFormat(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return formatCSV, true
+	}
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if format, ok := transferMediaTypes[mediaType]; ok {
+			return format, true
+		}
+		switch mediaType {
+		case "*/*", "text/*":
+			return formatCSV, true
+		case "application/*":
+			return formatNDJSON, true
+		}
+	}
+	return "", false
+}
+
+type productExportWriter struct {
+	w       http.ResponseWriter
+	format  string
+	buf     *bufio.Writer
+	csv     *csv.Writer
+	encoder *json.Encoder
+	rows    int
+}
+
+func newProductExportWriter(w http.ResponseWriter, format string) *productExportWriter {
+	buf := bufio.NewWriter(w)
+	writer := &productExportWriter{w: w, format: format, buf: buf}
+	if format == formatCSV {
+		writer.csv = csv.NewWriter(buf)
+	} else {
+		writer.encoder = json.NewEncoder(buf)
+	}
+	return writer
+}
+
+func (e *productExportWriter) Start() {
+	contentType, extension := contentTypeNDJSON, "ndjson"
+	if e.format == formatCSV {
+		contentType, extension = contentTypeCSV+"; charset=utf-8", "csv"
+	}
+	e.w.Header().Set("Content-Type", contentType)
+	e.w.Header().Set("Content-Disposition", `attachment; filename="products.`+extension+`"`)
+	e.w.WriteHeader(http.StatusOK)
+
+	if e.csv != nil {
+		e.csv.Write(productCSVHeader)
+	}
+}
+
+func (e *productExportWriter) Write(product *domain.Product) error {
+	record := dto.ToProductExportRecord(product)
+	e.rows++
+
+	if e.encoder != nil {
+		return e.encoder.Encode(record)
+	}
+
+	prices, err := jsonCell(record.Prices)
+	if err != nil {
+		return err
+	}
+	attributes, err := jsonCell(record.Attributes)
+	if err != nil {
+		return err
+	}
+	parentID := ""
+	if record.ParentID != nil {
+		parentID = strconv.Itoa(*record.ParentID)
+	}
+
+	return e.csv.Write([]string{
+		strconv.Itoa(record.ID),
+		record.Name,
+		record.Price,
+		record.Currency,
+		prices,
+		attributes,
+		parentID,
+		record.Status,
+		strconv.Itoa(record.Version),
+		record.CreatedAt,
+	})
+}
+
+func (e *productExportWriter) Rows() int {
+	return e.rows
+}
+
+func (e *productExportWriter) Flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if err := e.buf.Flush(); err != nil {
+		return err
+	}
+	if flusher, ok := e.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+func jsonCell[T any](value map[string]T) (string, error) {
+	if len(value) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+type csvImportSource struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func newCSVImportSource(r io.Reader) (*csvImportSource, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("CSV import is empty")
+		}
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("CSV header must contain a name column")
+	}
+
+	reader.ReuseRecord = true
+	return &csvImportSource{reader: reader, columns: columns}, nil
+}
+
+func (s *csvImportSource) Next() (usecase.ProductImportRow, error) {
+	record, err := s.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+			return usecase.ProductImportRow{
+				Line: parseErr.StartLine,
+				Err:  fmt.Errorf("expected %d fields, got %d: %w", s.reader.FieldsPerRecord, len(record), domain.ErrInvalidInput),
+			}, nil
+		}
+		return usecase.ProductImportRow{}, err
+	}
+
+	line, _ := s.reader.FieldPos(0)
+	row, err := s.parseRecord(record)
+	if err != nil {
+		return usecase.ProductImportRow{Line: line, Err: err}, nil
+	}
+	return importRow(line, row), nil
+}
+
+func (s *csvImportSource) field(record []string, column string) string {
+	i, ok := s.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+func (s *csvImportSource) parseRecord(record []string) (dto.ImportProductRow, error) {
+	row := dto.ImportProductRow{
+		CreateProductRequest: dto.CreateProductRequest{
+			Name:     s.field(record, "name"),
+			Price:    dto.DecimalInput(s.field(record, "price")),
+			Currency: s.field(record, "currency"),
+		},
+	}
+
+	var err error
+	if row.ID, err = parseOptionalInt(s.field(record, "id"), "id"); err != nil {
+		return dto.ImportProductRow{}, err
+	}
+	if row.Version, err = parseOptionalInt(s.field(record, "version"), "version"); err != nil {
+		return dto.ImportProductRow{}, err
+	}
+	if prices := s.field(record, "prices"); prices != "" {
+		if err := json.Unmarshal([]byte(prices), &row.Prices); err != nil {
+			return dto.ImportProductRow{}, fmt.Errorf("prices must be a JSON object of amounts by currency: %w", domain.ErrInvalidInput)
+		}
+	}
+	if attributes := s.field(record, "attributes"); attributes != "" {
+		if err := json.Unmarshal([]byte(attributes), &row.Attributes); err != nil {
+			return dto.ImportProductRow{}, fmt.Errorf("attributes must be a JSON object: %w", domain.ErrInvalidInput)
+		}
+	}
+	return row, nil
+}
+
+func parseOptionalInt(value, name string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s %q: %w", name, value, domain.ErrInvalidInput)
+	}
+	return n, nil
+}
+
+type ndjsonImportSource struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newNDJSONImportSource(r io.Reader) *ndjsonImportSource {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRequestBodySize)
+	return &ndjsonImportSource{scanner: scanner}
+}
+
+func (s *ndjsonImportSource) Next() (usecase.ProductImportRow, error) {
+	for s.scanner.Scan() {
+		s.line++
+		data := strings.TrimSpace(s.scanner.Text())
+		if data == "" {
+			continue
+		}
+
+		var row dto.ImportProductRow
+		if err := json.Unmarshal([]byte(data), &row); err != nil {
+			return usecase.ProductImportRow{
+				Line: s.line,
+				Err:  fmt.Errorf("invalid JSON: %v: %w", err, domain.ErrInvalidInput),
+			}, nil
+		}
+		return importRow(s.line, row), nil
+	}
+
+	if err := s.scanner.Err(); err != nil {
+		return usecase.ProductImportRow{}, fmt.Errorf("line %d: %w", s.line+1, err)
+	}
+	return usecase.ProductImportRow{}, io.EOF
+}
+
+func importRow(line int, row dto.ImportProductRow) usecase.ProductImportRow {
+	if row.ID < 0 || row.Version < 0 {
+		return usecase.ProductImportRow{Line: line, Err: fmt.Errorf("id and version must not be negative: %w", domain.ErrInvalidInput)}
+	}
+
+	input, err := parseBatchItem(row.CreateProductRequest)
+	return usecase.ProductImportRow{
+		Line:    line,
+		ID:      row.ID,
+		Version: row.Version,
+		Input:   input,
+		Err:     err,
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"product_service/products/internal/handler/dto"
+	"product_service/products/internal/usecase"
+	"product_service/products/internal/usecase/ports"
+)
+
+func (h *HTTPProductHandler) CreateVariant(idStr string, w http.ResponseWriter, r *http.Request) {
+	parentID, ok := h.parseProductID(idStr, w, r)
+	if !ok {
+		return
+	}
+
+	limitedBody := io.LimitReader(r.Body, maxRequestBodySize)
+	defer r.Body.Close()
+
+	var req dto.CreateVariantRequest
+	if err := json.NewDecoder(limitedBody).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body",
+			ports.NewField("error", err),
+		)
+		h.writeError(w, r, CodeInvalidRequestBody, "")
+		return
+	}
+
+	variant := usecase.VariantInput{Name: req.Name}
+	if req.Price != "" {
+		variant.Price = &usecase.PriceInput{Amount: string(req.Price), Currency: req.Currency}
+	} else if req.Currency != "" {
+		h.writeError(w, r, CodeInvalidInput, "currency can only be set together with price")
+		return
+	}
+
+	if variant.Attributes, ok = h.parseAttributes(w, r, req.Attributes); !ok {
+		return
+	}
+
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	defer cancel()
+
+	product, err := h.useCase.CreateVariant(ctx, parentID, variant, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			h.handleContextError(ctx, w, "create_variant", err, ports.NewField("parent_id", parentID))
+			return
+		}
+		h.errorMapper.MapToHTTPError(w, err, ctx)
+		return
+	}
+
+	if h.metrics != nil {
+		h.metrics.IncrementProductsCreated()
+	}
+
+	h.logger.Info("Product variant created",
+		ports.NewField("id", product.ID),
+		ports.NewField("parent_id", parentID),
+	)
+	setETag(w, product.Version)
+	h.writeJSON(w, http.StatusCreated, dto.ToProductResponse(product, dto.PriceOptions{Format: ParsePriceFormat(r)}))
+}
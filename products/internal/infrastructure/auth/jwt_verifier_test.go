@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"product_service/products/internal/domain"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func encodeSegment(t *testing.T, value interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("Failed to encode token segment: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, header, claims map[string]interface{}, secret []byte) string {
+	t.Helper()
+	return appendHS256(encodeSegment(t, header)+"."+encodeSegment(t, claims), secret)
+}
+
+func appendHS256(signingInput string, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, header, claims map[string]interface{}, key *rsa.PrivateKey) string {
+	t.Helper()
+	signingInput := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub": "alice",
+		"iss": "https://issuer.example",
+		"aud": "products",
+		"exp": testNow.Add(time.Hour).Unix(),
+	}
+}
+
+func withClaims(changes map[string]interface{}) map[string]interface{} {
+	claims := validClaims()
+	for name, value := range changes {
+		if value == nil {
+			delete(claims, name)
+			continue
+		}
+		claims[name] = value
+	}
+	return claims
+}
+
+func writeJWKS(t *testing.T, keys ...map[string]string) string {
+	t.Helper()
+	data, err := json.Marshal(map[string]interface{}{"keys": keys})
+	if err != nil {
+		t.Fatalf("Failed to encode JWKS: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("Failed to write JWKS: %v", err)
+	}
+	return path
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"alg": algRS256,
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func newTestVerifier(t *testing.T, cfg JWTConfig) *JWTVerifier {
+	t.Helper()
+	verifier, err := NewJWTVerifier(cfg)
+	if err != nil {
+		t.Fatalf("Failed to build verifier: %v", err)
+	}
+	verifier.now = func() time.Time { return testNow }
+	return verifier
+}
+
+func TestJWTVerifier_HS256(t *testing.T) {
+	secret := []byte("test-secret")
+	verifier := newTestVerifier(t, JWTConfig{
+		HMACSecret: string(secret),
+		Issuer:     "https://issuer.example",
+		Audience:   "products",
+		Leeway:     30 * time.Second,
+	})
+	hs256 := map[string]interface{}{"alg": algHS256, "typ": "JWT"}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "valid", token: signHS256(t, hs256, validClaims(), secret)},
+		{name: "audience list", token: signHS256(t, hs256, withClaims(map[string]interface{}{"aud": []string{"billing", "products"}}), secret)},
+		{name: "wrong secret", token: signHS256(t, hs256, validClaims(), []byte("other-secret")), wantErr: domain.ErrInvalidToken},
+		{name: "alg none", token: encodeSegment(t, map[string]string{"alg": "none"}) + "." + encodeSegment(t, validClaims()) + ".", wantErr: domain.ErrInvalidToken},
+		{name: "unsupported alg", token: signHS256(t, map[string]interface{}{"alg": "HS512"}, validClaims(), secret), wantErr: domain.ErrInvalidToken},
+		{name: "expired beyond leeway", token: signHS256(t, hs256, withClaims(map[string]interface{}{"exp": testNow.Add(-time.Minute).Unix()}), secret), wantErr: domain.ErrTokenExpired},
+		{name: "expired within leeway", token: signHS256(t, hs256, withClaims(map[string]interface{}{"exp": testNow.Add(-10 * time.Second).Unix()}), secret)},
+		{name: "missing exp", token: signHS256(t, hs256, withClaims(map[string]interface{}{"exp": nil}), secret), wantErr: domain.ErrInvalidToken},
+		{name: "non-numeric exp", token: signHS256(t, hs256, withClaims(map[string]interface{}{"exp": "tomorrow"}), secret), wantErr: domain.ErrInvalidToken},
+		{name: "nbf within leeway", token: signHS256(t, hs256, withClaims(map[string]interface{}{"nbf": testNow.Add(10 * time.Second).Unix()}), secret)},
+		{name: "nbf beyond leeway", token: signHS256(t, hs256, withClaims(map[string]interface{}{"nbf": testNow.Add(time.Minute).Unix()}), secret), wantErr: domain.ErrInvalidToken},
+		{name: "wrong issuer", token: signHS256(t, hs256, withClaims(map[string]interface{}{"iss": "https://evil.example"}), secret), wantErr: domain.ErrInvalidToken},
+		{name: "missing issuer", token: signHS256(t, hs256, withClaims(map[string]interface{}{"iss": nil}), secret), wantErr: domain.ErrInvalidToken},
+		{name: "wrong audience", token: signHS256(t, hs256, withClaims(map[string]interface{}{"aud": "billing"}), secret), wantErr: domain.ErrInvalidToken},
+		{name: "missing subject", token: signHS256(t, hs256, withClaims(map[string]interface{}{"sub": nil}), secret), wantErr: domain.ErrInvalidToken},
+		{name: "two segments", token: "a.b", wantErr: domain.ErrInvalidToken},
+		{name: "four segments", token: signHS256(t, hs256, validClaims(), secret) + ".x", wantErr: domain.ErrInvalidToken},
+		{name: "header not base64", token: "%%%." + encodeSegment(t, validClaims()) + ".c2ln", wantErr: domain.ErrInvalidToken},
+		{name: "header not json", token: base64.RawURLEncoding.EncodeToString([]byte("alg")) + "." + encodeSegment(t, validClaims()) + ".c2ln", wantErr: domain.ErrInvalidToken},
+		{name: "signature not base64", token: encodeSegment(t, hs256) + "." + encodeSegment(t, validClaims()) + ".%%%", wantErr: domain.ErrInvalidToken},
+		{name: "claims not json", token: appendHS256(encodeSegment(t, hs256)+"."+base64.RawURLEncoding.EncodeToString([]byte("not json")), secret), wantErr: domain.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := verifier.Verify(tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Expected %v, got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if principal.Subject != "alice" {
+				t.Errorf("Expected subject alice, got %q", principal.Subject)
+			}
+		})
+	}
+}
+
+func TestJWTVerifier_RS256KeyLookup(t *testing.T) {
+	first, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	second, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+
+	verifier := newTestVerifier(t, JWTConfig{
+		JWKSFile: writeJWKS(t, rsaJWK("k1", &first.PublicKey), rsaJWK("k2", &second.PublicKey)),
+	})
+	claims := map[string]interface{}{"sub": "alice", "exp": testNow.Add(time.Hour).Unix()}
+	rs256 := func(kid string) map[string]interface{} {
+		header := map[string]interface{}{"alg": algRS256}
+		if kid != "" {
+			header["kid"] = kid
+		}
+		return header
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "first kid", token: signRS256(t, rs256("k1"), claims, first)},
+		{name: "second kid", token: signRS256(t, rs256("k2"), claims, second)},
+		{name: "kid of another key", token: signRS256(t, rs256("k2"), claims, first), wantErr: true},
+		{name: "unknown kid", token: signRS256(t, rs256("k3"), claims, first), wantErr: true},
+		{name: "no kid with several keys", token: signRS256(t, rs256(""), claims, first), wantErr: true},
+		{name: "hs256 signed with the public modulus", token: signHS256(t, map[string]interface{}{"alg": algHS256, "kid": "k1"}, claims, first.PublicKey.N.Bytes()), wantErr: true},
+		{name: "hs256 without kid", token: signHS256(t, map[string]interface{}{"alg": algHS256}, claims, []byte("guess")), wantErr: true},
+		{name: "alg none with kid", token: encodeSegment(t, map[string]string{"alg": "none", "kid": "k1"}) + "." + encodeSegment(t, claims) + ".", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := verifier.Verify(tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrInvalidToken) {
+					t.Fatalf("Expected ErrInvalidToken, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestJWTVerifier_JWKSSymmetricKeys(t *testing.T) {
+	verifier := newTestVerifier(t, JWTConfig{
+		JWKSFile: writeJWKS(t,
+			map[string]string{"kty": "oct", "kid": "current", "k": base64.RawURLEncoding.EncodeToString([]byte("current-secret"))},
+			map[string]string{"kty": "oct", "kid": "encryption", "use": "enc", "k": base64.RawURLEncoding.EncodeToString([]byte("enc-secret"))},
+		),
+	})
+	claims := map[string]interface{}{"sub": "alice", "exp": testNow.Add(time.Hour).Unix()}
+
+	if _, err := verifier.Verify(signHS256(t, map[string]interface{}{"alg": algHS256, "kid": "current"}, claims, []byte("current-secret"))); err != nil {
+		t.Errorf("Expected the current key to verify, got: %v", err)
+	}
+	if _, err := verifier.Verify(signHS256(t, map[string]interface{}{"alg": algHS256}, claims, []byte("current-secret"))); err != nil {
+		t.Errorf("Expected the only signing key to be used without a kid, got: %v", err)
+	}
+	token := signHS256(t, map[string]interface{}{"alg": algHS256, "kid": "encryption"}, claims, []byte("enc-secret"))
+	if _, err := verifier.Verify(token); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Errorf("Expected encryption keys to be ignored, got: %v", err)
+	}
+}
+
+func TestNewJWTVerifier_RequiresKeys(t *testing.T) {
+	if _, err := NewJWTVerifier(JWTConfig{Issuer: "https://issuer.example"}); err == nil || !strings.Contains(err.Error(), "no JWT verification keys") {
+		t.Errorf("Expected a missing keys error, got: %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+)
+
+var _ ports.Authorizer = (*PolicyAuthorizer)(nil)
+
+type PolicyConfig struct {
+	Enabled       bool
+	Roles         map[string][]string
+	DefaultRole   string
+	AnonymousRole string
+}
+
+type PolicyAuthorizer struct {
+	policy        *domain.Policy
+	enabled       bool
+	defaultRole   string
+	anonymousRole string
+	logger        ports.Logger
+	metrics       ports.MetricsCollector
+}
+
+func NewPolicyAuthorizer(cfg PolicyConfig, logger ports.Logger, metrics ports.MetricsCollector) (*PolicyAuthorizer, error) {
+	policy, err := domain.NewPolicy(cfg.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.DefaultRole != "" && !policy.HasRole(cfg.DefaultRole) {
+		return nil, fmt.Errorf("default role %q is not defined: %w", cfg.DefaultRole, domain.ErrInvalidPolicy)
+	}
+
+	return &PolicyAuthorizer{
+		policy:        policy,
+		enabled:       cfg.Enabled,
+		defaultRole:   cfg.DefaultRole,
+		anonymousRole: cfg.AnonymousRole,
+		logger:        logger,
+		metrics:       metrics,
+	}, nil
+}
+
+func (a *PolicyAuthorizer) Authorize(ctx context.Context, permission domain.Permission) error {
+	if key, ok := ports.APIKeyFromContext(ctx); ok {
+		if key.Grants(permission) {
+			return nil
+		}
+		return a.deny(ctx, permission, "api_key:"+key.Prefix, nil,
+			fmt.Errorf("api key %s is not allowed to %s: %w", key.Prefix, permission, domain.ErrInsufficientScope))
+	}
+
+	if !a.enabled {
+		return nil
+	}
+
+	principal, authenticated := ports.PrincipalFromContext(ctx)
+	if !authenticated {
+		roles := []string{a.anonymousRole}
+		if a.policy.Allows(roles, permission) {
+			return nil
+		}
+		return a.deny(ctx, permission, ports.ActorFromContext(ctx), roles,
+			fmt.Errorf("%s requires an authenticated caller: %w", permission, domain.ErrUnauthenticated))
+	}
+
+	roles := principal.Roles
+	if len(roles) == 0 && a.defaultRole != "" {
+		roles = []string{a.defaultRole}
+	}
+	if a.policy.Allows(roles, permission) {
+		return nil
+	}
+	return a.deny(ctx, permission, ports.ActorFromContext(ctx), roles,
+		fmt.Errorf("%s is not allowed to %s: %w", principal.Subject, permission, domain.ErrForbidden))
+}
+
+func (a *PolicyAuthorizer) deny(ctx context.Context, permission domain.Permission, actor string, roles []string, err error) error {
+	a.logger.Warn("Authorization denied",
+		ports.NewField("permission", permission.String()),
+		ports.NewField("actor", actor),
+		ports.NewField("roles", roles),
+		ports.NewField("tenant_id", ports.TenantFromContext(ctx)),
+		ports.NewField("request_id", ports.RequestIDFromContext(ctx)),
+	)
+	if a.metrics != nil {
+		a.metrics.IncrementAuthorizationDenied(permission.String())
+	}
+	return err
+}
@@ -7,25 +7,73 @@ import (
 )
 
 type InfrastructureEvent struct {
-	Type      string    `json:"type"`
-	ProductID int       `json:"product_id"`
-	Timestamp time.Time `json:"timestamp"`
+	Type       string                        `json:"type"`
+	TenantID   string                        `json:"tenant_id,omitempty"`
+	ProductID  int                           `json:"product_id,omitempty"`
+	ParentID   *int                          `json:"parent_id,omitempty"`
+	Status     domain.ProductStatus          `json:"status,omitempty"`
+	FromStatus domain.ProductStatus          `json:"from_status,omitempty"`
+	ToStatus   domain.ProductStatus          `json:"to_status,omitempty"`
+	Changes    map[string]domain.FieldChange `json:"changes,omitempty"`
+	Prices     map[string]string             `json:"prices,omitempty"`
+	Attributes map[string]interface{}        `json:"attributes,omitempty"`
+	Stock      *domain.StockSnapshot         `json:"stock,omitempty"`
+	Category   *domain.CategorySnapshot      `json:"category,omitempty"`
+	Timestamp  time.Time                     `json:"timestamp"`
 }
 
 func ToInfrastructureEvent(event domain.DomainEvent) InfrastructureEvent {
 	switch e := event.(type) {
 	case domain.ProductCreatedEvent:
-		return InfrastructureEvent{
+		infraEvent := InfrastructureEvent{
+			Type:      e.EventType(),
+			ProductID: e.ProductID,
+			Timestamp: e.OccurredAt(),
+		}
+		if e.Product != nil {
+			infraEvent.setDetails(e.Product.EventDetails())
+		}
+		return infraEvent
+	case domain.ProductUpdatedEvent:
+		infraEvent := InfrastructureEvent{
 			Type:      e.EventType(),
 			ProductID: e.ProductID,
+			Changes:   e.Changes,
 			Timestamp: e.OccurredAt(),
 		}
+		infraEvent.setDetails(e.Details)
+		return infraEvent
 	case domain.ProductDeletedEvent:
 		return InfrastructureEvent{
 			Type:      e.EventType(),
 			ProductID: e.ProductID,
 			Timestamp: e.OccurredAt(),
 		}
+	case domain.ProductRestoredEvent:
+		return InfrastructureEvent{
+			Type:      e.EventType(),
+			ProductID: e.ProductID,
+			Timestamp: e.OccurredAt(),
+		}
+	case domain.ProductStatusEvent:
+		transition := e.Transition()
+		return NewProductStatusInfrastructureEvent(e.EventType(), transition.ProductID, transition.From, transition.To, e.OccurredAt())
+	case domain.StockChangedEvent:
+		return newStockInfrastructureEvent(e.EventType(), e.ProductID, e.Stock, e.OccurredAt())
+	case domain.StockReservedEvent:
+		return newStockInfrastructureEvent(e.EventType(), e.ProductID, e.Stock, e.OccurredAt())
+	case domain.OutOfStockEvent:
+		return newStockInfrastructureEvent(e.EventType(), e.ProductID, e.Stock, e.OccurredAt())
+	case domain.CategoryCreatedEvent:
+		return NewCategoryInfrastructureEvent(e.EventType(), e.Category, e.OccurredAt())
+	case domain.CategoryUpdatedEvent:
+		infraEvent := NewCategoryInfrastructureEvent(e.EventType(), e.Category, e.OccurredAt())
+		infraEvent.Changes = e.Changes
+		return infraEvent
+	case domain.CategoryMovedEvent:
+		return NewCategoryInfrastructureEvent(e.EventType(), e.Category, e.OccurredAt())
+	case domain.CategoryDeletedEvent:
+		return NewCategoryInfrastructureEvent(e.EventType(), e.Category, e.OccurredAt())
 	default:
 		return InfrastructureEvent{
 			Type:      event.EventType(),
@@ -34,12 +82,77 @@ func ToInfrastructureEvent(event domain.DomainEvent) InfrastructureEvent {
 	}
 }
 
+func newStockInfrastructureEvent(eventType string, productID int, stock domain.StockSnapshot, timestamp time.Time) InfrastructureEvent {
+	return InfrastructureEvent{
+		Type:      eventType,
+		ProductID: productID,
+		Stock:     &stock,
+		Timestamp: timestamp,
+	}
+}
+
+func NewProductStatusInfrastructureEvent(eventType string, productID int, from, to domain.ProductStatus, timestamp time.Time) InfrastructureEvent {
+	return InfrastructureEvent{
+		Type:       eventType,
+		ProductID:  productID,
+		Status:     to,
+		FromStatus: from,
+		ToStatus:   to,
+		Timestamp:  timestamp,
+	}
+}
+
+func NewCategoryInfrastructureEvent(eventType string, category domain.CategorySnapshot, timestamp time.Time) InfrastructureEvent {
+	return InfrastructureEvent{
+		Type:      eventType,
+		Category:  &category,
+		Timestamp: timestamp,
+	}
+}
+
+func NewProductInfrastructureEvent(eventType string, productID int, details domain.ProductEventDetails, timestamp time.Time) InfrastructureEvent {
+	event := InfrastructureEvent{
+		Type:      eventType,
+		ProductID: productID,
+		Timestamp: timestamp,
+	}
+	event.setDetails(details)
+	return event
+}
+
+func (e *InfrastructureEvent) setDetails(details domain.ProductEventDetails) {
+	e.Status = details.Status
+	e.Prices = details.Prices
+	e.Attributes = details.Attributes
+	e.ParentID = details.ParentID
+}
+
+func (e InfrastructureEvent) Details() domain.ProductEventDetails {
+	return domain.ProductEventDetails{
+		Status:     e.Status,
+		Prices:     e.Prices,
+		Attributes: e.Attributes,
+		ParentID:   e.ParentID,
+	}
+}
+
 func (e InfrastructureEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
 }
 
 const (
-	EventTypeProductCreated = "PRODUCT_CREATED"
-	EventTypeProductDeleted = "PRODUCT_DELETED"
+	EventTypeProductCreated      = "PRODUCT_CREATED"
+	EventTypeProductUpdated      = "PRODUCT_UPDATED"
+	EventTypeProductDeleted      = "PRODUCT_DELETED"
+	EventTypeProductRestored     = "PRODUCT_RESTORED"
+	EventTypeProductActivated    = "PRODUCT_ACTIVATED"
+	EventTypeProductDiscontinued = "PRODUCT_DISCONTINUED"
+	EventTypeProductArchived     = "PRODUCT_ARCHIVED"
+	EventTypeStockChanged        = "STOCK_CHANGED"
+	EventTypeStockReserved       = "STOCK_RESERVED"
+	EventTypeOutOfStock          = "OUT_OF_STOCK"
+	EventTypeCategoryCreated     = "CATEGORY_CREATED"
+	EventTypeCategoryUpdated     = "CATEGORY_UPDATED"
+	EventTypeCategoryMoved       = "CATEGORY_MOVED"
+	EventTypeCategoryDeleted     = "CATEGORY_DELETED"
 )
-
@@ -0,0 +1,109 @@
+package inventory
+
+import (
+	"context"
+	"product_service/products/internal/usecase/ports"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type ReservationSweeper struct {
+	appService ports.InventoryApplicationService
+	logger     *zap.Logger
+	metrics    ports.MetricsCollector
+	interval   time.Duration
+	batchSize  int
+	stopChan   chan struct{}
+	doneChan   chan struct{}
+}
+
+func NewReservationSweeper(
+	appService ports.InventoryApplicationService,
+	logger *zap.Logger,
+	interval time.Duration,
+	batchSize int,
+	metrics ports.MetricsCollector,
+) *ReservationSweeper {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &ReservationSweeper{
+		appService: appService,
+		logger:     logger,
+		metrics:    metrics,
+		interval:   interval,
+		batchSize:  batchSize,
+		stopChan:   make(chan struct{}),
+		doneChan:   make(chan struct{}),
+	}
+}
+
+func (s *ReservationSweeper) Start(ctx context.Context) {
+	go s.run(ctx)
+}
+
+func (s *ReservationSweeper) Stop() {
+	close(s.stopChan)
+	<-s.doneChan
+}
+
+func (s *ReservationSweeper) run(ctx context.Context) {
+	defer close(s.doneChan)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Reservation sweeper stopped: context cancelled")
+			return
+		case <-s.stopChan:
+			s.logger.Info("Reservation sweeper stopped: stop signal received")
+			return
+		case <-ticker.C:
+			s.releaseExpired(ctx)
+		}
+	}
+}
+
+func (s *ReservationSweeper) releaseExpired(ctx context.Context) {
+	now := time.Now().UTC()
+	total := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopChan:
+			return
+		default:
+		}
+
+		released, err := s.appService.ReleaseExpiredReservations(ctx, now, s.batchSize)
+		if err != nil {
+			s.logger.Error("Failed to release expired reservations",
+				zap.Time("now", now),
+				zap.Error(err),
+			)
+			break
+		}
+
+		total += released
+		if s.metrics != nil && released > 0 {
+			s.metrics.RecordBatchSize("expire_reservations", released)
+			s.metrics.IncrementReservationsExpired(released)
+		}
+
+		if released < s.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		s.logger.Info("Released expired reservations",
+			zap.Int("count", total),
+		)
+	}
+}
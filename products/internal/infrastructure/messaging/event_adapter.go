@@ -10,7 +10,7 @@ import (
 )
 
 type EventAdapter interface {
-	AdaptEvent(outboxEvent ports.OutboxEvent) (eventType string, productID int, timestamp time.Time, err error)
+	AdaptEvent(outboxEvent ports.OutboxEvent) (events.InfrastructureEvent, error)
 }
 
 type domainEventAdapter struct{}
@@ -19,26 +19,62 @@ func NewDomainEventAdapter() EventAdapter {
 	return &domainEventAdapter{}
 }
 
-func (a *domainEventAdapter) AdaptEvent(outboxEvent ports.OutboxEvent) (string, int, time.Time, error) {
+func (a *domainEventAdapter) AdaptEvent(outboxEvent ports.OutboxEvent) (events.InfrastructureEvent, error) {
 	var eventData struct {
-		Type      string    `json:"type"`
-		ProductID int       `json:"product_id"`
-		Timestamp time.Time `json:"timestamp"`
+		Type       string                        `json:"type"`
+		ProductID  int                           `json:"product_id"`
+		ParentID   *int                          `json:"parent_id"`
+		Status     domain.ProductStatus          `json:"status"`
+		FromStatus domain.ProductStatus          `json:"from_status"`
+		ToStatus   domain.ProductStatus          `json:"to_status"`
+		Changes    map[string]domain.FieldChange `json:"changes"`
+		Prices     map[string]string             `json:"prices"`
+		Attributes map[string]interface{}        `json:"attributes"`
+		Stock      *domain.StockSnapshot         `json:"stock"`
+		Category   *domain.CategorySnapshot      `json:"category"`
+		Timestamp  time.Time                     `json:"timestamp"`
 	}
 
 	if err := json.Unmarshal(outboxEvent.EventData, &eventData); err != nil {
-		return "", 0, time.Time{}, fmt.Errorf("failed to unmarshal domain event: %w", err)
+		return events.InfrastructureEvent{}, fmt.Errorf("failed to unmarshal domain event: %w", err)
 	}
 
 	if eventData.Type == "" {
-		return "", 0, time.Time{}, fmt.Errorf("missing event type in domain event")
+		return events.InfrastructureEvent{}, fmt.Errorf("missing event type in domain event")
 	}
 
-	createdEventType := domain.ProductCreatedEvent{}.EventType()
-	deletedEventType := domain.ProductDeletedEvent{}.EventType()
-	if eventData.Type == createdEventType || eventData.Type == deletedEventType {
+	switch eventData.Type {
+	case domain.ProductCreatedEvent{}.EventType(),
+		domain.ProductUpdatedEvent{}.EventType(),
+		domain.ProductDeletedEvent{}.EventType(),
+		domain.ProductRestoredEvent{}.EventType():
 		if eventData.ProductID == 0 {
-			return "", 0, time.Time{}, fmt.Errorf("missing product_id in product event")
+			return events.InfrastructureEvent{}, fmt.Errorf("missing product_id in product event")
+		}
+	case domain.ProductActivatedEvent{}.EventType(),
+		domain.ProductDiscontinuedEvent{}.EventType(),
+		domain.ProductArchivedEvent{}.EventType():
+		if eventData.ProductID == 0 {
+			return events.InfrastructureEvent{}, fmt.Errorf("missing product_id in product status event")
+		}
+		if eventData.ToStatus == "" {
+			return events.InfrastructureEvent{}, fmt.Errorf("missing to_status in product status event")
+		}
+	case domain.StockChangedEvent{}.EventType(),
+		domain.StockReservedEvent{}.EventType(),
+		domain.OutOfStockEvent{}.EventType():
+		if eventData.ProductID == 0 {
+			return events.InfrastructureEvent{}, fmt.Errorf("missing product_id in stock event")
+		}
+		if eventData.Stock == nil {
+			return events.InfrastructureEvent{}, fmt.Errorf("missing stock in stock event")
+		}
+	case domain.CategoryCreatedEvent{}.EventType(),
+		domain.CategoryUpdatedEvent{}.EventType(),
+		domain.CategoryMovedEvent{}.EventType(),
+		domain.CategoryDeletedEvent{}.EventType():
+		if eventData.Category == nil || eventData.Category.ID == 0 {
+			return events.InfrastructureEvent{}, fmt.Errorf("missing category in category event")
 		}
 	}
 
@@ -47,7 +83,20 @@ func (a *domainEventAdapter) AdaptEvent(outboxEvent ports.OutboxEvent) (string,
 		timestamp = time.Now()
 	}
 
-	return eventData.Type, eventData.ProductID, timestamp, nil
+	return events.InfrastructureEvent{
+		Type:       eventData.Type,
+		ProductID:  eventData.ProductID,
+		ParentID:   eventData.ParentID,
+		Status:     eventData.Status,
+		FromStatus: eventData.FromStatus,
+		ToStatus:   eventData.ToStatus,
+		Changes:    eventData.Changes,
+		Prices:     eventData.Prices,
+		Attributes: eventData.Attributes,
+		Stock:      eventData.Stock,
+		Category:   eventData.Category,
+		Timestamp:  timestamp,
+	}, nil
 }
 
 type infrastructureEventAdapter struct{}
@@ -56,18 +105,17 @@ func NewInfrastructureEventAdapter() EventAdapter {
 	return &infrastructureEventAdapter{}
 }
 
-func (a *infrastructureEventAdapter) AdaptEvent(outboxEvent ports.OutboxEvent) (string, int, time.Time, error) {
+func (a *infrastructureEventAdapter) AdaptEvent(outboxEvent ports.OutboxEvent) (events.InfrastructureEvent, error) {
 	var infraEvent events.InfrastructureEvent
 	if err := json.Unmarshal(outboxEvent.EventData, &infraEvent); err != nil {
-		return "", 0, time.Time{}, fmt.Errorf("failed to unmarshal infrastructure event: %w", err)
+		return events.InfrastructureEvent{}, fmt.Errorf("failed to unmarshal infrastructure event: %w", err)
 	}
 
-	timestamp := infraEvent.Timestamp
-	if timestamp.IsZero() {
-		timestamp = time.Now()
+	if infraEvent.Timestamp.IsZero() {
+		infraEvent.Timestamp = time.Now()
 	}
 
-	return infraEvent.Type, infraEvent.ProductID, timestamp, nil
+	return infraEvent, nil
 }
 
 type smartEventAdapter struct {
@@ -82,16 +130,14 @@ func NewSmartEventAdapter() EventAdapter {
 	}
 }
 
-func (a *smartEventAdapter) AdaptEvent(outboxEvent ports.OutboxEvent) (string, int, time.Time, error) {
+func (a *smartEventAdapter) AdaptEvent(outboxEvent ports.OutboxEvent) (events.InfrastructureEvent, error) {
 	var infraEvent events.InfrastructureEvent
 	if err := json.Unmarshal(outboxEvent.EventData, &infraEvent); err == nil && infraEvent.Type != "" {
-		timestamp := infraEvent.Timestamp
-		if timestamp.IsZero() {
-			timestamp = time.Now()
+		if infraEvent.Timestamp.IsZero() {
+			infraEvent.Timestamp = time.Now()
 		}
-		return infraEvent.Type, infraEvent.ProductID, timestamp, nil
+		return infraEvent, nil
 	}
 
 	return a.domainAdapter.AdaptEvent(outboxEvent)
 }
-
@@ -310,22 +310,62 @@ func (w *OutboxWorker) publishEventWithRetry(ctx context.Context, event ports.Ou
 }
 
 func (w *OutboxWorker) publishEvent(ctx context.Context, event ports.OutboxEvent) error {
-	eventType, productID, timestamp, err := w.eventAdapter.AdaptEvent(event)
+	adapted, err := w.eventAdapter.AdaptEvent(event)
 	if err != nil {
 		return fmt.Errorf("failed to adapt event: %w", err)
 	}
 
-	if !timestamp.IsZero() {
-		ctx = WithTimestamp(ctx, timestamp)
+	if !adapted.Timestamp.IsZero() {
+		ctx = WithTimestamp(ctx, adapted.Timestamp)
 	}
+	ctx = ports.WithTenant(ctx, event.TenantID)
 
-	switch eventType {
+	switch adapted.Type {
 	case events.EventTypeProductCreated:
-		return w.publisher.PublishProductCreated(ctx, productID)
+		return w.publisher.PublishProductCreated(ctx, adapted.ProductID, adapted.Details())
+	case events.EventTypeProductUpdated:
+		return w.publisher.PublishProductUpdated(ctx, adapted.ProductID, adapted.Changes, adapted.Details())
 	case events.EventTypeProductDeleted:
-		return w.publisher.PublishProductDeleted(ctx, productID)
+		return w.publisher.PublishProductDeleted(ctx, adapted.ProductID)
+	case events.EventTypeProductRestored:
+		return w.publisher.PublishProductRestored(ctx, adapted.ProductID)
+	case events.EventTypeProductActivated, events.EventTypeProductDiscontinued, events.EventTypeProductArchived:
+		return w.publisher.PublishProductStatusChanged(ctx, adapted.Type, adapted.ProductID, adapted.FromStatus, adapted.ToStatus)
+	case events.EventTypeStockChanged, events.EventTypeStockReserved, events.EventTypeOutOfStock:
+		if adapted.Stock == nil {
+			return fmt.Errorf("missing stock in %s event", adapted.Type)
+		}
+		return w.publishStockEvent(ctx, adapted)
+	case events.EventTypeCategoryCreated, events.EventTypeCategoryUpdated, events.EventTypeCategoryMoved, events.EventTypeCategoryDeleted:
+		if adapted.Category == nil {
+			return fmt.Errorf("missing category in %s event", adapted.Type)
+		}
+		return w.publishCategoryEvent(ctx, adapted)
 	default:
-		return fmt.Errorf("unknown event type: %s", eventType)
+		return fmt.Errorf("unknown event type: %s", adapted.Type)
 	}
 }
 
+func (w *OutboxWorker) publishStockEvent(ctx context.Context, event events.InfrastructureEvent) error {
+	switch event.Type {
+	case events.EventTypeStockReserved:
+		return w.publisher.PublishStockReserved(ctx, event.ProductID, *event.Stock)
+	case events.EventTypeOutOfStock:
+		return w.publisher.PublishOutOfStock(ctx, event.ProductID, *event.Stock)
+	default:
+		return w.publisher.PublishStockChanged(ctx, event.ProductID, *event.Stock)
+	}
+}
+
+func (w *OutboxWorker) publishCategoryEvent(ctx context.Context, event events.InfrastructureEvent) error {
+	switch event.Type {
+	case events.EventTypeCategoryCreated:
+		return w.publisher.PublishCategoryCreated(ctx, *event.Category)
+	case events.EventTypeCategoryUpdated:
+		return w.publisher.PublishCategoryUpdated(ctx, *event.Category, event.Changes)
+	case events.EventTypeCategoryMoved:
+		return w.publisher.PublishCategoryMoved(ctx, *event.Category)
+	default:
+		return w.publisher.PublishCategoryDeleted(ctx, *event.Category)
+	}
+}
@@ -3,6 +3,7 @@ package messaging
 import (
 	"context"
 	"fmt"
+	"product_service/products/internal/domain"
 	"product_service/products/internal/infrastructure/events"
 	"product_service/products/internal/infrastructure/retry"
 	"product_service/products/internal/usecase/ports"
@@ -116,35 +117,122 @@ func NewRabbitMQPublisher(ctx context.Context, connStr, exchange string, logger
 	}, nil
 }
 
-func (p *rabbitMQPublisher) PublishProductCreated(ctx context.Context, productID int) error {
+func (p *rabbitMQPublisher) PublishProductCreated(ctx context.Context, productID int, details domain.ProductEventDetails) error {
+	timestamp, ok := GetTimestamp(ctx)
+	if !ok {
+		timestamp = time.Now()
+	}
+	
+	event := events.NewProductInfrastructureEvent(events.EventTypeProductCreated, productID, details, timestamp)
+	return p.publishInfrastructureEvent(ctx, event)
+}
+
+func (p *rabbitMQPublisher) PublishProductUpdated(ctx context.Context, productID int, changes map[string]domain.FieldChange, details domain.ProductEventDetails) error {
+	timestamp, ok := GetTimestamp(ctx)
+	if !ok {
+		timestamp = time.Now()
+	}
+	
+	event := events.NewProductInfrastructureEvent(events.EventTypeProductUpdated, productID, details, timestamp)
+	event.Changes = changes
+	return p.publishInfrastructureEvent(ctx, event)
+}
+
+func (p *rabbitMQPublisher) PublishProductDeleted(ctx context.Context, productID int) error {
 	timestamp, ok := GetTimestamp(ctx)
 	if !ok {
 		timestamp = time.Now()
 	}
 	
 	event := events.InfrastructureEvent{
-		Type:      events.EventTypeProductCreated,
+		Type:      events.EventTypeProductDeleted,
 		ProductID: productID,
 		Timestamp: timestamp,
 	}
 	return p.publishInfrastructureEvent(ctx, event)
 }
 
-func (p *rabbitMQPublisher) PublishProductDeleted(ctx context.Context, productID int) error {
+func (p *rabbitMQPublisher) PublishProductRestored(ctx context.Context, productID int) error {
 	timestamp, ok := GetTimestamp(ctx)
 	if !ok {
 		timestamp = time.Now()
 	}
 	
 	event := events.InfrastructureEvent{
-		Type:      events.EventTypeProductDeleted,
+		Type:      events.EventTypeProductRestored,
 		ProductID: productID,
 		Timestamp: timestamp,
 	}
 	return p.publishInfrastructureEvent(ctx, event)
 }
 
+func (p *rabbitMQPublisher) PublishProductStatusChanged(ctx context.Context, eventType string, productID int, from, to domain.ProductStatus) error {
+	timestamp, ok := GetTimestamp(ctx)
+	if !ok {
+		timestamp = time.Now()
+	}
+
+	event := events.NewProductStatusInfrastructureEvent(eventType, productID, from, to, timestamp)
+	return p.publishInfrastructureEvent(ctx, event)
+}
+
+func (p *rabbitMQPublisher) PublishStockChanged(ctx context.Context, productID int, stock domain.StockSnapshot) error {
+	return p.publishStockEvent(ctx, events.EventTypeStockChanged, productID, stock)
+}
+
+func (p *rabbitMQPublisher) PublishStockReserved(ctx context.Context, productID int, stock domain.StockSnapshot) error {
+	return p.publishStockEvent(ctx, events.EventTypeStockReserved, productID, stock)
+}
+
+func (p *rabbitMQPublisher) PublishOutOfStock(ctx context.Context, productID int, stock domain.StockSnapshot) error {
+	return p.publishStockEvent(ctx, events.EventTypeOutOfStock, productID, stock)
+}
+
+func (p *rabbitMQPublisher) publishStockEvent(ctx context.Context, eventType string, productID int, stock domain.StockSnapshot) error {
+	timestamp, ok := GetTimestamp(ctx)
+	if !ok {
+		timestamp = time.Now()
+	}
+	
+	event := events.InfrastructureEvent{
+		Type:      eventType,
+		ProductID: productID,
+		Stock:     &stock,
+		Timestamp: timestamp,
+	}
+	return p.publishInfrastructureEvent(ctx, event)
+}
+
+func (p *rabbitMQPublisher) PublishCategoryCreated(ctx context.Context, category domain.CategorySnapshot) error {
+	return p.publishCategoryEvent(ctx, events.EventTypeCategoryCreated, category, nil)
+}
+
+func (p *rabbitMQPublisher) PublishCategoryUpdated(ctx context.Context, category domain.CategorySnapshot, changes map[string]domain.FieldChange) error {
+	return p.publishCategoryEvent(ctx, events.EventTypeCategoryUpdated, category, changes)
+}
+
+func (p *rabbitMQPublisher) PublishCategoryMoved(ctx context.Context, category domain.CategorySnapshot) error {
+	return p.publishCategoryEvent(ctx, events.EventTypeCategoryMoved, category, nil)
+}
+
+func (p *rabbitMQPublisher) PublishCategoryDeleted(ctx context.Context, category domain.CategorySnapshot) error {
+	return p.publishCategoryEvent(ctx, events.EventTypeCategoryDeleted, category, nil)
+}
+
+func (p *rabbitMQPublisher) publishCategoryEvent(ctx context.Context, eventType string, category domain.CategorySnapshot, changes map[string]domain.FieldChange) error {
+	timestamp, ok := GetTimestamp(ctx)
+	if !ok {
+		timestamp = time.Now()
+	}
+	
+	event := events.NewCategoryInfrastructureEvent(eventType, category, timestamp)
+	event.Changes = changes
+	return p.publishInfrastructureEvent(ctx, event)
+}
+
 func (p *rabbitMQPublisher) publishInfrastructureEvent(ctx context.Context, event events.InfrastructureEvent) error {
+	event.TenantID = ports.TenantFromContext(ctx)
+
 	body, err := event.ToJSON()
 	if err != nil {
 		return err
@@ -157,6 +245,7 @@ func (p *rabbitMQPublisher) publishInfrastructureEvent(ctx context.Context, even
 		false,
 		amqp.Publishing{
 			ContentType: "application/json",
+			Headers:     amqp.Table{"tenant_id": event.TenantID},
 			Body:        body,
 			Timestamp:   event.Timestamp,
 		},
@@ -168,6 +257,7 @@ func (p *rabbitMQPublisher) publishInfrastructureEvent(ctx context.Context, even
 
 	p.logger.Info("Event published successfully", 
 		zap.String("type", event.Type), 
+		zap.String("tenant_id", event.TenantID),
 		zap.Int("product_id", event.ProductID))
 	return nil
 }
@@ -12,7 +12,11 @@ var _ ports.MetricsCollector = (*prometheusMetrics)(nil)
 
 type prometheusMetrics struct {
 	productsCreatedTotal      prometheus.Counter
+	productsUpdatedTotal      prometheus.Counter
 	productsDeletedTotal      prometheus.Counter
+	productsRestoredTotal     prometheus.Counter
+	productsPurgedTotal       prometheus.Counter
+	reservationsExpiredTotal  prometheus.Counter
 	requestDuration           *prometheus.HistogramVec
 	requestCount              *prometheus.CounterVec
 	databaseQueryDuration     prometheus.Histogram
@@ -23,6 +27,9 @@ type prometheusMetrics struct {
 	batchSize                  *prometheus.HistogramVec
 	outboxRetryAttempts        *prometheus.HistogramVec
 	outboxEventsProcessed       *prometheus.CounterVec
+	authorizationDenied         *prometheus.CounterVec
+	grpcRequestDuration         *prometheus.HistogramVec
+	grpcRequestCount            *prometheus.CounterVec
 }
 
 func NewPrometheusMetrics() ports.MetricsCollector {
@@ -31,10 +38,26 @@ func NewPrometheusMetrics() ports.MetricsCollector {
 			Name: "products_created_total",
 			Help: "Total number of products created",
 		}),
+		productsUpdatedTotal: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "products_updated_total",
+			Help: "Total number of products updated",
+		}),
 		productsDeletedTotal: promauto.NewCounter(prometheus.CounterOpts{
 			Name: "products_deleted_total",
 			Help: "Total number of products deleted",
 		}),
+		productsRestoredTotal: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "products_restored_total",
+			Help: "Total number of soft-deleted products restored",
+		}),
+		productsPurgedTotal: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "products_purged_total",
+			Help: "Total number of soft-deleted products purged after retention",
+		}),
+		reservationsExpiredTotal: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "stock_reservations_expired_total",
+			Help: "Total number of stock reservations released after expiry",
+		}),
 		requestDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
 			Name:    "http_request_duration_seconds",
 			Help:    "HTTP request duration in seconds",
@@ -80,6 +103,19 @@ func NewPrometheusMetrics() ports.MetricsCollector {
 			Name: "outbox_events_processed_total",
 			Help: "Total number of outbox events processed",
 		}, []string{"event_type", "status"}),
+		authorizationDenied: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "authorization_denied_total",
+			Help: "Total number of requests denied by the authorization policy",
+		}, []string{"permission"}),
+		grpcRequestDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_request_duration_seconds",
+			Help:    "gRPC request duration in seconds",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		grpcRequestCount: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_requests_total",
+			Help: "Total number of gRPC requests",
+		}, []string{"method", "code"}),
 	}
 }
 
@@ -87,10 +123,26 @@ func (m *prometheusMetrics) IncrementProductsCreated() {
 	m.productsCreatedTotal.Inc()
 }
 
+func (m *prometheusMetrics) IncrementProductsUpdated() {
+	m.productsUpdatedTotal.Inc()
+}
+
 func (m *prometheusMetrics) IncrementProductsDeleted() {
 	m.productsDeletedTotal.Inc()
 }
 
+func (m *prometheusMetrics) IncrementProductsRestored() {
+	m.productsRestoredTotal.Inc()
+}
+
+func (m *prometheusMetrics) IncrementProductsPurged(count int) {
+	m.productsPurgedTotal.Add(float64(count))
+}
+
+func (m *prometheusMetrics) IncrementReservationsExpired(count int) {
+	m.reservationsExpiredTotal.Add(float64(count))
+}
+
 func (m *prometheusMetrics) RecordRequestDuration(method, endpoint, status string, duration time.Duration) {
 	m.requestDuration.WithLabelValues(method, endpoint, status).Observe(duration.Seconds())
 }
@@ -131,3 +183,14 @@ func (m *prometheusMetrics) RecordOutboxEventProcessed(eventType string, status
 	m.outboxEventsProcessed.WithLabelValues(eventType, status).Inc()
 }
 
+func (m *prometheusMetrics) IncrementAuthorizationDenied(permission string) {
+	m.authorizationDenied.WithLabelValues(permission).Inc()
+}
+
+func (m *prometheusMetrics) RecordGRPCRequestDuration(method, code string, duration time.Duration) {
+	m.grpcRequestDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+}
+
+func (m *prometheusMetrics) IncrementGRPCRequestCount(method, code string) {
+	m.grpcRequestCount.WithLabelValues(method, code).Inc()
+}
@@ -0,0 +1,155 @@
+package retention
+
+import (
+	"context"
+	"product_service/products/internal/usecase/ports"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type PurgeWorker struct {
+	productRepo ports.ProductRepository
+	idempotency ports.IdempotencyStore
+	logger      *zap.Logger
+	metrics     ports.MetricsCollector
+	retention   time.Duration
+	interval    time.Duration
+	batchSize   int
+	stopChan    chan struct{}
+	doneChan    chan struct{}
+}
+
+func NewPurgeWorker(
+	productRepo ports.ProductRepository,
+	idempotency ports.IdempotencyStore,
+	logger *zap.Logger,
+	retention time.Duration,
+	interval time.Duration,
+	batchSize int,
+	metrics ports.MetricsCollector,
+) *PurgeWorker {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &PurgeWorker{
+		productRepo: productRepo,
+		idempotency: idempotency,
+		logger:      logger,
+		metrics:     metrics,
+		retention:   retention,
+		interval:    interval,
+		batchSize:   batchSize,
+		stopChan:    make(chan struct{}),
+		doneChan:    make(chan struct{}),
+	}
+}
+
+func (w *PurgeWorker) Start(ctx context.Context) {
+	go w.run(ctx)
+}
+
+func (w *PurgeWorker) Stop() {
+	close(w.stopChan)
+	<-w.doneChan
+}
+
+func (w *PurgeWorker) run(ctx context.Context) {
+	defer close(w.doneChan)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Purge worker stopped: context cancelled")
+			return
+		case <-w.stopChan:
+			w.logger.Info("Purge worker stopped: stop signal received")
+			return
+		case <-ticker.C:
+			w.purgeExpired(ctx)
+			w.purgeIdempotencyKeys(ctx)
+		}
+	}
+}
+
+func (w *PurgeWorker) purgeExpired(ctx context.Context) {
+	cutoff := time.Now().Add(-w.retention)
+	total := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.stopChan:
+			return
+		default:
+		}
+
+		purged, err := w.productRepo.PurgeDeleted(ctx, cutoff, w.batchSize)
+		if err != nil {
+			w.logger.Error("Failed to purge deleted products",
+				zap.Time("cutoff", cutoff),
+				zap.Error(err),
+			)
+			break
+		}
+
+		total += purged
+		if w.metrics != nil && purged > 0 {
+			w.metrics.RecordBatchSize("purge_products", purged)
+			w.metrics.IncrementProductsPurged(purged)
+		}
+
+		if purged < w.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		w.logger.Info("Purged soft-deleted products",
+			zap.Int("count", total),
+			zap.Time("cutoff", cutoff),
+		)
+	}
+}
+
+func (w *PurgeWorker) purgeIdempotencyKeys(ctx context.Context) {
+	if w.idempotency == nil {
+		return
+	}
+
+	now := time.Now()
+	total := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.stopChan:
+			return
+		default:
+		}
+
+		purged, err := w.idempotency.PurgeExpired(ctx, now, w.batchSize)
+		if err != nil {
+			w.logger.Error("Failed to purge expired idempotency keys",
+				zap.Error(err),
+			)
+			break
+		}
+
+		total += purged
+		if purged < w.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		w.logger.Info("Purged expired idempotency keys",
+			zap.Int("count", total),
+		)
+	}
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	actorKey       = "actor"
+	anonymousActor = "anonymous"
+	maxActorLength = 255
+)
+
+func ActorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		setActor(c, resolveActor(c))
+
+		c.Next()
+	}
+}
+
+func setActor(c *gin.Context, actor string) {
+	c.Set(actorKey, actor)
+	c.Request = c.Request.WithContext(ports.WithActor(c.Request.Context(), actor))
+}
+
+func GetActor(c *gin.Context) string {
+	if actor, exists := c.Get(actorKey); exists {
+		if str, ok := actor.(string); ok {
+			return str
+		}
+	}
+	return ""
+}
+
+func resolveActor(c *gin.Context) string {
+	if apiKey := strings.TrimSpace(c.GetHeader("X-API-Key")); apiKey != "" {
+		sum := sha256.Sum256([]byte(apiKey))
+		return "api_key:" + hex.EncodeToString(sum[:6])
+	}
+
+	if user := strings.TrimSpace(c.GetHeader("X-User-ID")); user != "" {
+		if len(user) > maxActorLength-len("user:") {
+			user = user[:maxActorLength-len("user:")]
+		}
+		return "user:" + user
+	}
+
+	return anonymousActor
+}
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	APIKeyHeader = "X-API-Key"
+	apiKeyKey    = "api_key"
+)
+
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, plaintext string) (*domain.APIKey, error)
+}
+
+type APIKeyAuth struct {
+	keys   APIKeyAuthenticator
+	errors ErrorResponder
+	logger ports.Logger
+}
+
+func NewAPIKeyAuth(keys APIKeyAuthenticator, errors ErrorResponder, logger ports.Logger) *APIKeyAuth {
+	return &APIKeyAuth{
+		keys:   keys,
+		errors: errors,
+		logger: logger,
+	}
+}
+
+func (a *APIKeyAuth) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		plaintext := strings.TrimSpace(c.GetHeader(APIKeyHeader))
+		if plaintext == "" {
+			c.Next()
+			return
+		}
+
+		key, err := a.keys.AuthenticateAPIKey(c.Request.Context(), plaintext)
+		if err != nil {
+			a.reject(c, err)
+			return
+		}
+
+		scopes := make([]string, len(key.Scopes))
+		for i, scope := range key.Scopes {
+			scopes[i] = scope.String()
+		}
+		principal := &domain.Principal{
+			Subject: "api_key:" + key.Prefix,
+			Claims: map[string]interface{}{
+				"api_key_id": strconv.FormatInt(key.ID, 10),
+				"scope":      strings.Join(scopes, " "),
+				"tenant_id":  key.TenantID,
+			},
+		}
+
+		c.Set(apiKeyKey, key)
+		c.Set(principalKey, principal)
+		ctx := ports.WithAPIKey(c.Request.Context(), key)
+		c.Request = c.Request.WithContext(ports.WithPrincipal(ctx, principal))
+		setActor(c, principal.Subject)
+		if !SetTenant(c, key.TenantID) {
+			a.reject(c, fmt.Errorf("api key %s has an invalid tenant: %w", key.Prefix, domain.ErrInvalidAPIKey))
+			return
+		}
+		if err := CheckTenantBinding(c.GetHeader(tenantHeader), key.TenantID); err != nil {
+			a.reject(c, err)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func GetAPIKey(c *gin.Context) (*domain.APIKey, bool) {
+	if value, exists := c.Get(apiKeyKey); exists {
+		if key, ok := value.(*domain.APIKey); ok {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+func (a *APIKeyAuth) reject(c *gin.Context, err error) {
+	a.logger.Warn("API key rejected",
+		ports.NewField("error", err),
+		ports.NewField("path", c.Request.URL.Path),
+		ports.NewField("request_id", GetRequestID(c)),
+	)
+	a.errors.MapToHTTPError(c.Writer, err, c.Request.Context())
+	c.Abort()
+}
@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	principalKey = "principal"
+	bearerPrefix = "bearer "
+)
+
+type ErrorResponder interface {
+	MapToHTTPError(w http.ResponseWriter, err error, ctx context.Context)
+	WriteError(w http.ResponseWriter, ctx context.Context, code handler.ErrorCode, detail string)
+}
+
+type publicRoute struct {
+	method string
+	path   string
+	prefix bool
+}
+
+type Authenticator struct {
+	verifier     ports.TokenVerifier
+	errors       ErrorResponder
+	publicRoutes []publicRoute
+	tenantClaim  string
+	rolesClaim   string
+	logger       ports.Logger
+}
+
+func NewAuthenticator(verifier ports.TokenVerifier, errors ErrorResponder, publicRoutes []string, tenantClaim, rolesClaim string, logger ports.Logger) *Authenticator {
+	return &Authenticator{
+		verifier:     verifier,
+		errors:       errors,
+		publicRoutes: parsePublicRoutes(publicRoutes),
+		tenantClaim:  tenantClaim,
+		rolesClaim:   rolesClaim,
+		logger:       logger,
+	}
+}
+
+func (a *Authenticator) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if a.isPublic(c) {
+			c.Next()
+			return
+		}
+
+		if _, ok := GetPrincipal(c); ok {
+			c.Next()
+			return
+		}
+
+		token, err := bearerToken(c.GetHeader("Authorization"))
+		if err != nil {
+			a.reject(c, err)
+			return
+		}
+
+		principal, err := a.verifier.Verify(token)
+		if err != nil {
+			a.reject(c, err)
+			return
+		}
+
+		if a.rolesClaim != "" {
+			principal.Roles = principal.StringsClaim(a.rolesClaim)
+		}
+
+		c.Set(principalKey, principal)
+		c.Request = c.Request.WithContext(ports.WithPrincipal(c.Request.Context(), principal))
+		setActor(c, "user:"+principal.Subject)
+
+		if a.tenantClaim != "" {
+			tenant, ok := principal.StringClaim(a.tenantClaim)
+			if !ok {
+				a.reject(c, fmt.Errorf("missing %s claim: %w", a.tenantClaim, domain.ErrInvalidToken))
+				return
+			}
+			tenant = strings.ToLower(tenant)
+			if !ValidTenantID(tenant) {
+				a.reject(c, fmt.Errorf("invalid %s claim: %w", a.tenantClaim, domain.ErrInvalidToken))
+				return
+			}
+			if err := CheckTenantBinding(c.GetHeader(tenantHeader), tenant); err != nil {
+				a.reject(c, err)
+				return
+			}
+			SetTenant(c, tenant)
+		}
+
+		c.Next()
+	}
+}
+
+func GetPrincipal(c *gin.Context) (*domain.Principal, bool) {
+	if value, exists := c.Get(principalKey); exists {
+		if principal, ok := value.(*domain.Principal); ok {
+			return principal, true
+		}
+	}
+	return nil, false
+}
+
+func (a *Authenticator) isPublic(c *gin.Context) bool {
+	path := c.FullPath()
+	if path == "" {
+		path = c.Request.URL.Path
+	}
+
+	for _, route := range a.publicRoutes {
+		if route.method != "" && route.method != c.Request.Method {
+			continue
+		}
+		if route.path == path || (route.prefix && strings.HasPrefix(path, route.path)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Authenticator) reject(c *gin.Context, err error) {
+	a.logger.Warn("Request authentication failed",
+		ports.NewField("error", err),
+		ports.NewField("path", c.Request.URL.Path),
+		ports.NewField("request_id", GetRequestID(c)),
+	)
+	a.errors.MapToHTTPError(c.Writer, err, c.Request.Context())
+	c.Abort()
+}
+
+func bearerToken(header string) (string, error) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return "", fmt.Errorf("missing bearer token: %w", domain.ErrUnauthenticated)
+	}
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", fmt.Errorf("authorization header is not a bearer token: %w", domain.ErrInvalidToken)
+	}
+	return strings.TrimSpace(header[len(bearerPrefix):]), nil
+}
+
+func parsePublicRoutes(entries []string) []publicRoute {
+	routes := make([]publicRoute, 0, len(entries))
+	for _, entry := range entries {
+		fields := strings.Fields(entry)
+		var route publicRoute
+		switch len(fields) {
+		case 1:
+			route.path = fields[0]
+		case 2:
+			route.method, route.path = strings.ToUpper(fields[0]), fields[1]
+		default:
+			continue
+		}
+		if strings.HasSuffix(route.path, "*") {
+			route.path = strings.TrimSuffix(route.path, "*")
+			route.prefix = true
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
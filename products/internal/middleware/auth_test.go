@@ -0,0 +1,190 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler"
+	"product_service/products/mocks"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/mock/gomock"
+)
+
+type stubVerifier map[string]*domain.Principal
+
+func (v stubVerifier) Verify(token string) (*domain.Principal, error) {
+	principal, ok := v[token]
+	if !ok {
+		return nil, domain.ErrInvalidToken
+	}
+	copied := *principal
+	return &copied, nil
+}
+
+type stubAPIKeys map[string]*domain.APIKey
+
+func (k stubAPIKeys) AuthenticateAPIKey(_ context.Context, plaintext string) (*domain.APIKey, error) {
+	key, ok := k[plaintext]
+	if !ok {
+		return nil, domain.ErrInvalidAPIKey
+	}
+	return key, nil
+}
+
+func newAuthTestRouter(t *testing.T, publicRoutes []string) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	errors := handler.NewErrorMapper(logger)
+
+	verifier := stubVerifier{
+		"acme-token":   {Subject: "alice", Claims: map[string]interface{}{"tenant_id": "Acme"}},
+		"untenanted":   {Subject: "bob", Claims: map[string]interface{}{}},
+		"bad-tenant":   {Subject: "carol", Claims: map[string]interface{}{"tenant_id": "no spaces"}},
+		"globex-token": {Subject: "dave", Claims: map[string]interface{}{"tenant_id": "globex"}},
+	}
+	keys := stubAPIKeys{
+		"key-initech": {ID: 7, Prefix: "abc123", TenantID: "initech", Scopes: []domain.APIKeyScope{domain.ScopeProductsRead}},
+	}
+
+	router := gin.New()
+	router.Use(TenantMiddleware(errors))
+	router.Use(NewAPIKeyAuth(keys, errors, logger).Middleware())
+	router.Use(NewAuthenticator(verifier, errors, publicRoutes, "tenant_id", "", logger).Middleware())
+	respond := func(c *gin.Context) {
+		subject := ""
+		if principal, ok := GetPrincipal(c); ok {
+			subject = principal.Subject
+		}
+		c.JSON(http.StatusOK, gin.H{"tenant": GetTenant(c), "subject": subject})
+	}
+	router.GET("/health", respond)
+	router.GET("/docs/*any", respond)
+	router.GET("/api/v1/products", respond)
+	router.POST("/api/v1/products", respond)
+	return router
+}
+
+func TestAuthenticator_TenantPrecedence(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      string
+		token       string
+		apiKey      string
+		wantStatus  int
+		wantCode    handler.ErrorCode
+		wantTenant  string
+		wantSubject string
+	}{
+		{name: "claim sets tenant", token: "acme-token", wantStatus: http.StatusOK, wantTenant: "acme", wantSubject: "alice"},
+		{name: "matching header", header: "ACME", token: "acme-token", wantStatus: http.StatusOK, wantTenant: "acme", wantSubject: "alice"},
+		{name: "header differs from claim", header: "globex", token: "acme-token", wantStatus: http.StatusForbidden, wantCode: handler.CodeForbidden},
+		{name: "missing claim", header: "globex", token: "untenanted", wantStatus: http.StatusUnauthorized, wantCode: handler.CodeInvalidToken},
+		{name: "invalid claim", token: "bad-tenant", wantStatus: http.StatusUnauthorized, wantCode: handler.CodeInvalidToken},
+		{name: "api key sets tenant", apiKey: "key-initech", wantStatus: http.StatusOK, wantTenant: "initech", wantSubject: "api_key:abc123"},
+		{name: "api key wins over token", apiKey: "key-initech", token: "globex-token", wantStatus: http.StatusOK, wantTenant: "initech", wantSubject: "api_key:abc123"},
+		{name: "header differs from api key", header: "globex", apiKey: "key-initech", wantStatus: http.StatusForbidden, wantCode: handler.CodeForbidden},
+		{name: "unknown api key", apiKey: "key-unknown", token: "acme-token", wantStatus: http.StatusUnauthorized, wantCode: handler.CodeInvalidAPIKey},
+		{name: "no credentials", header: "acme", wantStatus: http.StatusUnauthorized, wantCode: handler.CodeUnauthenticated},
+		{name: "invalid header", header: "bad tenant", token: "acme-token", wantStatus: http.StatusBadRequest, wantCode: handler.CodeInvalidTenant},
+	}
+
+	router := newAuthTestRouter(t, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/products", nil)
+			if tt.header != "" {
+				req.Header.Set(tenantHeader, tt.header)
+			}
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			if tt.apiKey != "" {
+				req.Header.Set(APIKeyHeader, tt.apiKey)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantCode != "" {
+				var problem handler.ProblemDetails
+				if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+					t.Fatalf("decode problem: %v", err)
+				}
+				if problem.Code != tt.wantCode {
+					t.Errorf("code = %s, want %s", problem.Code, tt.wantCode)
+				}
+				return
+			}
+
+			var body map[string]string
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body["tenant"] != tt.wantTenant {
+				t.Errorf("tenant = %q, want %q", body["tenant"], tt.wantTenant)
+			}
+			if body["subject"] != tt.wantSubject {
+				t.Errorf("subject = %q, want %q", body["subject"], tt.wantSubject)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_PublicRoutes(t *testing.T) {
+	router := newAuthTestRouter(t, []string{"/health", "GET /docs/*", "get /api/v1/products", "/api/v1"})
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		authorization string
+		wantStatus    int
+		wantCode      handler.ErrorCode
+	}{
+		{name: "exact path", method: http.MethodGet, path: "/health", wantStatus: http.StatusOK},
+		{name: "path without method allows any method", method: http.MethodPost, path: "/health", wantStatus: http.StatusNotFound},
+		{name: "prefix match", method: http.MethodGet, path: "/docs/index.html", wantStatus: http.StatusOK},
+		{name: "prefix with wrong method", method: http.MethodPost, path: "/docs/index.html", wantStatus: http.StatusUnauthorized, wantCode: handler.CodeUnauthenticated},
+		{name: "method is case insensitive", method: http.MethodGet, path: "/api/v1/products", wantStatus: http.StatusOK},
+		{name: "method must match", method: http.MethodPost, path: "/api/v1/products", wantStatus: http.StatusUnauthorized, wantCode: handler.CodeUnauthenticated},
+		{name: "exact path is not a prefix", method: http.MethodGet, path: "/api/v1/categories", wantStatus: http.StatusUnauthorized, wantCode: handler.CodeUnauthenticated},
+		{name: "non-bearer scheme", method: http.MethodPost, path: "/api/v1/products", authorization: "Basic YWxpY2U6c2VjcmV0", wantStatus: http.StatusUnauthorized, wantCode: handler.CodeInvalidToken},
+		{name: "bearer token on private route", method: http.MethodPost, path: "/api/v1/products", authorization: "bearer acme-token", wantStatus: http.StatusOK},
+		{name: "unknown bearer token", method: http.MethodPost, path: "/api/v1/products", authorization: "Bearer forged", wantStatus: http.StatusUnauthorized, wantCode: handler.CodeInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantCode != "" {
+				var problem handler.ProblemDetails
+				if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+					t.Fatalf("decode problem: %v", err)
+				}
+				if problem.Code != tt.wantCode {
+					t.Errorf("code = %s, want %s", problem.Code, tt.wantCode)
+				}
+			}
+		})
+	}
+}
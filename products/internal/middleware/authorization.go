@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+)
+
+type Authorization struct {
+	authorizer ports.Authorizer
+	errors     ErrorResponder
+}
+
+func NewAuthorization(authorizer ports.Authorizer, errors ErrorResponder) *Authorization {
+	return &Authorization{
+		authorizer: authorizer,
+		errors:     errors,
+	}
+}
+
+func (a *Authorization) Require(permission domain.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := a.authorizer.Authorize(c.Request.Context(), permission); err != nil {
+			a.errors.MapToHTTPError(c.Writer, err, c.Request.Context())
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
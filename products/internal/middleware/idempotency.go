@@ -0,0 +1,200 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotentRequestBody = 1 << 20
+	idempotencyStoreTimeout  = 5 * time.Second
+	idempotencySkipKey       = "idempotency_skip"
+)
+
+var replayedResponseHeaders = []string{"ETag", "Location"}
+
+type Idempotency struct {
+	store       ports.IdempotencyStore
+	errors      ErrorResponder
+	logger      ports.Logger
+	lockTimeout time.Duration
+	ttl         time.Duration
+}
+
+func NewIdempotency(store ports.IdempotencyStore, lockTimeout, ttl time.Duration, errors ErrorResponder, logger ports.Logger) *Idempotency {
+	return &Idempotency{
+		store:       store,
+		errors:      errors,
+		logger:      logger,
+		lockTimeout: lockTimeout,
+		ttl:         ttl,
+	}
+}
+
+func (m *Idempotency) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := strings.TrimSpace(c.GetHeader(idempotencyKeyHeader))
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > domain.MaxIdempotencyKeyLength {
+			m.abort(c, handler.CodeInvalidIdempotencyKey, "Idempotency-Key is too long")
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxIdempotentRequestBody+1))
+		if err != nil {
+			m.abort(c, handler.CodeInvalidRequestBody, "")
+			return
+		}
+		if len(body) > maxIdempotentRequestBody {
+			m.abort(c, handler.CodePayloadTooLarge, "")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		scope := GetTenant(c) + "/" + GetActor(c)
+		hash := requestHash(c.Request, body)
+
+		record, acquired, err := m.store.Acquire(c.Request.Context(), scope, key, hash, m.lockTimeout, m.ttl)
+		if err != nil && !errors.Is(err, domain.ErrIdempotencyKeyInProgress) {
+			m.logger.Error("Failed to acquire idempotency key",
+				ports.NewField("error", err),
+				ports.NewField("request_id", GetRequestID(c)),
+			)
+			m.abort(c, handler.CodeServiceUnavailable, "Idempotency store unavailable")
+			return
+		}
+		if err == nil && !acquired {
+			err = record.Check(hash)
+		}
+
+		switch {
+		case errors.Is(err, domain.ErrIdempotencyKeyReused):
+			m.logger.Warn("Idempotency key reused with a different request",
+				ports.NewField("idempotency_key", key),
+				ports.NewField("request_id", GetRequestID(c)),
+			)
+			m.abort(c, handler.CodeIdempotencyKeyReused, "")
+			return
+		case errors.Is(err, domain.ErrIdempotencyKeyInProgress):
+			c.Header("Retry-After", "1")
+			m.abort(c, handler.CodeIdempotencyInProgress, "")
+			return
+		}
+
+		if !acquired {
+			for name, value := range record.Headers {
+				c.Header(name, value)
+			}
+			c.Header(idempotentReplayedHeader, "true")
+			c.Data(record.StatusCode, record.ContentType, record.ResponseBody)
+			c.Abort()
+			return
+		}
+
+		writer := &capturingResponseWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		keepKey := false
+		defer func() {
+			if keepKey {
+				return
+			}
+			ctx, cancel := storeContext(c.Request.Context())
+			defer cancel()
+			if err := m.store.Release(ctx, scope, key); err != nil {
+				m.logger.Error("Failed to release idempotency key",
+					ports.NewField("error", err),
+					ports.NewField("request_id", GetRequestID(c)),
+				)
+			}
+		}()
+
+		c.Next()
+
+		status := writer.Status()
+		if status < 200 || status >= 300 || c.GetBool(idempotencySkipKey) {
+			return
+		}
+
+		ctx, cancel := storeContext(c.Request.Context())
+		defer cancel()
+		keepKey = true
+		if err := m.store.Complete(ctx, scope, key, status, writer.Header().Get("Content-Type"), responseHeaders(writer.Header()), writer.body.Bytes()); err != nil {
+			m.logger.Error("Failed to store idempotent response, keeping the key locked",
+				ports.NewField("error", err),
+				ports.NewField("request_id", GetRequestID(c)),
+			)
+			if err := m.store.Retain(ctx, scope, key); err != nil {
+				m.logger.Error("Failed to retain idempotency key",
+					ports.NewField("error", err),
+					ports.NewField("request_id", GetRequestID(c)),
+				)
+			}
+		}
+	}
+}
+
+func SkipIdempotentResponse(c *gin.Context) {
+	c.Set(idempotencySkipKey, true)
+}
+
+func (m *Idempotency) abort(c *gin.Context, code handler.ErrorCode, detail string) {
+	m.errors.WriteError(c.Writer, c.Request.Context(), code, detail)
+	c.Abort()
+}
+
+func responseHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string)
+	for _, name := range replayedResponseHeaders {
+		if value := header.Get(name); value != "" {
+			headers[name] = value
+		}
+	}
+	return headers
+}
+
+func requestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(r.URL.RequestURI()))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func storeContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), idempotencyStoreTimeout)
+}
+
+type capturingResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *capturingResponseWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *capturingResponseWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
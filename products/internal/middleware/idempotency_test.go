@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler"
+	"product_service/products/mocks"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/mock/gomock"
+)
+
+type memoryIdempotencyStore struct {
+	mu          sync.Mutex
+	records     map[string]*domain.IdempotencyRecord
+	completeErr error
+	completed   int
+	retained    int
+	released    int
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{records: make(map[string]*domain.IdempotencyRecord)}
+}
+
+func (s *memoryIdempotencyStore) Acquire(_ context.Context, scope, key, requestHash string, _, ttl time.Duration) (*domain.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[scope+"/"+key]; ok {
+		if !record.Completed() {
+			return record, false, domain.ErrIdempotencyKeyInProgress
+		}
+		return record, false, nil
+	}
+	record := &domain.IdempotencyRecord{Scope: scope, Key: key, RequestHash: requestHash, ExpiresAt: time.Now().Add(ttl)}
+	s.records[scope+"/"+key] = record
+	return record, true, nil
+}
+
+func (s *memoryIdempotencyStore) Complete(_ context.Context, scope, key string, statusCode int, contentType string, headers map[string]string, body []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.completeErr != nil {
+		return s.completeErr
+	}
+	record := s.records[scope+"/"+key]
+	record.StatusCode = statusCode
+	record.ContentType = contentType
+	record.Headers = headers
+	record.ResponseBody = append([]byte(nil), body...)
+	s.completed++
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Retain(context.Context, string, string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.retained++
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(_ context.Context, scope, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, scope+"/"+key)
+	s.released++
+	return nil
+}
+
+func (s *memoryIdempotencyStore) PurgeExpired(context.Context, time.Time, int) (int, error) {
+	return 0, nil
+}
+
+func newIdempotencyTestRouter(t *testing.T, store *memoryIdempotencyStore, handlers ...gin.HandlerFunc) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockLogger(ctrl)
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	router := gin.New()
+	idempotency := NewIdempotency(store, time.Minute, time.Hour, handler.NewErrorMapper(logger), logger)
+	router.POST("/things", append([]gin.HandlerFunc{idempotency.Middleware()}, handlers...)...)
+	return router
+}
+
+func postWithKey(router *gin.Engine, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/things", strings.NewReader(body))
+	req.Header.Set(idempotencyKeyHeader, key)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotency_SkippedResponseIsNotStored(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	calls := 0
+	router := newIdempotencyTestRouter(t, store, func(c *gin.Context) {
+		calls++
+		SkipIdempotentResponse(c)
+		c.JSON(http.StatusOK, gin.H{"errors": []string{"boom"}})
+	})
+
+	for i := 0; i < 2; i++ {
+		if rec := postWithKey(router, "key-1", `{}`); rec.Code != http.StatusOK || rec.Header().Get(idempotentReplayedHeader) != "" {
+			t.Fatalf("Expected a fresh 200 response, got %d replayed=%q", rec.Code, rec.Header().Get(idempotentReplayedHeader))
+		}
+	}
+	if calls != 2 || store.completed != 0 || store.released != 2 {
+		t.Errorf("Expected both requests to run and release the key, got calls=%d completed=%d released=%d", calls, store.completed, store.released)
+	}
+}
+
+func TestIdempotency_ReplaysLocationAndETag(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	calls := 0
+	router := newIdempotencyTestRouter(t, store, func(c *gin.Context) {
+		calls++
+		c.Header("Location", "/api/v1/products/7")
+		c.Header("ETag", `"1"`)
+		c.Header("X-Request-ID", "not-replayed")
+		c.JSON(http.StatusCreated, gin.H{"id": 7})
+	})
+
+	first := postWithKey(router, "key-1", `{"name":"x"}`)
+	replay := postWithKey(router, "key-1", `{"name":"x"}`)
+
+	if calls != 1 {
+		t.Fatalf("Expected the handler to run once, ran %d times", calls)
+	}
+	if replay.Code != http.StatusCreated || replay.Header().Get(idempotentReplayedHeader) != "true" {
+		t.Fatalf("Expected a replayed 201, got %d replayed=%q", replay.Code, replay.Header().Get(idempotentReplayedHeader))
+	}
+	for _, name := range []string{"Location", "ETag", "Content-Type"} {
+		if replay.Header().Get(name) != first.Header().Get(name) {
+			t.Errorf("Expected replayed %s %q, got %q", name, first.Header().Get(name), replay.Header().Get(name))
+		}
+	}
+	if replay.Header().Get("X-Request-ID") != "" {
+		t.Errorf("Expected X-Request-ID not to be replayed, got %q", replay.Header().Get("X-Request-ID"))
+	}
+	if replay.Body.String() != first.Body.String() {
+		t.Errorf("Expected replayed body %s, got %s", first.Body.String(), replay.Body.String())
+	}
+}
+
+func TestIdempotency_FailedCompleteKeepsKeyLocked(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	store.completeErr = errors.New("connection reset")
+	calls := 0
+	router := newIdempotencyTestRouter(t, store, func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"id": 7})
+	})
+
+	if rec := postWithKey(router, "key-1", `{}`); rec.Code != http.StatusCreated {
+		t.Fatalf("Expected the first request to succeed, got %d", rec.Code)
+	}
+	retry := postWithKey(router, "key-1", `{}`)
+
+	if calls != 1 {
+		t.Errorf("Expected the retry not to run the handler again, ran %d times", calls)
+	}
+	if retry.Code != http.StatusConflict {
+		t.Errorf("Expected the retry to see the key in progress, got %d", retry.Code)
+	}
+	if store.released != 0 || store.retained != 1 {
+		t.Errorf("Expected the key to be retained, not released, got retained=%d released=%d", store.retained, store.released)
+	}
+}
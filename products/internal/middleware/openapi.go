@@ -0,0 +1,207 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"regexp"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+
+	"product_service/products/api/openapi"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/usecase/ports"
+)
+
+const maxValidatedRequestBody = 1 << 20
+
+var ginPathParam = regexp.MustCompile(`/:([^/]+)`)
+
+type OpenAPIValidator struct {
+	spec              *openapi3.T
+	specJSON          []byte
+	validateResponses bool
+	errors            ErrorResponder
+	logger            ports.Logger
+}
+
+func NewOpenAPIValidator(validateResponses bool, errors ErrorResponder, logger ports.Logger) (*OpenAPIValidator, error) {
+	spec, err := openapi.Load()
+	if err != nil {
+		return nil, err
+	}
+	specJSON, err := openapi.JSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenAPIValidator{
+		spec:              spec,
+		specJSON:          specJSON,
+		validateResponses: validateResponses,
+		errors:            errors,
+		logger:            logger,
+	}, nil
+}
+
+func (v *OpenAPIValidator) SpecHandler(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json", v.specJSON)
+}
+
+func (v *OpenAPIValidator) DocsHandler(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", openapi.DocsPage)
+}
+
+func (v *OpenAPIValidator) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route, ok := v.route(c)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		pathParams := make(map[string]string, len(c.Params))
+		for _, param := range c.Params {
+			pathParams[param.Key] = param.Value
+		}
+
+		options := &openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			ExcludeRequestBody: !hasJSONContent(route.Operation.RequestBody),
+		}
+		if !options.ExcludeRequestBody {
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxValidatedRequestBody)
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		}
+		if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+			validationErr := openapi.NewValidationError(err)
+			v.logger.Warn("Request does not match the API specification",
+				ports.NewField("operation", route.Operation.OperationID),
+				ports.NewField("details", validationErr.Details),
+			)
+			v.errors.MapToHTTPError(c.Writer, validationErr, c.Request.Context())
+			c.Abort()
+			return
+		}
+
+		if !v.validateResponses || streamsResponse(route.Operation) {
+			c.Next()
+			return
+		}
+
+		writer := &bufferedResponseWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		serveBuffered(c, writer)
+
+		err := openapi3filter.ValidateResponse(c.Request.Context(), (&openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 writer.status,
+			Header:                 writer.Header(),
+			Options:                options,
+		}).SetBodyBytes(writer.body.Bytes()))
+		if err != nil {
+			v.logger.Error("Response does not match the API specification",
+				ports.NewField("operation", route.Operation.OperationID),
+				ports.NewField("status", writer.status),
+				ports.NewField("error", err),
+				ports.NewField("request_id", GetRequestID(c)),
+			)
+			writer.Header().Del("Content-Length")
+			v.errors.WriteError(c.Writer, c.Request.Context(), handler.CodeResponseValidation, "")
+			c.Abort()
+			return
+		}
+
+		c.Writer.WriteHeader(writer.status)
+		if writer.body.Len() > 0 {
+			c.Writer.Write(writer.body.Bytes())
+		} else {
+			c.Writer.WriteHeaderNow()
+		}
+	}
+}
+
+func (v *OpenAPIValidator) route(c *gin.Context) (*routers.Route, bool) {
+	for _, path := range []string{ginPathParam.ReplaceAllString(c.FullPath(), "/{$1}"), c.Request.URL.Path} {
+		pathItem := v.spec.Paths.Find(path)
+		if pathItem == nil {
+			continue
+		}
+		operation := pathItem.GetOperation(c.Request.Method)
+		if operation == nil {
+			return nil, false
+		}
+		return &routers.Route{
+			Spec:      v.spec,
+			Path:      path,
+			PathItem:  pathItem,
+			Method:    c.Request.Method,
+			Operation: operation,
+		}, true
+	}
+	return nil, false
+}
+
+func hasJSONContent(body *openapi3.RequestBodyRef) bool {
+	return body != nil && body.Value != nil && body.Value.Content.Get("application/json") != nil
+}
+
+func streamsResponse(operation *openapi3.Operation) bool {
+	response := operation.Responses.Status(http.StatusOK)
+	if response == nil || response.Value == nil || len(response.Value.Content) == 0 {
+		return false
+	}
+	return response.Value.Content.Get("application/json") == nil
+}
+
+func serveBuffered(c *gin.Context, writer *bufferedResponseWriter) {
+	c.Writer = writer
+	defer func() {
+		c.Writer = writer.ResponseWriter
+	}()
+	c.Next()
+}
+
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.written = true
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	w.written = true
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.written
+}
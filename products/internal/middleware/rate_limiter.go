@@ -1,11 +1,12 @@
 package middleware
 
 import (
-	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"product_service/products/internal/handler"
 	"product_service/products/internal/usecase/ports"
 )
 
@@ -14,16 +15,18 @@ type RateLimiter struct {
 	mu       sync.RWMutex
 	limit    int
 	window   time.Duration
+	errors   ErrorResponder
 	logger   ports.Logger
 	stopChan chan struct{}
 	stopOnce sync.Once
 }
 
-func NewRateLimiter(limit int, window time.Duration, logger ports.Logger) *RateLimiter {
+func NewRateLimiter(limit int, window time.Duration, errors ErrorResponder, logger ports.Logger) *RateLimiter {
 	rl := &RateLimiter{
 		requests: make(map[string][]time.Time),
 		limit:    limit,
 		window:   window,
+		errors:   errors,
 		logger:   logger,
 		stopChan: make(chan struct{}),
 	}
@@ -41,16 +44,15 @@ func (rl *RateLimiter) Stop() {
 
 func (rl *RateLimiter) RateLimitMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		clientID := c.ClientIP()
+		clientID, limit := rl.clientLimit(c)
 
-		if !rl.allow(clientID) {
+		if !rl.allow(clientID, limit) {
 			rl.logger.Warn("Rate limit exceeded",
 				ports.NewField("client_id", clientID),
 				ports.NewField("path", c.Request.URL.Path),
 			)
-			c.JSON(http.StatusTooManyRequests, gin.H{
-				"error": "Rate limit exceeded",
-			})
+			c.Header("Retry-After", strconv.Itoa(int(rl.window.Seconds())))
+			rl.errors.WriteError(c.Writer, c.Request.Context(), handler.CodeRateLimited, "")
 			c.Abort()
 			return
 		}
@@ -59,7 +61,18 @@ func (rl *RateLimiter) RateLimitMiddleware() gin.HandlerFunc {
 	}
 }
 
-func (rl *RateLimiter) allow(clientID string) bool {
+func (rl *RateLimiter) clientLimit(c *gin.Context) (string, int) {
+	if key, ok := GetAPIKey(c); ok {
+		limit := rl.limit
+		if key.RateLimit > 0 {
+			limit = key.RateLimit
+		}
+		return "api_key:" + strconv.FormatInt(key.ID, 10), limit
+	}
+	return c.ClientIP(), rl.limit
+}
+
+func (rl *RateLimiter) allow(clientID string, limit int) bool {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
@@ -84,7 +97,7 @@ func (rl *RateLimiter) allow(clientID string) bool {
 
 	requests = requests[:validCount]
 
-	if len(requests) >= rl.limit {
+	if len(requests) >= limit {
 		rl.requests[clientID] = requests
 		return false
 	}
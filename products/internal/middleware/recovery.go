@@ -1,14 +1,14 @@
 package middleware
 
 import (
-	"net/http"
 	"runtime/debug"
 
 	"github.com/gin-gonic/gin"
+	"product_service/products/internal/handler"
 	"product_service/products/internal/usecase/ports"
 )
 
-func RecoveryMiddleware(logger ports.Logger) gin.HandlerFunc {
+func RecoveryMiddleware(errors ErrorResponder, logger ports.Logger) gin.HandlerFunc {
 	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
 		logger.Error("Panic recovered",
 			ports.NewField("error", recovered),
@@ -16,9 +16,8 @@ func RecoveryMiddleware(logger ports.Logger) gin.HandlerFunc {
 			ports.NewField("method", c.Request.Method),
 			ports.NewField("stack", string(debug.Stack())),
 		)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Internal server error",
-		})
+		errors.WriteError(c.Writer, c.Request.Context(), handler.CodeInternal, "")
+		c.Abort()
 	})
 }
 
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"product_service/products/internal/usecase/ports"
 )
 
 const requestIDKey = "request_id"
@@ -21,7 +22,7 @@ func RequestIDMiddleware() gin.HandlerFunc {
 
 		c.Header("X-Request-ID", requestID)
 
-		c.Request = c.Request.WithContext(c.Request.Context())
+		c.Request = c.Request.WithContext(ports.WithRequestID(c.Request.Context(), requestID))
 
 		c.Next()
 	}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/handler"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	tenantKey    = "tenant"
+	tenantHeader = "X-Tenant-ID"
+)
+
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+func TenantMiddleware(errors ErrorResponder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenant := strings.ToLower(strings.TrimSpace(c.GetHeader(tenantHeader)))
+		if tenant == "" {
+			tenant = ports.DefaultTenant
+		}
+
+		if !SetTenant(c, tenant) {
+			errors.WriteError(c.Writer, c.Request.Context(), handler.CodeInvalidTenant, "")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func SetTenant(c *gin.Context, tenant string) bool {
+	if !ValidTenantID(tenant) {
+		return false
+	}
+
+	c.Set(tenantKey, tenant)
+	c.Request = c.Request.WithContext(ports.WithTenant(c.Request.Context(), tenant))
+	return true
+}
+
+func GetTenant(c *gin.Context) string {
+	if tenant, exists := c.Get(tenantKey); exists {
+		if str, ok := tenant.(string); ok {
+			return str
+		}
+	}
+	return ports.DefaultTenant
+}
+
+func ValidTenantID(tenant string) bool {
+	return tenantIDPattern.MatchString(tenant)
+}
+
+func CheckTenantBinding(requested, bound string) error {
+	requested = strings.ToLower(strings.TrimSpace(requested))
+	if requested != "" && requested != bound {
+		return fmt.Errorf("requested tenant %q does not match credential tenant %q: %w", requested, bound, domain.ErrForbidden)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"product_service/products/internal/domain"
 	"product_service/products/internal/usecase/ports"
 	"time"
@@ -12,6 +13,8 @@ type MetricsProductRepositoryDecorator struct {
 	metrics ports.MetricsCollector
 }
 
+var _ ports.TransactionalRepository = (*MetricsProductRepositoryDecorator)(nil)
+
 func NewMetricsProductRepositoryDecorator(repo ports.ProductRepository, metrics ports.MetricsCollector) ports.ProductRepository {
 	if metrics == nil {
 		return repo
@@ -31,30 +34,106 @@ func (d *MetricsProductRepositoryDecorator) Create(ctx context.Context, product
 	return err
 }
 
-func (d *MetricsProductRepositoryDecorator) GetByID(ctx context.Context, id int) (*domain.Product, error) {
+func (d *MetricsProductRepositoryDecorator) CreateBatch(ctx context.Context, products []*domain.Product) error {
 	start := time.Now()
-	product, err := d.repo.GetByID(ctx, id)
+	err := d.repo.CreateBatch(ctx, products)
+	if d.metrics != nil {
+		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
+		d.metrics.RecordBatchSize("create_products", len(products))
+	}
+	return err
+}
+
+func (d *MetricsProductRepositoryDecorator) GetByID(ctx context.Context, id int, includeDeleted bool) (*domain.Product, error) {
+	start := time.Now()
+	product, err := d.repo.GetByID(ctx, id, includeDeleted)
 	if d.metrics != nil {
 		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
 	}
 	return product, err
 }
 
-func (d *MetricsProductRepositoryDecorator) List(ctx context.Context, page, limit int) ([]domain.Product, int, error) {
+func (d *MetricsProductRepositoryDecorator) GetByIDs(ctx context.Context, ids []int, includeDeleted bool) ([]domain.Product, error) {
+	start := time.Now()
+	products, err := d.repo.GetByIDs(ctx, ids, includeDeleted)
+	if d.metrics != nil {
+		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
+	}
+	return products, err
+}
+
+func (d *MetricsProductRepositoryDecorator) List(ctx context.Context, query ports.ProductListQuery) (ports.ProductListResult, error) {
+	start := time.Now()
+	result, err := d.repo.List(ctx, query)
+	if d.metrics != nil {
+		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
+	}
+	return result, err
+}
+
+func (d *MetricsProductRepositoryDecorator) Search(ctx context.Context, query ports.ProductSearchQuery) (ports.ProductSearchResult, error) {
+	start := time.Now()
+	result, err := d.repo.Search(ctx, query)
+	if d.metrics != nil {
+		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
+	}
+	return result, err
+}
+
+func (d *MetricsProductRepositoryDecorator) Update(ctx context.Context, product *domain.Product) error {
 	start := time.Now()
-	products, total, err := d.repo.List(ctx, page, limit)
+	err := d.repo.Update(ctx, product)
 	if d.metrics != nil {
 		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
 	}
-	return products, total, err
+	return err
+}
+
+func (d *MetricsProductRepositoryDecorator) Delete(ctx context.Context, id int, version int) error {
+	start := time.Now()
+	err := d.repo.Delete(ctx, id, version)
+	if d.metrics != nil {
+		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
+	}
+	return err
+}
+
+func (d *MetricsProductRepositoryDecorator) CountActiveVariants(ctx context.Context, parentID int) (int, error) {
+	start := time.Now()
+	count, err := d.repo.CountActiveVariants(ctx, parentID)
+	if d.metrics != nil {
+		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
+	}
+	return count, err
 }
 
-func (d *MetricsProductRepositoryDecorator) Delete(ctx context.Context, id int) error {
+
+func (d *MetricsProductRepositoryDecorator) Restore(ctx context.Context, product *domain.Product) error {
 	start := time.Now()
-	err := d.repo.Delete(ctx, id)
+	err := d.repo.Restore(ctx, product)
 	if d.metrics != nil {
 		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
 	}
 	return err
 }
 
+func (d *MetricsProductRepositoryDecorator) PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) (int, error) {
+	start := time.Now()
+	purged, err := d.repo.PurgeDeleted(ctx, deletedBefore, limit)
+	if d.metrics != nil {
+		d.metrics.RecordDatabaseQueryDuration(time.Since(start))
+	}
+	return purged, err
+}
+
+func (d *MetricsProductRepositoryDecorator) SetTransaction(tx *sql.Tx) {
+	if txRepo, ok := d.repo.(ports.TransactionalRepository); ok {
+		txRepo.SetTransaction(tx)
+	}
+}
+
+func (d *MetricsProductRepositoryDecorator) ClearTransaction() {
+	if txRepo, ok := d.repo.(ports.TransactionalRepository); ok {
+		txRepo.ClearTransaction()
+	}
+}
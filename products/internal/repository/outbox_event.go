@@ -10,6 +10,8 @@ type OutboxEvent struct {
 	EventType      string
 	EventData      json.RawMessage
 	IdempotencyKey string
+	TenantID       string
+	DLQReason      string
 	CreatedAt      time.Time
 	PublishedAt    *time.Time
 	RetryCount     int
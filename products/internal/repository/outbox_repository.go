@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"product_service/products/internal/domain"
 	"product_service/products/internal/usecase/ports"
 	"strconv"
 	"strings"
@@ -15,6 +16,8 @@ var _ ports.OutboxRepository = (*postgresOutboxRepository)(nil)
 
 var _ ports.BatchOutboxRepository = (*postgresOutboxRepository)(nil)
 
+var _ ports.OutboxAdminRepository = (*postgresOutboxRepository)(nil)
+
 type postgresOutboxRepository struct {
 	db            *sql.DB
 	tx            *sql.Tx
@@ -36,6 +39,15 @@ func NewPostgresOutboxRepositoryWithConfig(db *sql.DB, stm *PreparedStatements,
 	}
 }
 
+func NewPostgresOutboxAdminRepository(db *sql.DB, stm *PreparedStatements) ports.OutboxAdminRepository {
+	return &postgresOutboxRepository{
+		db:            db,
+		stm:           stm,
+		queryExecutor: db,
+		maxBatchSize:  DefaultMaxBatchSize,
+	}
+}
+
 func (r *postgresOutboxRepository) getQueryExecutor() ports.QueryExecutor {
 	if r.tx != nil {
 		return r.tx
@@ -182,6 +194,8 @@ func toPortsOutboxEvent(event *OutboxEvent) *ports.OutboxEvent {
 		EventType:      event.EventType,
 		EventData:      eventData,
 		IdempotencyKey: event.IdempotencyKey,
+		TenantID:       event.TenantID,
+		DLQReason:      event.DLQReason,
 		CreatedAt:      event.CreatedAt,
 		PublishedAt:    event.PublishedAt,
 		RetryCount:     event.RetryCount,
@@ -195,6 +209,8 @@ func fromPortsOutboxEvent(event *ports.OutboxEvent) *OutboxEvent {
 		EventType:      event.EventType,
 		EventData:      json.RawMessage(event.EventData),
 		IdempotencyKey: event.IdempotencyKey,
+		TenantID:       event.TenantID,
+		DLQReason:      event.DLQReason,
 		CreatedAt:      event.CreatedAt,
 		PublishedAt:    event.PublishedAt,
 		RetryCount:     event.RetryCount,
@@ -212,6 +228,10 @@ func (r *postgresOutboxRepository) SaveEvent(ctx context.Context, event *ports.O
 		eventDataJSON = []byte("{}")
 	}
 
+	if repoEvent.TenantID == "" {
+		repoEvent.TenantID = tenantScope(ctx)
+	}
+
 	var row *sql.Row
 
 	if r.tx != nil {
@@ -225,6 +245,7 @@ func (r *postgresOutboxRepository) SaveEvent(ctx context.Context, event *ports.O
 			eventDataJSON,
 			repoEvent.IdempotencyKey,
 			repoEvent.Status,
+			repoEvent.TenantID,
 		)
 	} else {
 		row = r.stm.SaveOutboxEvent.QueryRowContext(ctx,
@@ -232,6 +253,7 @@ func (r *postgresOutboxRepository) SaveEvent(ctx context.Context, event *ports.O
 			eventDataJSON,
 			repoEvent.IdempotencyKey,
 			repoEvent.Status,
+			repoEvent.TenantID,
 		)
 	}
 
@@ -246,6 +268,7 @@ func (r *postgresOutboxRepository) SaveEvent(ctx context.Context, event *ports.O
 
 	event.ID = repoEvent.ID
 	event.CreatedAt = repoEvent.CreatedAt
+	event.TenantID = repoEvent.TenantID
 
 	return nil
 }
@@ -257,7 +280,7 @@ func (r *postgresOutboxRepository) GetPendingEvents(ctx context.Context, limit i
 
 	if r.tx != nil {
 		txStmt := r.tx.StmtContext(ctx, r.stm.GetPendingEvents)
-		rows, err = txStmt.QueryContext(ctx, OutboxStatusPending, limit)
+		rows, err = txStmt.QueryContext(ctx, OutboxStatusPending, limit, tenantScope(ctx))
 		if err != nil {
 			txStmt.Close()
 			return nil, fmt.Errorf("failed to get pending events: %w", err)
@@ -278,7 +301,7 @@ func (r *postgresOutboxRepository) GetPendingEvents(ctx context.Context, limit i
 			return nil
 		}
 	} else {
-		rows, err = r.stm.GetPendingEvents.QueryContext(ctx, OutboxStatusPending, limit)
+		rows, err = r.stm.GetPendingEvents.QueryContext(ctx, OutboxStatusPending, limit, tenantScope(ctx))
 		if err != nil {
 			return nil, fmt.Errorf("failed to get pending events: %w", err)
 		}
@@ -316,6 +339,7 @@ func (r *postgresOutboxRepository) scanEventsWithCapacity(rows *sql.Rows, closeF
 			&event.EventType,
 			&event.EventData,
 			&idempotencyKey,
+			&event.TenantID,
 			&event.CreatedAt,
 			&publishedAt,
 			&event.RetryCount,
@@ -349,9 +373,9 @@ func (r *postgresOutboxRepository) MarkAsPublished(ctx context.Context, eventID
 	if r.tx != nil {
 		txStmt := r.tx.StmtContext(ctx, r.stm.MarkAsPublished)
 		defer txStmt.Close()
-		result, err = txStmt.ExecContext(ctx, string(OutboxStatusPublished), eventID)
+		result, err = txStmt.ExecContext(ctx, string(OutboxStatusPublished), eventID, tenantScope(ctx))
 	} else {
-		result, err = r.stm.MarkAsPublished.ExecContext(ctx, string(OutboxStatusPublished), eventID)
+		result, err = r.stm.MarkAsPublished.ExecContext(ctx, string(OutboxStatusPublished), eventID, tenantScope(ctx))
 	}
 
 	if err != nil {
@@ -381,9 +405,9 @@ func (r *postgresOutboxRepository) MarkAsFailed(ctx context.Context, eventID int
 	if r.tx != nil {
 		txStmt := r.tx.StmtContext(ctx, r.stm.MarkAsFailed)
 		defer txStmt.Close()
-		result, err = txStmt.ExecContext(ctx, string(OutboxStatusFailed), retryCount, eventID)
+		result, err = txStmt.ExecContext(ctx, string(OutboxStatusFailed), retryCount, eventID, tenantScope(ctx))
 	} else {
-		result, err = r.stm.MarkAsFailed.ExecContext(ctx, string(OutboxStatusFailed), retryCount, eventID)
+		result, err = r.stm.MarkAsFailed.ExecContext(ctx, string(OutboxStatusFailed), retryCount, eventID, tenantScope(ctx))
 	}
 
 	if err != nil {
@@ -403,9 +427,9 @@ func (r *postgresOutboxRepository) CheckIdempotencyKey(ctx context.Context, idem
 	if r.tx != nil {
 		txStmt := r.tx.StmtContext(ctx, r.stm.CheckIdempotencyKey)
 		defer txStmt.Close()
-		row = txStmt.QueryRowContext(ctx, idempotencyKey)
+		row = txStmt.QueryRowContext(ctx, idempotencyKey, tenantScope(ctx))
 	} else {
-		row = r.stm.CheckIdempotencyKey.QueryRowContext(ctx, idempotencyKey)
+		row = r.stm.CheckIdempotencyKey.QueryRowContext(ctx, idempotencyKey, tenantScope(ctx))
 	}
 
 	var exists bool
@@ -421,7 +445,7 @@ func (r *postgresOutboxRepository) CheckIdempotencyKey(ctx context.Context, idem
 }
 
 func (r *postgresOutboxRepository) MoveToDLQ(ctx context.Context, eventID int64, reason string) error {
-	result, closeFn, err := r.executeExec(ctx, queryMoveToDLQ, string(OutboxStatusDLQ), eventID, reason)
+	result, closeFn, err := r.executeExec(ctx, queryMoveToDLQ, string(OutboxStatusDLQ), eventID, reason, tenantScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -430,9 +454,75 @@ func (r *postgresOutboxRepository) MoveToDLQ(ctx context.Context, eventID int64,
 	return r.checkRowsAffected(result, eventID, "move to DLQ")
 }
 
+func (r *postgresOutboxRepository) ListDeadLetters(ctx context.Context, limit, offset int) ([]ports.OutboxEvent, int, error) {
+	rows, closeFn, err := r.executeQuery(ctx, queryListDeadLetters, string(OutboxStatusDLQ), limit, offset, tenantScope(ctx))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list dead-letter events: %w", err)
+	}
+	defer closeFn()
+
+	events := make([]ports.OutboxEvent, 0, limit)
+	total := 0
+	for rows.Next() {
+		var event OutboxEvent
+		var publishedAt sql.NullTime
+		var idempotencyKey sql.NullString
+
+		err := rows.Scan(
+			&event.ID,
+			&event.EventType,
+			&event.EventData,
+			&idempotencyKey,
+			&event.TenantID,
+			&event.CreatedAt,
+			&publishedAt,
+			&event.RetryCount,
+			&event.Status,
+			&event.DLQReason,
+			&total,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan dead-letter event: %w", err)
+		}
+
+		if publishedAt.Valid {
+			event.PublishedAt = &publishedAt.Time
+		}
+		if idempotencyKey.Valid {
+			event.IdempotencyKey = idempotencyKey.String
+		}
+
+		events = append(events, *toPortsOutboxEvent(&event))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating dead-letter events: %w", err)
+	}
+
+	return events, total, nil
+}
+
+func (r *postgresOutboxRepository) RequeueDeadLetter(ctx context.Context, eventID int64) error {
+	result, closeFn, err := r.executeExec(ctx, queryRequeueDeadLetter, string(OutboxStatusPending), eventID, string(OutboxStatusDLQ), tenantScope(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to requeue dead-letter event: %w", err)
+	}
+	defer closeFn()
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("event with id %d: %w", eventID, domain.ErrDeadLetterNotFound)
+	}
+
+	return nil
+}
+
 const DefaultMaxBatchSize = 100
 
-const paramsPerEvent = 4
+const paramsPerEvent = 5
 
 const initialScanCapacity = 32
 
@@ -451,10 +541,8 @@ func (r *postgresOutboxRepository) SaveEventsBatch(ctx context.Context, events [
 func (r *postgresOutboxRepository) saveEventsBatchSingle(ctx context.Context, events []*ports.OutboxEvent) error {
 	args := make([]interface{}, 0, len(events)*paramsPerEvent)
 
-	estimatedSize := len(querySaveEventsBatch) + len(" RETURNING id, created_at") + (len(events) * 20)
-	var queryBuilder strings.Builder
-	queryBuilder.Grow(estimatedSize)
-	queryBuilder.WriteString(querySaveEventsBatch)
+	var valuesBuilder strings.Builder
+	valuesBuilder.Grow(len(events) * 32)
 
 	argIndex := 1
 	for i, event := range events {
@@ -466,26 +554,31 @@ func (r *postgresOutboxRepository) saveEventsBatchSingle(ctx context.Context, ev
 		}
 
 		if i > 0 {
-			queryBuilder.WriteString(", ")
+			valuesBuilder.WriteString(", ")
 		}
 
-		queryBuilder.WriteByte('(')
-		queryBuilder.WriteByte('$')
-		writeInt(&queryBuilder, argIndex)
-		queryBuilder.WriteString(", $")
-		writeInt(&queryBuilder, argIndex+1)
-		queryBuilder.WriteString(", $")
-		writeInt(&queryBuilder, argIndex+2)
-		queryBuilder.WriteString(", $")
-		writeInt(&queryBuilder, argIndex+3)
-		queryBuilder.WriteString(", NOW())")
+		valuesBuilder.WriteString("($")
+		writeInt(&valuesBuilder, argIndex)
+		valuesBuilder.WriteString(", $")
+		writeInt(&valuesBuilder, argIndex+1)
+		valuesBuilder.WriteString("::jsonb, $")
+		writeInt(&valuesBuilder, argIndex+2)
+		valuesBuilder.WriteString(", $")
+		writeInt(&valuesBuilder, argIndex+3)
+		valuesBuilder.WriteString(", $")
+		writeInt(&valuesBuilder, argIndex+4)
+		valuesBuilder.WriteByte(')')
+
+		tenant := event.TenantID
+		if tenant == "" {
+			tenant = tenantScope(ctx)
+		}
 
-		args = append(args, event.EventType, eventDataJSON, event.IdempotencyKey, string(ports.OutboxStatusPending))
+		args = append(args, event.EventType, eventDataJSON, event.IdempotencyKey, string(ports.OutboxStatusPending), tenant)
 		argIndex += paramsPerEvent
 	}
 
-	queryBuilder.WriteString(" ON CONFLICT (idempotency_key) DO NOTHING RETURNING id, created_at")
-	query := queryBuilder.String()
+	query := fmt.Sprintf(querySaveEventsBatch, valuesBuilder.String())
 
 	executor := r.getQueryExecutor()
 	var rows *sql.Rows
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"strings"
+	"time"
+)
+
+var _ ports.APIKeyRepository = (*postgresAPIKeyRepository)(nil)
+
+type postgresAPIKeyRepository struct {
+	db  *sql.DB
+	stm *PreparedStatements
+}
+
+func NewPostgresAPIKeyRepository(db *sql.DB, stm *PreparedStatements) ports.APIKeyRepository {
+	return &postgresAPIKeyRepository{
+		db:  db,
+		stm: stm,
+	}
+}
+
+func (r *postgresAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	err := r.stm.CreateAPIKey.QueryRowContext(ctx,
+		key.TenantID,
+		key.Name,
+		key.Prefix,
+		key.Hash,
+		scopeStrings(key.Scopes),
+		key.RateLimit,
+		key.CreatedBy,
+	).Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresAPIKeyRepository) GetByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	key, err := scanAPIKey(r.stm.GetAPIKeyByHash.QueryRowContext(ctx, hash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return key, nil
+}
+
+func (r *postgresAPIKeyRepository) List(ctx context.Context, limit, offset int) ([]domain.APIKey, int, error) {
+	rows, err := r.stm.ListAPIKeys.QueryContext(ctx, limit, offset, tenantScope(ctx))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0, limit)
+	total := 0
+	for rows.Next() {
+		key, err := scanAPIKey(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, *key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating api keys: %w", err)
+	}
+
+	return keys, total, nil
+}
+
+func (r *postgresAPIKeyRepository) UpdateScopes(ctx context.Context, id int64, scopes []domain.APIKeyScope) (*domain.APIKey, error) {
+	key, err := scanAPIKey(r.stm.UpdateAPIKeyScopes.QueryRowContext(ctx, id, scopeStrings(scopes), tenantScope(ctx)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update api key scopes: %w", err)
+	}
+	return key, nil
+}
+
+func (r *postgresAPIKeyRepository) Revoke(ctx context.Context, id int64) (*domain.APIKey, error) {
+	key, err := scanAPIKey(r.stm.RevokeAPIKey.QueryRowContext(ctx, id, tenantScope(ctx)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	return key, nil
+}
+
+func (r *postgresAPIKeyRepository) TouchLastUsed(ctx context.Context, id int64, usedAt time.Time) error {
+	if _, err := r.stm.TouchAPIKey.ExecContext(ctx, id, usedAt); err != nil {
+		return fmt.Errorf("failed to record api key usage: %w", err)
+	}
+	return nil
+}
+
+func scanAPIKey(row rowScanner, extra ...interface{}) (*domain.APIKey, error) {
+	var key domain.APIKey
+	var scopes string
+	var lastUsedAt, revokedAt sql.NullTime
+
+	dest := []interface{}{
+		&key.ID,
+		&key.TenantID,
+		&key.Name,
+		&key.Prefix,
+		&key.Hash,
+		&scopes,
+		&key.RateLimit,
+		&key.CreatedBy,
+		&key.CreatedAt,
+		&lastUsedAt,
+		&revokedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if scopes != "" {
+		for _, scope := range strings.Split(scopes, ",") {
+			key.Scopes = append(key.Scopes, domain.APIKeyScope(scope))
+		}
+	}
+	if lastUsedAt.Valid {
+		key.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	return &key, nil
+}
+
+func scopeStrings(scopes []domain.APIKeyScope) []string {
+	values := make([]string, len(scopes))
+	for i, scope := range scopes {
+		values[i] = scope.String()
+	}
+	return values
+}
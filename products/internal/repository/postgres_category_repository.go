@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+var _ ports.CategoryRepository = (*postgresCategoryRepository)(nil)
+
+var _ ports.TransactionalRepository = (*postgresCategoryRepository)(nil)
+
+type postgresCategoryRepository struct {
+	db  *sql.DB
+	tx  *sql.Tx
+	stm *PreparedStatements
+}
+
+func NewPostgresCategoryRepository(db *sql.DB, stm *PreparedStatements) ports.CategoryRepository {
+	return &postgresCategoryRepository{
+		db:  db,
+		stm: stm,
+	}
+}
+
+func (r *postgresCategoryRepository) SetTransaction(tx *sql.Tx) {
+	r.tx = tx
+}
+
+func (r *postgresCategoryRepository) ClearTransaction() {
+	r.tx = nil
+}
+
+func (r *postgresCategoryRepository) executeQueryRow(ctx context.Context, stmt *sql.Stmt, args ...interface{}) (*sql.Row, func() error) {
+	if r.tx != nil {
+		txStmt := r.tx.StmtContext(ctx, stmt)
+		return txStmt.QueryRowContext(ctx, args...), txStmt.Close
+	}
+	return stmt.QueryRowContext(ctx, args...), func() error { return nil }
+}
+
+func (r *postgresCategoryRepository) executeExec(ctx context.Context, stmt *sql.Stmt, args ...interface{}) (sql.Result, error) {
+	if r.tx != nil {
+		txStmt := r.tx.StmtContext(ctx, stmt)
+		defer txStmt.Close()
+		return txStmt.ExecContext(ctx, args...)
+	}
+	return stmt.ExecContext(ctx, args...)
+}
+
+func (r *postgresCategoryRepository) executeQuery(ctx context.Context, stmt *sql.Stmt, args ...interface{}) (*sql.Rows, func() error, error) {
+	if r.tx != nil {
+		txStmt := r.tx.StmtContext(ctx, stmt)
+		rows, err := txStmt.QueryContext(ctx, args...)
+		if err != nil {
+			txStmt.Close()
+			return nil, nil, err
+		}
+		return rows, txStmt.Close, nil
+	}
+	rows, err := stmt.QueryContext(ctx, args...)
+	return rows, func() error { return nil }, err
+}
+
+func (r *postgresCategoryRepository) Create(ctx context.Context, category *domain.Category) error {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.CreateCategory, category.Name, category.Slug, category.ParentID, ports.TenantFromContext(ctx))
+	defer closeFn()
+
+	if err := row.Scan(&category.ID, &category.Path, &category.Version, &category.CreatedAt, &category.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("parent category %d: %w", *category.ParentID, domain.ErrCategoryNotFound)
+		}
+		return fmt.Errorf("failed to create category: %w", translateCategoryError(err, domain.ErrCategoryNotFound))
+	}
+	return nil
+}
+
+func (r *postgresCategoryRepository) GetByID(ctx context.Context, id int) (*domain.Category, error) {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.GetCategoryByID, id, tenantScope(ctx))
+	defer closeFn()
+
+	category, err := scanCategory(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("category %d: %w", id, domain.ErrCategoryNotFound)
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	return category, nil
+}
+
+func (r *postgresCategoryRepository) List(ctx context.Context) ([]domain.Category, error) {
+	return r.queryCategories(ctx, r.stm.ListCategories, tenantScope(ctx))
+}
+
+func (r *postgresCategoryRepository) ListProductCategories(ctx context.Context, productID int) ([]domain.Category, error) {
+	return r.queryCategories(ctx, r.stm.ListProductCategories, productID, tenantScope(ctx))
+}
+
+func (r *postgresCategoryRepository) queryCategories(ctx context.Context, stmt *sql.Stmt, args ...interface{}) ([]domain.Category, error) {
+	rows, closeFn, err := r.executeQuery(ctx, stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer closeFn()
+	defer rows.Close()
+
+	categories := make([]domain.Category, 0)
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		categories = append(categories, *category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func scanCategory(row rowScanner) (*domain.Category, error) {
+	var category domain.Category
+	var parentID sql.NullInt64
+
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.Slug,
+		&parentID,
+		&category.Path,
+		&category.Version,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID.Valid {
+		id := int(parentID.Int64)
+		category.ParentID = &id
+	}
+	return &category, nil
+}
+
+func (r *postgresCategoryRepository) Update(ctx context.Context, category *domain.Category) error {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.UpdateCategory, category.Name, category.Slug, category.ID, category.Version, tenantScope(ctx))
+	defer closeFn()
+
+	if err := row.Scan(&category.Version, &category.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return r.missingOrConflict(ctx, category.ID)
+		}
+		return fmt.Errorf("failed to update category: %w", translateCategoryError(err, domain.ErrCategoryNotFound))
+	}
+	return nil
+}
+
+func (r *postgresCategoryRepository) LockForMove(ctx context.Context, id int, parentID *int) error {
+	if r.tx == nil {
+		return fmt.Errorf("locking categories requires a transaction")
+	}
+
+	rows, closeFn, err := r.executeQuery(ctx, r.stm.LockCategoriesForMove, id, parentID, tenantScope(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to lock categories: %w", err)
+	}
+	defer closeFn()
+	defer rows.Close()
+
+	for rows.Next() {
+		var lockedID int
+		if err := rows.Scan(&lockedID); err != nil {
+			return fmt.Errorf("failed to lock categories: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to lock categories: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresCategoryRepository) Move(ctx context.Context, category *domain.Category, previousPath string) error {
+	if r.tx == nil {
+		return fmt.Errorf("moving a category requires a transaction")
+	}
+
+	row, closeFn := r.executeQueryRow(ctx, r.stm.MoveCategory, category.ParentID, category.Path, category.ID, category.Version, tenantScope(ctx))
+	defer closeFn()
+
+	if err := row.Scan(&category.Version, &category.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return r.missingOrConflict(ctx, category.ID)
+		}
+		return fmt.Errorf("failed to move category: %w", translateCategoryError(err, domain.ErrCategoryNotFound))
+	}
+
+	if _, err := r.executeExec(ctx, r.stm.MoveCategoryDescendants, category.Path, previousPath, category.ID, tenantScope(ctx)); err != nil {
+		return fmt.Errorf("failed to move category descendants: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresCategoryRepository) Delete(ctx context.Context, id int, version int) error {
+	result, err := r.executeExec(ctx, r.stm.DeleteCategory, id, version, tenantScope(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", translateCategoryError(err, domain.ErrCategoryInUse))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+	if rowsAffected == 0 {
+		return r.missingOrConflict(ctx, id)
+	}
+	return nil
+}
+
+func (r *postgresCategoryRepository) missingOrConflict(ctx context.Context, id int) error {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.CategoryExists, id, tenantScope(ctx))
+	defer closeFn()
+
+	var exists bool
+	if err := row.Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check category existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("category %d: %w", id, domain.ErrCategoryNotFound)
+	}
+	return fmt.Errorf("category %d was modified concurrently: %w", id, domain.ErrVersionConflict)
+}
+
+func (r *postgresCategoryRepository) Usage(ctx context.Context, id int) (domain.CategoryUsage, error) {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.CategoryUsage, id)
+	defer closeFn()
+
+	var usage domain.CategoryUsage
+	if err := row.Scan(&usage.Products, &usage.Children); err != nil {
+		return domain.CategoryUsage{}, fmt.Errorf("failed to get category usage: %w", err)
+	}
+	return usage, nil
+}
+
+func (r *postgresCategoryRepository) SubtreeDepth(ctx context.Context, category *domain.Category) (int, error) {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.CategorySubtreeDepth, category.Path, tenantScope(ctx))
+	defer closeFn()
+
+	var depth int
+	if err := row.Scan(&depth); err != nil {
+		return 0, fmt.Errorf("failed to get category subtree depth: %w", err)
+	}
+	return depth, nil
+}
+
+func (r *postgresCategoryRepository) SetProductCategories(ctx context.Context, productID int, categoryIDs []int) error {
+	ids := make([]int64, len(categoryIDs))
+	for i, id := range categoryIDs {
+		ids[i] = int64(id)
+	}
+
+	if _, err := r.executeExec(ctx, r.stm.SetProductCategories, productID, ids, ports.TenantFromContext(ctx)); err != nil {
+		return fmt.Errorf("failed to assign product categories: %w", translateCategoryError(err, domain.ErrCategoryNotFound))
+	}
+	return nil
+}
+
+func translateCategoryError(err error, foreignKeyErr error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return fmt.Errorf("%w: %s", domain.ErrCategorySlugConflict, pgErr.Detail)
+	case pgForeignKeyViolation:
+		return fmt.Errorf("%w: %s", foreignKeyErr, pgErr.Detail)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"time"
+)
+
+var _ ports.IdempotencyStore = (*postgresIdempotencyStore)(nil)
+
+type postgresIdempotencyStore struct {
+	db  *sql.DB
+	stm *PreparedStatements
+}
+
+func NewPostgresIdempotencyStore(db *sql.DB, stm *PreparedStatements) ports.IdempotencyStore {
+	return &postgresIdempotencyStore{
+		db:  db,
+		stm: stm,
+	}
+}
+
+func (s *postgresIdempotencyStore) Acquire(ctx context.Context, scope, key, requestHash string, lockTimeout, ttl time.Duration) (*domain.IdempotencyRecord, bool, error) {
+	record := &domain.IdempotencyRecord{Scope: scope, Key: key, RequestHash: requestHash}
+
+	err := s.stm.AcquireIdempotencyKey.QueryRowContext(ctx, scope, key, requestHash, lockTimeout.Seconds(), ttl.Seconds()).Scan(&record.ExpiresAt)
+	if err == nil {
+		return record, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, fmt.Errorf("failed to acquire idempotency key: %w", err)
+	}
+
+	existing := &domain.IdempotencyRecord{Scope: scope, Key: key}
+	var headers []byte
+	err = s.stm.GetIdempotencyKey.QueryRowContext(ctx, scope, key).Scan(
+		&existing.RequestHash,
+		&existing.StatusCode,
+		&existing.ContentType,
+		&headers,
+		&existing.ResponseBody,
+		&existing.ExpiresAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, domain.ErrIdempotencyKeyInProgress
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	if err := json.Unmarshal(headers, &existing.Headers); err != nil {
+		return nil, false, fmt.Errorf("failed to decode idempotent response headers: %w", err)
+	}
+
+	return existing, false, nil
+}
+
+func (s *postgresIdempotencyStore) Complete(ctx context.Context, scope, key string, statusCode int, contentType string, headers map[string]string, body []byte) error {
+	encodedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotent response headers: %w", err)
+	}
+
+	if _, err := s.stm.CompleteIdempotencyKey.ExecContext(ctx, scope, key, statusCode, contentType, string(encodedHeaders), body); err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (s *postgresIdempotencyStore) Retain(ctx context.Context, scope, key string) error {
+	if _, err := s.stm.RetainIdempotencyKey.ExecContext(ctx, scope, key); err != nil {
+		return fmt.Errorf("failed to retain idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (s *postgresIdempotencyStore) Release(ctx context.Context, scope, key string) error {
+	if _, err := s.stm.ReleaseIdempotencyKey.ExecContext(ctx, scope, key); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (s *postgresIdempotencyStore) PurgeExpired(ctx context.Context, expiredBefore time.Time, limit int) (int, error) {
+	result, err := s.stm.PurgeIdempotencyKeys.ExecContext(ctx, expiredBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"time"
+)
+
+var _ ports.InventoryRepository = (*postgresInventoryRepository)(nil)
+
+var _ ports.TransactionalRepository = (*postgresInventoryRepository)(nil)
+
+type postgresInventoryRepository struct {
+	db  *sql.DB
+	tx  *sql.Tx
+	stm *PreparedStatements
+}
+
+func NewPostgresInventoryRepository(db *sql.DB, stm *PreparedStatements) ports.InventoryRepository {
+	return &postgresInventoryRepository{
+		db:  db,
+		stm: stm,
+	}
+}
+
+func (r *postgresInventoryRepository) SetTransaction(tx *sql.Tx) {
+	r.tx = tx
+}
+
+func (r *postgresInventoryRepository) ClearTransaction() {
+	r.tx = nil
+}
+
+func (r *postgresInventoryRepository) executeQueryRow(ctx context.Context, stmt *sql.Stmt, args ...interface{}) (*sql.Row, func() error) {
+	if r.tx != nil {
+		txStmt := r.tx.StmtContext(ctx, stmt)
+		return txStmt.QueryRowContext(ctx, args...), txStmt.Close
+	}
+	return stmt.QueryRowContext(ctx, args...), func() error { return nil }
+}
+
+func (r *postgresInventoryRepository) executeExec(ctx context.Context, stmt *sql.Stmt, args ...interface{}) (sql.Result, error) {
+	if r.tx != nil {
+		txStmt := r.tx.StmtContext(ctx, stmt)
+		defer txStmt.Close()
+		return txStmt.ExecContext(ctx, args...)
+	}
+	return stmt.ExecContext(ctx, args...)
+}
+
+func (r *postgresInventoryRepository) Get(ctx context.Context, productID int) (*domain.Inventory, error) {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.GetInventory, productID)
+	defer closeFn()
+
+	inventory, err := scanInventory(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.NewInventory(productID), nil
+		}
+		return nil, fmt.Errorf("failed to get inventory: %w", err)
+	}
+	return inventory, nil
+}
+
+func (r *postgresInventoryRepository) GetForUpdate(ctx context.Context, productID int) (*domain.Inventory, error) {
+	if r.tx == nil {
+		return nil, fmt.Errorf("locking inventory requires a transaction")
+	}
+
+	if _, err := r.executeExec(ctx, r.stm.EnsureInventory, productID); err != nil {
+		return nil, fmt.Errorf("failed to initialize inventory: %w", err)
+	}
+
+	row, closeFn := r.executeQueryRow(ctx, r.stm.GetInventoryForUpdate, productID)
+	defer closeFn()
+
+	inventory, err := scanInventory(row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock inventory: %w", err)
+	}
+	return inventory, nil
+}
+
+func scanInventory(row rowScanner) (*domain.Inventory, error) {
+	inventory := domain.NewInventory(0)
+	if err := row.Scan(&inventory.ProductID, &inventory.OnHand, &inventory.Reserved, &inventory.Version, &inventory.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return inventory, nil
+}
+
+func (r *postgresInventoryRepository) Save(ctx context.Context, inventory *domain.Inventory) error {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.SaveInventory, inventory.OnHand, inventory.Reserved, inventory.ProductID, inventory.Version)
+	defer closeFn()
+
+	if err := row.Scan(&inventory.Version, &inventory.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("inventory of product %d was modified concurrently: %w", inventory.ProductID, domain.ErrVersionConflict)
+		}
+		return fmt.Errorf("failed to save inventory: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresInventoryRepository) CreateReservation(ctx context.Context, reservation *domain.Reservation) error {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.CreateReservation,
+		reservation.ProductID,
+		reservation.Quantity,
+		string(reservation.Status),
+		reservation.ExpiresAt,
+		ports.TenantFromContext(ctx),
+	)
+	defer closeFn()
+
+	if err := row.Scan(&reservation.ID, &reservation.CreatedAt); err != nil {
+		return fmt.Errorf("failed to create reservation: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresInventoryRepository) GetReservationForUpdate(ctx context.Context, id int64) (*domain.Reservation, error) {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.GetReservationForUpdate, id, tenantScope(ctx))
+	defer closeFn()
+
+	reservation, err := scanReservation(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("reservation %d: %w", id, domain.ErrReservationNotFound)
+		}
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+	return &reservation, nil
+}
+
+func (r *postgresInventoryRepository) UpdateReservationStatus(ctx context.Context, reservation *domain.Reservation) error {
+	result, err := r.executeExec(ctx, r.stm.UpdateReservationStatus, string(reservation.Status), reservation.ID, tenantScope(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to update reservation: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update reservation: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("reservation %d: %w", reservation.ID, domain.ErrReservationNotFound)
+	}
+	return nil
+}
+
+func (r *postgresInventoryRepository) ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]domain.Reservation, error) {
+	var rows *sql.Rows
+	var err error
+
+	if r.tx != nil {
+		txStmt := r.tx.StmtContext(ctx, r.stm.ListExpiredReservations)
+		defer txStmt.Close()
+		rows, err = txStmt.QueryContext(ctx, string(domain.ReservationStatusPending), now, limit, tenantScope(ctx))
+	} else {
+		rows, err = r.stm.ListExpiredReservations.QueryContext(ctx, string(domain.ReservationStatusPending), now, limit, tenantScope(ctx))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired reservations: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+		}
+	}()
+
+	reservations := make([]domain.Reservation, 0, limit)
+	for rows.Next() {
+		reservation, err := scanReservation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reservation: %w", err)
+		}
+		reservations = append(reservations, reservation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating expired reservations: %w", err)
+	}
+
+	return reservations, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanReservation(row rowScanner) (domain.Reservation, error) {
+	var reservation domain.Reservation
+	var status string
+
+	err := row.Scan(
+		&reservation.ID,
+		&reservation.ProductID,
+		&reservation.Quantity,
+		&status,
+		&reservation.ExpiresAt,
+		&reservation.CreatedAt,
+	)
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+
+	reservation.Status = domain.ReservationStatus(status)
+	return reservation, nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+)
+
+var _ ports.ProductHistoryRepository = (*postgresProductHistoryRepository)(nil)
+
+var _ ports.TransactionalRepository = (*postgresProductHistoryRepository)(nil)
+
+type postgresProductHistoryRepository struct {
+	db  *sql.DB
+	tx  *sql.Tx
+	stm *PreparedStatements
+}
+
+func NewPostgresProductHistoryRepository(db *sql.DB, stm *PreparedStatements) ports.ProductHistoryRepository {
+	return &postgresProductHistoryRepository{
+		db:  db,
+		stm: stm,
+	}
+}
+
+func (r *postgresProductHistoryRepository) SetTransaction(tx *sql.Tx) {
+	r.tx = tx
+}
+
+func (r *postgresProductHistoryRepository) ClearTransaction() {
+	r.tx = nil
+}
+
+func (r *postgresProductHistoryRepository) statement(ctx context.Context, stmt *sql.Stmt) (*sql.Stmt, func() error) {
+	if r.tx != nil {
+		txStmt := r.tx.StmtContext(ctx, stmt)
+		return txStmt, txStmt.Close
+	}
+	return stmt, func() error { return nil }
+}
+
+func (r *postgresProductHistoryRepository) Append(ctx context.Context, entries []domain.ProductHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	productIDs := make([]int64, len(entries))
+	actions := make([]string, len(entries))
+	changes := make([]string, len(entries))
+	actors := make([]string, len(entries))
+	requestIDs := make([]string, len(entries))
+	for i, entry := range entries {
+		data, err := json.Marshal(entry.Changes)
+		if err != nil {
+			return fmt.Errorf("failed to encode product history changes: %w", err)
+		}
+		productIDs[i] = int64(entry.ProductID)
+		actions[i] = entry.Action
+		changes[i] = string(data)
+		actors[i] = entry.Actor
+		requestIDs[i] = entry.RequestID
+	}
+
+	stmt, closeFn := r.statement(ctx, r.stm.AppendProductHistory)
+	defer closeFn()
+
+	if _, err := stmt.ExecContext(ctx, productIDs, actions, changes, actors, requestIDs); err != nil {
+		return fmt.Errorf("failed to append product history: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresProductHistoryRepository) ListByProduct(ctx context.Context, productID int, limit, offset int) ([]domain.ProductHistoryEntry, int, error) {
+	stmt, closeFn := r.statement(ctx, r.stm.ListProductHistory)
+	defer closeFn()
+
+	rows, err := stmt.QueryContext(ctx, productID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list product history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]domain.ProductHistoryEntry, 0, limit)
+	total := 0
+	for rows.Next() {
+		var entry domain.ProductHistoryEntry
+		var changes []byte
+		if err := rows.Scan(&entry.ID, &entry.ProductID, &entry.Action, &changes, &entry.Actor, &entry.RequestID, &entry.CreatedAt, &total); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan product history: %w", err)
+		}
+		if err := json.Unmarshal(changes, &entry.Changes); err != nil {
+			return nil, 0, fmt.Errorf("invalid product history changes: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating product history: %w", err)
+	}
+
+	return entries, total, nil
+}
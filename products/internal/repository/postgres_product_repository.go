@@ -1,12 +1,18 @@
 package repository
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"product_service/products/internal/domain"
 	"product_service/products/internal/usecase/ports"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
 )
 
 var _ ports.ProductRepository = (*postgresProductRepository)(nil)
@@ -45,38 +51,175 @@ func (r *postgresProductRepository) ClearTransaction() {
 var _ ports.TransactionalRepository = (*postgresProductRepository)(nil)
 
 func (r *postgresProductRepository) Create(ctx context.Context, product *domain.Product) error {
+	currencies, amounts := priceColumns(product)
+	attributes, err := attributesColumn(product.Attributes)
+	if err != nil {
+		return fmt.Errorf("failed to create product: %w", err)
+	}
+
 	var row *sql.Row
 
 	if r.tx != nil {
 		txStmt := r.tx.StmtContext(ctx, r.stm.CreateProduct)
 		defer txStmt.Close()
-		row = txStmt.QueryRowContext(ctx, product.Name.Value(), product.Price.Value())
+		row = txStmt.QueryRowContext(ctx, product.Name.Value(), product.Price.Amount(), product.Price.Currency(), currencies, amounts, attributes, product.ParentID, productStatus(product), ports.TenantFromContext(ctx))
 	} else {
-		row = r.stm.CreateProduct.QueryRowContext(ctx, product.Name.Value(), product.Price.Value())
+		row = r.stm.CreateProduct.QueryRowContext(ctx, product.Name.Value(), product.Price.Amount(), product.Price.Currency(), currencies, amounts, attributes, product.ParentID, productStatus(product), ports.TenantFromContext(ctx))
 	}
 
-	err := row.Scan(&product.ID, &product.CreatedAt)
+	err = row.Scan(&product.ID, &product.Version, &product.CreatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to create product: %w", err)
 	}
 	return nil
 }
 
-func (r *postgresProductRepository) GetByID(ctx context.Context, id int) (*domain.Product, error) {
+func (r *postgresProductRepository) CreateBatch(ctx context.Context, products []*domain.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids, err := r.reserveProductIDs(ctx, len(products))
+	if err != nil {
+		return fmt.Errorf("failed to create products: %w", err)
+	}
+
+	const paramsPerProduct = 7
+	tenant := ports.TenantFromContext(ctx)
+	args := make([]interface{}, 0, len(products)*paramsPerProduct+3)
+	var priceProductIDs []int64
+	var priceCurrencies, priceAmounts []string
+
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(queryCreateProductsBatch)
+
+	argIndex := 4
+	for i, product := range products {
+		attributes, err := attributesColumn(product.Attributes)
+		if err != nil {
+			return fmt.Errorf("failed to create products: %w", err)
+		}
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString("($")
+		writeInt(&queryBuilder, argIndex)
+		queryBuilder.WriteString(", $")
+		writeInt(&queryBuilder, argIndex+1)
+		queryBuilder.WriteString(", $")
+		writeInt(&queryBuilder, argIndex+2)
+		queryBuilder.WriteString(", $")
+		writeInt(&queryBuilder, argIndex+3)
+		queryBuilder.WriteString(", $")
+		writeInt(&queryBuilder, argIndex+4)
+		queryBuilder.WriteString("::jsonb, $")
+		writeInt(&queryBuilder, argIndex+5)
+		queryBuilder.WriteString(", $")
+		writeInt(&queryBuilder, argIndex+6)
+		queryBuilder.WriteString(", NOW())")
+
+		args = append(args, ids[i], product.Name.Value(), product.Price.Amount(), product.Price.Currency(), attributes, productStatus(product), tenant)
+		argIndex += paramsPerProduct
+
+		currencies, amounts := priceColumns(product)
+		for range currencies {
+			priceProductIDs = append(priceProductIDs, ids[i])
+		}
+		priceCurrencies = append(priceCurrencies, currencies...)
+		priceAmounts = append(priceAmounts, amounts...)
+	}
+	queryBuilder.WriteString(queryCreateProductsBatchPrices)
+
+	args = append([]interface{}{priceProductIDs, priceCurrencies, priceAmounts}, args...)
+
+	rows, err := r.getQueryExecutor().QueryContext(ctx, queryBuilder.String(), args...)
+	if err != nil {
+		return fmt.Errorf("failed to create products: %w", err)
+	}
+	defer rows.Close()
+
+	byID := make(map[int64]*domain.Product, len(products))
+	for i, product := range products {
+		byID[ids[i]] = product
+	}
+
+	created := 0
+	for rows.Next() {
+		var id int64
+		var version int
+		var createdAt time.Time
+		if err := rows.Scan(&id, &version, &createdAt); err != nil {
+			return fmt.Errorf("failed to scan created product: %w", err)
+		}
+		product, ok := byID[id]
+		if !ok {
+			return fmt.Errorf("unexpected product id %d returned from batch insert", id)
+		}
+		product.ID = int(id)
+		product.Version = version
+		product.CreatedAt = createdAt
+		created++
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to create products: %w", err)
+	}
+	if created != len(products) {
+		return fmt.Errorf("batch insert created %d of %d products", created, len(products))
+	}
+
+	return nil
+}
+
+func (r *postgresProductRepository) reserveProductIDs(ctx context.Context, count int) ([]int64, error) {
+	stmt := r.stm.ReserveProductIDs
+	if r.tx != nil {
+		stmt = r.tx.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+
+	rows, err := stmt.QueryContext(ctx, count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve product ids: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0, count)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan reserved product id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to reserve product ids: %w", err)
+	}
+	if len(ids) != count {
+		return nil, fmt.Errorf("reserved %d of %d product ids", len(ids), count)
+	}
+	return ids, nil
+}
+
+func (r *postgresProductRepository) GetByID(ctx context.Context, id int, includeDeleted bool) (*domain.Product, error) {
 	product := &domain.Product{}
 	var name string
-	var price float64
+	var price, currency, status string
+	var deletedAt sql.NullTime
+	var prices []byte
+	var parentID sql.NullInt64
+	var attributes, parentAttributes []byte
 
 	var row *sql.Row
 	if r.tx != nil {
 		txStmt := r.tx.StmtContext(ctx, r.stm.GetProductByID)
 		defer txStmt.Close()
-		row = txStmt.QueryRowContext(ctx, id)
+		row = txStmt.QueryRowContext(ctx, id, includeDeleted, tenantScope(ctx))
 	} else {
-		row = r.stm.GetProductByID.QueryRowContext(ctx, id)
+		row = r.stm.GetProductByID.QueryRowContext(ctx, id, includeDeleted, tenantScope(ctx))
 	}
 
-	err := row.Scan(&product.ID, &name, &price, &product.CreatedAt)
+	err := row.Scan(&product.ID, &name, &price, &currency, &status, &product.Version, &product.CreatedAt, &deletedAt, &prices, &parentID, &attributes, &parentAttributes)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
@@ -90,22 +233,113 @@ func (r *postgresProductRepository) GetByID(ctx context.Context, id int) (*domai
 	}
 	product.Name = productName
 
-	productPrice, err := domain.NewPrice(price)
+	productPrice, err := domain.ParseMoney(price, currency)
 	if err != nil {
 		return nil, fmt.Errorf("invalid product data: %w", err)
 	}
 	product.Price = productPrice
 
+	if err := applyProductPrices(product, prices); err != nil {
+		return nil, err
+	}
+
+	if err := applyProductAttributes(product, parentID, attributes, parentAttributes); err != nil {
+		return nil, err
+	}
+
+	if product.Status, err = domain.ParseProductStatus(status); err != nil {
+		return nil, fmt.Errorf("invalid product data: %w", err)
+	}
+
+	if deletedAt.Valid {
+		product.DeletedAt = &deletedAt.Time
+	}
+
 	return product, nil
 }
 
-func (r *postgresProductRepository) executeQuery(ctx context.Context, query string, args ...interface{}) (*sql.Rows, func() error, error) {
+func (r *postgresProductRepository) GetByIDs(ctx context.Context, ids []int, includeDeleted bool) ([]domain.Product, error) {
+	if len(ids) == 0 {
+		return []domain.Product{}, nil
+	}
+
+	values := make([]int64, len(ids))
+	for i, id := range ids {
+		values[i] = int64(id)
+	}
+
+	rows, closeFn, err := r.executeQuery(ctx, r.stm.GetProductsByIDs, values, includeDeleted, tenantScope(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	defer func() {
+		if err := closeFn(); err != nil {
+		}
+		if err := rows.Close(); err != nil {
+		}
+	}()
+
+	products := make([]domain.Product, 0, len(ids))
+	for rows.Next() {
+		product, err := scanListedProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate products: %w", err)
+	}
+
+	return products, nil
+}
+
+func (r *postgresProductRepository) List(ctx context.Context, query ports.ProductListQuery) (ports.ProductListResult, error) {
+	sqlQuery, args := buildProductListQuery(query, tenantScope(ctx))
+
+	rows, err := r.getQueryExecutor().QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return ports.ProductListResult{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+		}
+	}()
+
+	products := make([]domain.Product, 0, query.Limit+1)
+	var total int
+
+	for rows.Next() {
+		var extra []interface{}
+		if !query.IsCursorMode() {
+			extra = append(extra, &total)
+		}
+
+		product, err := scanListedProduct(rows, extra...)
+		if err != nil {
+			return ports.ProductListResult{}, err
+		}
+		products = append(products, product)
+	}
+
+	if err := rows.Err(); err != nil {
+		return ports.ProductListResult{}, fmt.Errorf("failed to iterate products: %w", err)
+	}
+
+	if query.IsCursorMode() {
+		return paginateByCursor(query, products), nil
+	}
+	return paginateByPage(query, products, total), nil
+}
+
+func (r *postgresProductRepository) executeQuery(ctx context.Context, stmt *sql.Stmt, args ...interface{}) (*sql.Rows, func() error, error) {
 	var rows *sql.Rows
 	var closeFn func() error = func() error { return nil }
 	var err error
 
 	if r.tx != nil {
-		txStmt := r.tx.StmtContext(ctx, r.stm.ListProducts)
+		txStmt := r.tx.StmtContext(ctx, stmt)
 		rows, err = txStmt.QueryContext(ctx, args...)
 		if err != nil {
 			txStmt.Close()
@@ -113,7 +347,7 @@ func (r *postgresProductRepository) executeQuery(ctx context.Context, query stri
 		}
 		closeFn = txStmt.Close
 	} else {
-		rows, err = r.stm.ListProducts.QueryContext(ctx, args...)
+		rows, err = stmt.QueryContext(ctx, args...)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to execute query: %w", err)
 		}
@@ -122,12 +356,28 @@ func (r *postgresProductRepository) executeQuery(ctx context.Context, query stri
 	return rows, closeFn, nil
 }
 
-func (r *postgresProductRepository) List(ctx context.Context, page, limit int) ([]domain.Product, int, error) {
-	offset := (page - 1) * limit
+func (r *postgresProductRepository) Search(ctx context.Context, query ports.ProductSearchQuery) (ports.ProductSearchResult, error) {
+	if tsQuery := buildPrefixTSQuery(query.Text); tsQuery != "" {
+		hits, err := r.searchWith(ctx, r.stm.SearchProducts, tsQuery, query.Limit)
+		if err != nil {
+			return ports.ProductSearchResult{}, err
+		}
+		if len(hits) > 0 {
+			return ports.ProductSearchResult{Hits: hits}, nil
+		}
+	}
+
+	hits, err := r.searchWith(ctx, r.stm.SearchFuzzy, query.Text, query.Limit)
+	if err != nil {
+		return ports.ProductSearchResult{}, err
+	}
+	return ports.ProductSearchResult{Hits: hits, Fuzzy: true}, nil
+}
 
-	rows, closeFn, err := r.executeQuery(ctx, queryListProducts, limit, offset)
+func (r *postgresProductRepository) searchWith(ctx context.Context, stmt *sql.Stmt, text string, limit int) ([]ports.ProductSearchHit, error) {
+	rows, closeFn, err := r.executeQuery(ctx, stmt, text, limit, tenantScope(ctx))
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer func() {
 		if err := closeFn(); err != nil {
@@ -136,47 +386,255 @@ func (r *postgresProductRepository) List(ctx context.Context, page, limit int) (
 		}
 	}()
 
-	products := make([]domain.Product, 0, limit)
-	var total int
-
+	hits := make([]ports.ProductSearchHit, 0, limit)
 	for rows.Next() {
-		var product domain.Product
-		var name string
-		var price float64
-
-		if err := rows.Scan(&product.ID, &name, &price, &product.CreatedAt, &total); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan product: %w", err)
+		var hit ports.ProductSearchHit
+		product, err := scanListedProduct(rows, &hit.Rank, &hit.Highlight)
+		if err != nil {
+			return nil, err
 		}
+		hit.Product = product
+		hits = append(hits, hit)
+	}
 
-		productName, err := domain.NewProductName(name)
-		if err != nil {
-			return nil, 0, fmt.Errorf("invalid product data: %w", err)
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate search results: %w", err)
+	}
+
+	return hits, nil
+}
+
+func buildPrefixTSQuery(text string) string {
+	words := strings.FieldsFunc(text, func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, strings.ToLower(word)+":*")
+	}
+	return strings.Join(terms, " & ")
+}
+
+func paginateByPage(query ports.ProductListQuery, products []domain.Product, total int) ports.ProductListResult {
+	result := ports.ProductListResult{
+		Products: products,
+		Total:    total,
+	}
+	if len(products) == 0 {
+		return result
+	}
+
+	offset := (query.Page - 1) * query.Limit
+	if offset+len(products) < total {
+		result.NextCursor = cursorFor(&products[len(products)-1], query.Sort, query.Filter.PriceCurrency(), ports.CursorNext)
+	}
+	if query.Page > 1 {
+		result.PrevCursor = cursorFor(&products[0], query.Sort, query.Filter.PriceCurrency(), ports.CursorPrev)
+	}
+
+	return result
+}
+
+func paginateByCursor(query ports.ProductListQuery, products []domain.Product) ports.ProductListResult {
+	hasMore := len(products) > query.Limit
+	if hasMore {
+		products = products[:query.Limit]
+	}
+
+	result := ports.ProductListResult{Products: products}
+	if len(products) == 0 {
+		return result
+	}
+
+	if query.Cursor.Direction == ports.CursorPrev {
+		slices.Reverse(products)
+		if hasMore {
+			result.PrevCursor = cursorFor(&products[0], query.Sort, query.Filter.PriceCurrency(), ports.CursorPrev)
 		}
-		product.Name = productName
+		result.NextCursor = cursorFor(&products[len(products)-1], query.Sort, query.Filter.PriceCurrency(), ports.CursorNext)
+		return result
+	}
+
+	if hasMore {
+		result.NextCursor = cursorFor(&products[len(products)-1], query.Sort, query.Filter.PriceCurrency(), ports.CursorNext)
+	}
+	result.PrevCursor = cursorFor(&products[0], query.Sort, query.Filter.PriceCurrency(), ports.CursorPrev)
+	return result
+}
+
+func scanListedProduct(rows *sql.Rows, extra ...interface{}) (domain.Product, error) {
+	var product domain.Product
+	var name string
+	var price, currency, status string
+	var deletedAt sql.NullTime
+	var prices []byte
+	var parentID sql.NullInt64
+	var attributes, parentAttributes []byte
+
+	dest := append([]interface{}{&product.ID, &name, &price, &currency, &status, &product.Version, &product.CreatedAt, &deletedAt, &prices, &parentID, &attributes, &parentAttributes}, extra...)
+	if err := rows.Scan(dest...); err != nil {
+		return domain.Product{}, fmt.Errorf("failed to scan product: %w", err)
+	}
+
+	productName, err := domain.NewProductName(name)
+	if err != nil {
+		return domain.Product{}, fmt.Errorf("invalid product data: %w", err)
+	}
+	product.Name = productName
+
+	productPrice, err := domain.ParseMoney(price, currency)
+	if err != nil {
+		return domain.Product{}, fmt.Errorf("invalid product data: %w", err)
+	}
+	product.Price = productPrice
+
+	if err := applyProductPrices(&product, prices); err != nil {
+		return domain.Product{}, err
+	}
+
+	if err := applyProductAttributes(&product, parentID, attributes, parentAttributes); err != nil {
+		return domain.Product{}, err
+	}
+
+	if product.Status, err = domain.ParseProductStatus(status); err != nil {
+		return domain.Product{}, fmt.Errorf("invalid product data: %w", err)
+	}
+
+	if deletedAt.Valid {
+		product.DeletedAt = &deletedAt.Time
+	}
 
-		productPrice, err := domain.NewPrice(price)
+	return product, nil
+}
+
+func priceColumns(product *domain.Product) ([]string, []string) {
+	priceAmounts := product.PriceAmounts()
+	currencies := make([]string, 0, len(priceAmounts))
+	for currency := range priceAmounts {
+		currencies = append(currencies, currency)
+	}
+	slices.Sort(currencies)
+
+	amounts := make([]string, len(currencies))
+	for i, currency := range currencies {
+		amounts[i] = priceAmounts[currency]
+	}
+	return currencies, amounts
+}
+
+func applyProductPrices(product *domain.Product, data []byte) error {
+	product.Prices = map[string]domain.Money{product.Price.Currency(): product.Price}
+	if len(data) == 0 {
+		return nil
+	}
+
+	var amounts map[string]json.Number
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&amounts); err != nil {
+		return fmt.Errorf("invalid product prices: %w", err)
+	}
+
+	for currency, amount := range amounts {
+		price, err := domain.ParseMoney(amount.String(), currency)
 		if err != nil {
-			return nil, 0, fmt.Errorf("invalid product data: %w", err)
+			return fmt.Errorf("invalid product prices: %w", err)
+		}
+		if price.Currency() == product.Price.Currency() {
+			continue
 		}
-		product.Price = productPrice
+		product.Prices[price.Currency()] = price
+	}
 
-		products = append(products, product)
+	return nil
+}
+
+func productStatus(product *domain.Product) string {
+	if product.Status == "" {
+		return domain.ProductStatusDraft.String()
 	}
+	return product.Status.String()
+}
 
-	if err := rows.Err(); err != nil {
-		return nil, 0, fmt.Errorf("failed to iterate products: %w", err)
+func tenantScope(ctx context.Context) string {
+	if ports.IsAllTenants(ctx) {
+		return ""
+	}
+	return ports.TenantFromContext(ctx)
+}
+
+func attributesColumn(attributes domain.Attributes) (string, error) {
+	if len(attributes) == 0 {
+		return "{}", nil
+	}
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return "", fmt.Errorf("invalid product attributes: %w", err)
+	}
+	return string(data), nil
+}
+
+func applyProductAttributes(product *domain.Product, parentID sql.NullInt64, own, inherited []byte) error {
+	if parentID.Valid {
+		id := int(parentID.Int64)
+		product.ParentID = &id
+	}
+
+	var err error
+	if product.Attributes, err = decodeAttributes(own); err != nil {
+		return err
+	}
+	if product.InheritedAttributes, err = decodeAttributes(inherited); err != nil {
+		return err
+	}
+	return nil
+}
+
+func decodeAttributes(data []byte) (domain.Attributes, error) {
+	if len(data) == 0 {
+		return nil, nil
 	}
 
-	return products, total, nil
+	var values map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return nil, fmt.Errorf("invalid product attributes: %w", err)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	attributes, err := domain.NewAttributes(values)
+	if err != nil {
+		return nil, fmt.Errorf("invalid product attributes: %w", err)
+	}
+	return attributes, nil
+}
+
+func cursorFor(product *domain.Product, sort ports.ProductSort, currency string, direction ports.CursorDirection) *ports.ProductCursor {
+	price, ok := product.PriceIn(currency)
+	if !ok {
+		price = product.Price
+	}
+	return &ports.ProductCursor{
+		Sort:      sort,
+		CreatedAt: product.CreatedAt,
+		Price:     price,
+		Name:      product.Name.Value(),
+		ID:        product.ID,
+		Direction: direction,
+	}
 }
 
-func (r *postgresProductRepository) executeExec(ctx context.Context, args ...interface{}) (sql.Result, func() error, error) {
+func (r *postgresProductRepository) executeExec(ctx context.Context, stmt *sql.Stmt, args ...interface{}) (sql.Result, func() error, error) {
 	var result sql.Result
 	var closeFn func() error = func() error { return nil }
 	var err error
 
 	if r.tx != nil {
-		txStmt := r.tx.StmtContext(ctx, r.stm.DeleteProduct)
+		txStmt := r.tx.StmtContext(ctx, stmt)
 		result, err = txStmt.ExecContext(ctx, args...)
 		if err != nil {
 			txStmt.Close()
@@ -184,7 +642,7 @@ func (r *postgresProductRepository) executeExec(ctx context.Context, args ...int
 		}
 		closeFn = txStmt.Close
 	} else {
-		result, err = r.stm.DeleteProduct.ExecContext(ctx, args...)
+		result, err = stmt.ExecContext(ctx, args...)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to execute query: %w", err)
 		}
@@ -193,8 +651,53 @@ func (r *postgresProductRepository) executeExec(ctx context.Context, args ...int
 	return result, closeFn, nil
 }
 
-func (r *postgresProductRepository) Delete(ctx context.Context, id int) error {
-	result, closeFn, err := r.executeExec(ctx, id)
+func (r *postgresProductRepository) executeQueryRow(ctx context.Context, stmt *sql.Stmt, args ...interface{}) (*sql.Row, func() error) {
+	if r.tx != nil {
+		txStmt := r.tx.StmtContext(ctx, stmt)
+		return txStmt.QueryRowContext(ctx, args...), txStmt.Close
+	}
+	return stmt.QueryRowContext(ctx, args...), func() error { return nil }
+}
+
+func (r *postgresProductRepository) Update(ctx context.Context, product *domain.Product) error {
+	currencies, amounts := priceColumns(product)
+	attributes, err := attributesColumn(product.Attributes)
+	if err != nil {
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+
+	row, closeFn := r.executeQueryRow(ctx, r.stm.UpdateProduct, product.Name.Value(), product.Price.Amount(), product.Price.Currency(), product.ID, product.Version, attributes, productStatus(product), tenantScope(ctx), currencies, amounts)
+	defer closeFn()
+
+	var newVersion int
+	if err := row.Scan(&newVersion); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return r.missingOrConflict(ctx, product.ID)
+		}
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+
+	product.Version = newVersion
+	return nil
+}
+
+func (r *postgresProductRepository) missingOrConflict(ctx context.Context, id int) error {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.ProductExists, id, tenantScope(ctx))
+	defer closeFn()
+
+	var exists bool
+	if err := row.Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check product existence: %w", err)
+	}
+
+	if !exists {
+		return fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+	}
+	return fmt.Errorf("product %d was modified concurrently: %w", id, domain.ErrVersionConflict)
+}
+
+func (r *postgresProductRepository) Delete(ctx context.Context, id int, version int) error {
+	result, closeFn, err := r.executeExec(ctx, r.stm.DeleteProduct, id, version, tenantScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -206,8 +709,57 @@ func (r *postgresProductRepository) Delete(ctx context.Context, id int) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+		variants, err := r.CountActiveVariants(ctx, id)
+		if err != nil {
+			return err
+		}
+		if variants > 0 {
+			return fmt.Errorf("product %d still has %d variants: %w", id, variants, domain.ErrProductHasVariants)
+		}
+		return r.missingOrConflict(ctx, id)
 	}
 
 	return nil
 }
+
+func (r *postgresProductRepository) CountActiveVariants(ctx context.Context, parentID int) (int, error) {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.CountVariants, parentID, tenantScope(ctx))
+	defer closeFn()
+
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count product variants: %w", err)
+	}
+	return count, nil
+}
+
+func (r *postgresProductRepository) Restore(ctx context.Context, product *domain.Product) error {
+	row, closeFn := r.executeQueryRow(ctx, r.stm.RestoreProduct, product.ID, product.Version, tenantScope(ctx))
+	defer closeFn()
+
+	var newVersion int
+	if err := row.Scan(&newVersion); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return r.missingOrConflict(ctx, product.ID)
+		}
+		return fmt.Errorf("failed to restore product: %w", err)
+	}
+
+	product.Version = newVersion
+	return nil
+}
+
+func (r *postgresProductRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) (int, error) {
+	result, closeFn, err := r.executeExec(ctx, r.stm.PurgeProducts, deletedBefore, limit, tenantScope(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer closeFn()
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted products: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
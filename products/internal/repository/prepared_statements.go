@@ -10,8 +10,57 @@ import (
 type PreparedStatements struct {
 	CreateProduct    *sql.Stmt
 	GetProductByID   *sql.Stmt
-	ListProducts     *sql.Stmt
+	GetProductsByIDs *sql.Stmt
+	ProductExists    *sql.Stmt
+	SearchProducts   *sql.Stmt
+	SearchFuzzy      *sql.Stmt
+	UpdateProduct    *sql.Stmt
 	DeleteProduct    *sql.Stmt
+	CountVariants    *sql.Stmt
+	RestoreProduct   *sql.Stmt
+	PurgeProducts    *sql.Stmt
+
+	ReserveProductIDs *sql.Stmt
+
+	EnsureInventory         *sql.Stmt
+	GetInventory            *sql.Stmt
+	GetInventoryForUpdate   *sql.Stmt
+	SaveInventory           *sql.Stmt
+	CreateReservation       *sql.Stmt
+	GetReservationForUpdate *sql.Stmt
+	UpdateReservationStatus *sql.Stmt
+	ListExpiredReservations *sql.Stmt
+
+	CreateCategory          *sql.Stmt
+	GetCategoryByID         *sql.Stmt
+	CategoryExists          *sql.Stmt
+	ListCategories          *sql.Stmt
+	UpdateCategory          *sql.Stmt
+	LockCategoriesForMove   *sql.Stmt
+	MoveCategory            *sql.Stmt
+	MoveCategoryDescendants *sql.Stmt
+	DeleteCategory          *sql.Stmt
+	CategoryUsage           *sql.Stmt
+	CategorySubtreeDepth    *sql.Stmt
+	ListProductCategories   *sql.Stmt
+	SetProductCategories    *sql.Stmt
+
+	AppendProductHistory *sql.Stmt
+	ListProductHistory   *sql.Stmt
+
+	AcquireIdempotencyKey  *sql.Stmt
+	GetIdempotencyKey      *sql.Stmt
+	CompleteIdempotencyKey *sql.Stmt
+	RetainIdempotencyKey   *sql.Stmt
+	ReleaseIdempotencyKey  *sql.Stmt
+	PurgeIdempotencyKeys   *sql.Stmt
+
+	CreateAPIKey       *sql.Stmt
+	GetAPIKeyByHash    *sql.Stmt
+	ListAPIKeys        *sql.Stmt
+	UpdateAPIKeyScopes *sql.Stmt
+	RevokeAPIKey       *sql.Stmt
+	TouchAPIKey        *sql.Stmt
 
 	SaveOutboxEvent       *sql.Stmt
 	GetPendingEvents      *sql.Stmt
@@ -31,7 +80,27 @@ func PrepareProductStatements(ctx context.Context, db *sql.DB) (*PreparedStateme
 		return nil, err
 	}
 
-	listProducts, err := db.PrepareContext(ctx, queryListProducts)
+	getProductsByIDs, err := db.PrepareContext(ctx, queryGetProductsByIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	productExists, err := db.PrepareContext(ctx, queryProductExists)
+	if err != nil {
+		return nil, err
+	}
+
+	searchProducts, err := db.PrepareContext(ctx, querySearchProducts)
+	if err != nil {
+		return nil, err
+	}
+
+	searchFuzzy, err := db.PrepareContext(ctx, querySearchProductsFuzzy)
+	if err != nil {
+		return nil, err
+	}
+
+	updateProduct, err := db.PrepareContext(ctx, queryUpdateProduct)
 	if err != nil {
 		return nil, err
 	}
@@ -41,11 +110,255 @@ func PrepareProductStatements(ctx context.Context, db *sql.DB) (*PreparedStateme
 		return nil, err
 	}
 
+	countVariants, err := db.PrepareContext(ctx, queryCountActiveVariants)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreProduct, err := db.PrepareContext(ctx, queryRestoreProduct)
+	if err != nil {
+		return nil, err
+	}
+
+	purgeProducts, err := db.PrepareContext(ctx, queryPurgeDeletedProducts)
+	if err != nil {
+		return nil, err
+	}
+
+	reserveProductIDs, err := db.PrepareContext(ctx, queryReserveProductIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ensureInventory, err := db.PrepareContext(ctx, queryEnsureInventory)
+	if err != nil {
+		return nil, err
+	}
+
+	getInventory, err := db.PrepareContext(ctx, queryGetInventory)
+	if err != nil {
+		return nil, err
+	}
+
+	getInventoryForUpdate, err := db.PrepareContext(ctx, queryGetInventoryForUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	saveInventory, err := db.PrepareContext(ctx, querySaveInventory)
+	if err != nil {
+		return nil, err
+	}
+
+	createReservation, err := db.PrepareContext(ctx, queryCreateReservation)
+	if err != nil {
+		return nil, err
+	}
+
+	getReservationForUpdate, err := db.PrepareContext(ctx, queryGetReservationForUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	updateReservationStatus, err := db.PrepareContext(ctx, queryUpdateReservationStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	listExpiredReservations, err := db.PrepareContext(ctx, queryListExpiredReservations)
+	if err != nil {
+		return nil, err
+	}
+
+	createCategory, err := db.PrepareContext(ctx, queryCreateCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	getCategoryByID, err := db.PrepareContext(ctx, queryGetCategoryByID)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryExists, err := db.PrepareContext(ctx, queryCategoryExists)
+	if err != nil {
+		return nil, err
+	}
+
+	listCategories, err := db.PrepareContext(ctx, queryListCategories)
+	if err != nil {
+		return nil, err
+	}
+
+	updateCategory, err := db.PrepareContext(ctx, queryUpdateCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	lockCategoriesForMove, err := db.PrepareContext(ctx, queryLockCategoriesForMove)
+	if err != nil {
+		return nil, err
+	}
+
+	moveCategory, err := db.PrepareContext(ctx, queryMoveCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	moveCategoryDescendants, err := db.PrepareContext(ctx, queryMoveCategoryDescendants)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteCategory, err := db.PrepareContext(ctx, queryDeleteCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryUsage, err := db.PrepareContext(ctx, queryCategoryUsage)
+	if err != nil {
+		return nil, err
+	}
+
+	categorySubtreeDepth, err := db.PrepareContext(ctx, queryCategorySubtreeDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	listProductCategories, err := db.PrepareContext(ctx, queryListProductCategories)
+	if err != nil {
+		return nil, err
+	}
+
+	setProductCategories, err := db.PrepareContext(ctx, querySetProductCategories)
+	if err != nil {
+		return nil, err
+	}
+
+	appendProductHistory, err := db.PrepareContext(ctx, queryAppendProductHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	listProductHistory, err := db.PrepareContext(ctx, queryListProductHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	acquireIdempotencyKey, err := db.PrepareContext(ctx, queryAcquireIdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	getIdempotencyKey, err := db.PrepareContext(ctx, queryGetIdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	completeIdempotencyKey, err := db.PrepareContext(ctx, queryCompleteIdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	retainIdempotencyKey, err := db.PrepareContext(ctx, queryRetainIdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseIdempotencyKey, err := db.PrepareContext(ctx, queryReleaseIdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	purgeIdempotencyKeys, err := db.PrepareContext(ctx, queryPurgeIdempotencyKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	createAPIKey, err := db.PrepareContext(ctx, queryCreateAPIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	getAPIKeyByHash, err := db.PrepareContext(ctx, queryGetAPIKeyByHash)
+	if err != nil {
+		return nil, err
+	}
+
+	listAPIKeys, err := db.PrepareContext(ctx, queryListAPIKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	updateAPIKeyScopes, err := db.PrepareContext(ctx, queryUpdateAPIKeyScopes)
+	if err != nil {
+		return nil, err
+	}
+
+	revokeAPIKey, err := db.PrepareContext(ctx, queryRevokeAPIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	touchAPIKey, err := db.PrepareContext(ctx, queryTouchAPIKey)
+	if err != nil {
+		return nil, err
+	}
+
 	return &PreparedStatements{
 		CreateProduct:  createProduct,
 		GetProductByID: getProductByID,
-		ListProducts:   listProducts,
+		GetProductsByIDs: getProductsByIDs,
+		ProductExists:  productExists,
+		SearchProducts: searchProducts,
+		SearchFuzzy:    searchFuzzy,
+		UpdateProduct:  updateProduct,
 		DeleteProduct:  deleteProduct,
+		CountVariants:  countVariants,
+		RestoreProduct: restoreProduct,
+		PurgeProducts:  purgeProducts,
+
+		ReserveProductIDs: reserveProductIDs,
+
+		EnsureInventory:         ensureInventory,
+		GetInventory:            getInventory,
+		GetInventoryForUpdate:   getInventoryForUpdate,
+		SaveInventory:           saveInventory,
+		CreateReservation:       createReservation,
+		GetReservationForUpdate: getReservationForUpdate,
+		UpdateReservationStatus: updateReservationStatus,
+		ListExpiredReservations: listExpiredReservations,
+
+		CreateCategory:          createCategory,
+		GetCategoryByID:         getCategoryByID,
+		CategoryExists:          categoryExists,
+		ListCategories:          listCategories,
+		UpdateCategory:          updateCategory,
+		LockCategoriesForMove:   lockCategoriesForMove,
+		MoveCategory:            moveCategory,
+		MoveCategoryDescendants: moveCategoryDescendants,
+		DeleteCategory:          deleteCategory,
+		CategoryUsage:           categoryUsage,
+		CategorySubtreeDepth:    categorySubtreeDepth,
+		ListProductCategories:   listProductCategories,
+		SetProductCategories:    setProductCategories,
+
+		AppendProductHistory: appendProductHistory,
+		ListProductHistory:   listProductHistory,
+
+		AcquireIdempotencyKey:  acquireIdempotencyKey,
+		GetIdempotencyKey:      getIdempotencyKey,
+		CompleteIdempotencyKey: completeIdempotencyKey,
+		RetainIdempotencyKey:   retainIdempotencyKey,
+		ReleaseIdempotencyKey:  releaseIdempotencyKey,
+		PurgeIdempotencyKeys:   purgeIdempotencyKeys,
+
+		CreateAPIKey:       createAPIKey,
+		GetAPIKeyByHash:    getAPIKeyByHash,
+		ListAPIKeys:        listAPIKeys,
+		UpdateAPIKeyScopes: updateAPIKeyScopes,
+		RevokeAPIKey:       revokeAPIKey,
+		TouchAPIKey:        touchAPIKey,
 	}, nil
 }
 
@@ -96,9 +409,29 @@ func (ps *PreparedStatements) Close() error {
 			errs = append(errs, fmt.Errorf("GetProductByID: %w", e))
 		}
 	}
-	if ps.ListProducts != nil {
-		if e := ps.ListProducts.Close(); e != nil {
-			errs = append(errs, fmt.Errorf("ListProducts: %w", e))
+	if ps.GetProductsByIDs != nil {
+		if e := ps.GetProductsByIDs.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("GetProductsByIDs: %w", e))
+		}
+	}
+	if ps.ProductExists != nil {
+		if e := ps.ProductExists.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("ProductExists: %w", e))
+		}
+	}
+	if ps.SearchProducts != nil {
+		if e := ps.SearchProducts.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("SearchProducts: %w", e))
+		}
+	}
+	if ps.SearchFuzzy != nil {
+		if e := ps.SearchFuzzy.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("SearchFuzzy: %w", e))
+		}
+	}
+	if ps.UpdateProduct != nil {
+		if e := ps.UpdateProduct.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("UpdateProduct: %w", e))
 		}
 	}
 	if ps.DeleteProduct != nil {
@@ -106,6 +439,201 @@ func (ps *PreparedStatements) Close() error {
 			errs = append(errs, fmt.Errorf("DeleteProduct: %w", e))
 		}
 	}
+	if ps.CountVariants != nil {
+		if e := ps.CountVariants.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("CountVariants: %w", e))
+		}
+	}
+	if ps.RestoreProduct != nil {
+		if e := ps.RestoreProduct.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("RestoreProduct: %w", e))
+		}
+	}
+	if ps.PurgeProducts != nil {
+		if e := ps.PurgeProducts.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("PurgeProducts: %w", e))
+		}
+	}
+	if ps.ReserveProductIDs != nil {
+		if e := ps.ReserveProductIDs.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("ReserveProductIDs: %w", e))
+		}
+	}
+	if ps.EnsureInventory != nil {
+		if e := ps.EnsureInventory.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("EnsureInventory: %w", e))
+		}
+	}
+	if ps.GetInventory != nil {
+		if e := ps.GetInventory.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("GetInventory: %w", e))
+		}
+	}
+	if ps.GetInventoryForUpdate != nil {
+		if e := ps.GetInventoryForUpdate.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("GetInventoryForUpdate: %w", e))
+		}
+	}
+	if ps.SaveInventory != nil {
+		if e := ps.SaveInventory.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("SaveInventory: %w", e))
+		}
+	}
+	if ps.CreateReservation != nil {
+		if e := ps.CreateReservation.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("CreateReservation: %w", e))
+		}
+	}
+	if ps.GetReservationForUpdate != nil {
+		if e := ps.GetReservationForUpdate.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("GetReservationForUpdate: %w", e))
+		}
+	}
+	if ps.UpdateReservationStatus != nil {
+		if e := ps.UpdateReservationStatus.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("UpdateReservationStatus: %w", e))
+		}
+	}
+	if ps.ListExpiredReservations != nil {
+		if e := ps.ListExpiredReservations.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("ListExpiredReservations: %w", e))
+		}
+	}
+	if ps.CreateCategory != nil {
+		if e := ps.CreateCategory.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("CreateCategory: %w", e))
+		}
+	}
+	if ps.GetCategoryByID != nil {
+		if e := ps.GetCategoryByID.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("GetCategoryByID: %w", e))
+		}
+	}
+	if ps.CategoryExists != nil {
+		if e := ps.CategoryExists.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("CategoryExists: %w", e))
+		}
+	}
+	if ps.ListCategories != nil {
+		if e := ps.ListCategories.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("ListCategories: %w", e))
+		}
+	}
+	if ps.UpdateCategory != nil {
+		if e := ps.UpdateCategory.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("UpdateCategory: %w", e))
+		}
+	}
+	if ps.LockCategoriesForMove != nil {
+		if e := ps.LockCategoriesForMove.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("LockCategoriesForMove: %w", e))
+		}
+	}
+	if ps.MoveCategory != nil {
+		if e := ps.MoveCategory.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("MoveCategory: %w", e))
+		}
+	}
+	if ps.MoveCategoryDescendants != nil {
+		if e := ps.MoveCategoryDescendants.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("MoveCategoryDescendants: %w", e))
+		}
+	}
+	if ps.DeleteCategory != nil {
+		if e := ps.DeleteCategory.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("DeleteCategory: %w", e))
+		}
+	}
+	if ps.CategoryUsage != nil {
+		if e := ps.CategoryUsage.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("CategoryUsage: %w", e))
+		}
+	}
+	if ps.CategorySubtreeDepth != nil {
+		if e := ps.CategorySubtreeDepth.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("CategorySubtreeDepth: %w", e))
+		}
+	}
+	if ps.ListProductCategories != nil {
+		if e := ps.ListProductCategories.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("ListProductCategories: %w", e))
+		}
+	}
+	if ps.SetProductCategories != nil {
+		if e := ps.SetProductCategories.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("SetProductCategories: %w", e))
+		}
+	}
+	if ps.AppendProductHistory != nil {
+		if e := ps.AppendProductHistory.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("AppendProductHistory: %w", e))
+		}
+	}
+	if ps.ListProductHistory != nil {
+		if e := ps.ListProductHistory.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("ListProductHistory: %w", e))
+		}
+	}
+	if ps.AcquireIdempotencyKey != nil {
+		if e := ps.AcquireIdempotencyKey.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("AcquireIdempotencyKey: %w", e))
+		}
+	}
+	if ps.GetIdempotencyKey != nil {
+		if e := ps.GetIdempotencyKey.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("GetIdempotencyKey: %w", e))
+		}
+	}
+	if ps.CompleteIdempotencyKey != nil {
+		if e := ps.CompleteIdempotencyKey.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("CompleteIdempotencyKey: %w", e))
+		}
+	}
+	if ps.RetainIdempotencyKey != nil {
+		if e := ps.RetainIdempotencyKey.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("RetainIdempotencyKey: %w", e))
+		}
+	}
+	if ps.ReleaseIdempotencyKey != nil {
+		if e := ps.ReleaseIdempotencyKey.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("ReleaseIdempotencyKey: %w", e))
+		}
+	}
+	if ps.PurgeIdempotencyKeys != nil {
+		if e := ps.PurgeIdempotencyKeys.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("PurgeIdempotencyKeys: %w", e))
+		}
+	}
+	if ps.CreateAPIKey != nil {
+		if e := ps.CreateAPIKey.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("CreateAPIKey: %w", e))
+		}
+	}
+	if ps.GetAPIKeyByHash != nil {
+		if e := ps.GetAPIKeyByHash.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("GetAPIKeyByHash: %w", e))
+		}
+	}
+	if ps.ListAPIKeys != nil {
+		if e := ps.ListAPIKeys.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("ListAPIKeys: %w", e))
+		}
+	}
+	if ps.UpdateAPIKeyScopes != nil {
+		if e := ps.UpdateAPIKeyScopes.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("UpdateAPIKeyScopes: %w", e))
+		}
+	}
+	if ps.RevokeAPIKey != nil {
+		if e := ps.RevokeAPIKey.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("RevokeAPIKey: %w", e))
+		}
+	}
+	if ps.TouchAPIKey != nil {
+		if e := ps.TouchAPIKey.Close(); e != nil {
+			errs = append(errs, fmt.Errorf("TouchAPIKey: %w", e))
+		}
+	}
 	if ps.SaveOutboxEvent != nil {
 		if e := ps.SaveOutboxEvent.Close(); e != nil {
 			errs = append(errs, fmt.Errorf("SaveOutboxEvent: %w", e))
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"encoding/json"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"strings"
+)
+
+var productSortColumns = map[ports.ProductSortField]string{
+	ports.SortByCreatedAt: "created_at",
+	ports.SortByName:      "name",
+}
+
+type productListQueryBuilder struct {
+	conditions []string
+	args       []interface{}
+}
+
+func (b *productListQueryBuilder) bind(value interface{}) string {
+	b.args = append(b.args, value)
+	var sb strings.Builder
+	sb.WriteByte('$')
+	writeInt(&sb, len(b.args))
+	return sb.String()
+}
+
+func (b *productListQueryBuilder) where(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+func buildProductListQuery(query ports.ProductListQuery, tenant string) (string, []interface{}) {
+	b := &productListQueryBuilder{}
+
+	filter := query.Filter
+	sortColumn, ok := productSortColumns[query.Sort.Field]
+	if !ok && query.Sort.Field != ports.SortByPrice {
+		query.Sort = ports.DefaultProductSort()
+		sortColumn = productSortColumns[query.Sort.Field]
+	}
+
+	if tenant != "" {
+		b.where("tenant_id = " + b.bind(tenant))
+	}
+	if !query.IncludeDeleted {
+		b.where("deleted_at IS NULL")
+	}
+
+	if query.Sort.Field == ports.SortByPrice || filter.HasPriceBounds() {
+		price := priceInCurrency(b.bind(filter.PriceCurrency()))
+		b.where(price + " IS NOT NULL")
+		if filter.MinPrice != nil {
+			b.where(price + " >= " + b.bind(filter.MinPrice.Amount()) + "::numeric")
+		}
+		if filter.MaxPrice != nil {
+			b.where(price + " <= " + b.bind(filter.MaxPrice.Amount()) + "::numeric")
+		}
+		if query.Sort.Field == ports.SortByPrice {
+			sortColumn = price
+		}
+	}
+
+	if filter.NameContains != "" {
+		b.where("name ILIKE " + b.bind("%"+escapeLike(filter.NameContains)+"%"))
+	}
+	if filter.NamePrefix != "" {
+		b.where("name ILIKE " + b.bind(escapeLike(filter.NamePrefix)+"%"))
+	}
+	if filter.CreatedAfter != nil {
+		b.where("created_at >= " + b.bind(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		b.where("created_at < " + b.bind(*filter.CreatedBefore))
+	}
+	if filter.ParentID != nil {
+		b.where("parent_id = " + b.bind(*filter.ParentID))
+	}
+	if len(filter.Statuses) > 0 {
+		b.where("status = ANY(" + b.bind(statusFilterValues(filter.Statuses)) + "::text[])")
+	}
+	if len(filter.Attributes) > 0 {
+		b.where(queryProductEffectiveAttributes + " @> " + b.bind(attributeFilterJSON(filter.Attributes)) + "::jsonb")
+	}
+	if filter.Category != nil {
+		b.where(categoryCondition(b, filter.Category))
+	}
+
+	descending := query.Sort.Descending
+	if query.IsCursorMode() {
+		if query.Cursor.Direction == ports.CursorPrev {
+			descending = !descending
+		}
+
+		operator := " > "
+		if descending {
+			operator = " < "
+		}
+		b.where("(" + sortColumn + ", id)" + operator + "(" + b.bind(cursorSortValue(query.Cursor, query.Sort.Field)) + ", " + b.bind(query.Cursor.ID) + ")")
+	}
+
+	direction := " ASC"
+	if descending {
+		direction = " DESC"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(queryListProductsSelect)
+	if !query.IsCursorMode() {
+		sb.WriteString(queryListProductsTotal)
+	}
+	sb.WriteString(queryListProductsFrom)
+
+	if len(b.conditions) > 0 {
+		sb.WriteString("\n\t\tWHERE ")
+		sb.WriteString(strings.Join(b.conditions, " AND "))
+	}
+
+	sb.WriteString("\n\t\tORDER BY ")
+	sb.WriteString(sortColumn)
+	sb.WriteString(direction)
+	sb.WriteString(", id")
+	sb.WriteString(direction)
+
+	if query.IsCursorMode() {
+		sb.WriteString("\n\t\tLIMIT ")
+		sb.WriteString(b.bind(query.Limit + 1))
+	} else {
+		sb.WriteString("\n\t\tLIMIT ")
+		sb.WriteString(b.bind(query.Limit))
+		sb.WriteString(" OFFSET ")
+		sb.WriteString(b.bind((query.Page - 1) * query.Limit))
+	}
+
+	return sb.String(), b.args
+}
+
+func priceInCurrency(currency string) string {
+	return "(CASE WHEN currency = " + currency + " THEN price ELSE " +
+		"(SELECT pp.amount FROM product_prices pp WHERE pp.product_id = products.id AND pp.currency = " + currency + ") END)"
+}
+
+func cursorSortValue(cursor *ports.ProductCursor, field ports.ProductSortField) interface{} {
+	switch field {
+	case ports.SortByPrice:
+		return cursor.Price.Amount()
+	case ports.SortByName:
+		return cursor.Name
+	default:
+		return cursor.CreatedAt
+	}
+}
+
+func categoryCondition(b *productListQueryBuilder, filter *ports.CategoryFilter) string {
+	subquery, alias := queryProductInCategory, "c"
+	if filter.IncludeDescendants {
+		subquery, alias = queryProductInCategoryTree, "root"
+	}
+	if filter.Slug != "" {
+		return subquery + alias + ".slug = " + b.bind(filter.Slug) + ")"
+	}
+	return subquery + alias + ".id = " + b.bind(filter.ID) + ")"
+}
+
+func attributeFilterJSON(attributes domain.Attributes) string {
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+func statusFilterValues(statuses []domain.ProductStatus) []string {
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = status.String()
+	}
+	return values
+}
+
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
@@ -1,47 +1,460 @@
 package repository
 
 const (
+	queryProductPricesColumn = `
+			(SELECT json_object_agg(pp.currency, pp.amount)
+			 FROM product_prices pp
+			 WHERE pp.product_id = products.id) AS prices`
+
+	queryProductParentAttributes = `(SELECT parent.attributes FROM products parent WHERE parent.id = products.parent_id)`
+
+	queryProductAttributeColumns = `
+			parent_id,
+			attributes,
+			` + queryProductParentAttributes + ` AS parent_attributes`
+
+	queryProductEscapedName = `replace(replace(replace(replace(replace(name, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+
+	queryProductEffectiveAttributes = `(COALESCE(` + queryProductParentAttributes + `, '{}'::jsonb) || attributes)`
+
+	queryProductInCategory = `EXISTS (
+			SELECT 1 FROM product_categories pc
+			JOIN categories c ON c.id = pc.category_id
+			WHERE pc.product_id = products.id AND c.tenant_id = products.tenant_id AND `
+
+	queryProductInCategoryTree = `EXISTS (
+			SELECT 1 FROM product_categories pc
+			JOIN categories c ON c.id = pc.category_id
+			JOIN categories root ON c.path LIKE root.path || '%' AND root.tenant_id = c.tenant_id
+			WHERE pc.product_id = products.id AND c.tenant_id = products.tenant_id AND `
+
 	queryCreateProduct = `
-		INSERT INTO products (name, price, created_at)
-		VALUES ($1, $2, NOW())
-		RETURNING id, created_at
+		WITH inserted AS (
+			INSERT INTO products (name, price, currency, attributes, parent_id, status, tenant_id, created_at)
+			VALUES ($1, $2, $3, $6::jsonb, $7, $8, $9, NOW())
+			RETURNING id, version, created_at
+		), prices AS (
+			INSERT INTO product_prices (product_id, currency, amount)
+			SELECT inserted.id, p.currency, p.amount::numeric
+			FROM inserted, unnest($4::text[], $5::text[]) AS p(currency, amount)
+		)
+		SELECT id, version, created_at FROM inserted
 	`
 
 	queryGetProductByID = `
-		SELECT id, name, price, created_at
+		SELECT id, name, price, currency, status, version, created_at, deleted_at,` + queryProductPricesColumn + `,` + queryProductAttributeColumns + `
 		FROM products
-		WHERE id = $1
+		WHERE id = $1 AND ($2 OR deleted_at IS NULL) AND ($3 = '' OR tenant_id = $3)
 	`
 
-	queryListProducts = `
-		SELECT 
-			id, 
-			name, 
-			price, 
+	queryGetProductsByIDs = `
+		SELECT id, name, price, currency, status, version, created_at, deleted_at,` + queryProductPricesColumn + `,` + queryProductAttributeColumns + `
+		FROM products
+		WHERE id = ANY($1::bigint[]) AND ($2 OR deleted_at IS NULL) AND ($3 = '' OR tenant_id = $3)
+	`
+
+	queryProductExists = `
+		SELECT EXISTS(SELECT 1 FROM products WHERE id = $1 AND ($2 = '' OR tenant_id = $2))
+	`
+
+	queryListProductsSelect = `
+		SELECT id, name, price, currency, status, version, created_at, deleted_at,` + queryProductPricesColumn + `,` + queryProductAttributeColumns
+
+	queryListProductsTotal = `, COUNT(*) OVER() AS total`
+
+	queryListProductsFrom = `
+		FROM products`
+
+	querySearchProducts = `
+		SELECT
+			id,
+			name,
+			price,
+			currency,
+			status,
+			version,
 			created_at,
-			COUNT(*) OVER() as total
+			deleted_at,` + queryProductPricesColumn + `,` + queryProductAttributeColumns + `,
+			ts_rank_cd(search_vector, query) AS rank,
+			ts_headline('english', ` + queryProductEscapedName + `, query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') AS highlight
+		FROM products, to_tsquery('english', $1) AS query
+		WHERE deleted_at IS NULL AND search_vector @@ query AND ($3 = '' OR tenant_id = $3)
+		ORDER BY rank DESC, id DESC
+		LIMIT $2
+	`
+
+	querySearchProductsFuzzy = `
+		SELECT
+			id,
+			name,
+			price,
+			currency,
+			status,
+			version,
+			created_at,
+			deleted_at,` + queryProductPricesColumn + `,` + queryProductAttributeColumns + `,
+			similarity(name, $1) AS rank,
+			` + queryProductEscapedName + ` AS highlight
 		FROM products
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
+		WHERE deleted_at IS NULL AND name % $1 AND ($3 = '' OR tenant_id = $3)
+		ORDER BY rank DESC, id DESC
+		LIMIT $2
+	`
+
+	queryUpdateProduct = `
+		WITH updated AS (
+			UPDATE products
+			SET name = $1, price = $2, currency = $3, attributes = $6::jsonb, status = $7, version = version + 1
+			WHERE id = $4 AND version = $5 AND deleted_at IS NULL AND ($8 = '' OR tenant_id = $8)
+			RETURNING id, price, currency, version
+		), prices AS (
+			INSERT INTO product_prices (product_id, currency, amount)
+			SELECT updated.id, p.currency, p.amount::numeric
+			FROM updated, unnest($9::text[], $10::text[]) AS p(currency, amount)
+			ON CONFLICT (product_id, currency) DO UPDATE SET amount = EXCLUDED.amount
+		)
+		SELECT version FROM updated
 	`
 
 	queryDeleteProduct = `
-		DELETE FROM products WHERE id = $1
+		UPDATE products
+		SET deleted_at = NOW(), version = version + 1
+		WHERE id = $1 AND version = $2 AND deleted_at IS NULL AND ($3 = '' OR tenant_id = $3)
+			AND NOT EXISTS (SELECT 1 FROM products variant WHERE variant.parent_id = products.id AND variant.deleted_at IS NULL)
+	`
+
+	queryCountActiveVariants = `
+		SELECT COUNT(*) FROM products
+		WHERE parent_id = $1 AND deleted_at IS NULL AND ($2 = '' OR tenant_id = $2)
+	`
+
+	queryRestoreProduct = `
+		UPDATE products
+		SET deleted_at = NULL, version = version + 1
+		WHERE id = $1 AND version = $2 AND deleted_at IS NOT NULL AND ($3 = '' OR tenant_id = $3)
+		RETURNING version
+	`
+
+	queryPurgeDeletedProducts = `
+		DELETE FROM products
+		WHERE id IN (
+			SELECT id
+			FROM products
+			WHERE deleted_at IS NOT NULL AND deleted_at < $1 AND ($3 = '' OR tenant_id = $3)
+				AND NOT EXISTS (SELECT 1 FROM products variant WHERE variant.parent_id = products.id)
+			ORDER BY deleted_at ASC
+			LIMIT $2
+		)
+	`
+
+	queryReserveProductIDs = `
+		SELECT nextval(pg_get_serial_sequence('products', 'id'))
+		FROM generate_series(1, $1)
+	`
+
+	queryCreateProductsBatch = `
+		WITH inserted AS (
+			INSERT INTO products (id, name, price, currency, attributes, status, tenant_id, created_at)
+			VALUES `
+
+	queryCreateProductsBatchPrices = `
+			RETURNING id, version, created_at
+		), prices AS (
+			INSERT INTO product_prices (product_id, currency, amount)
+			SELECT inserted.id, p.currency, p.amount::numeric
+			FROM inserted
+			JOIN unnest($1::int[], $2::text[], $3::text[]) AS p(product_id, currency, amount) ON p.product_id = inserted.id
+		)
+		SELECT id, version, created_at FROM inserted
+	`
+)
+
+const (
+	queryEnsureInventory = `
+		INSERT INTO product_inventory (product_id)
+		VALUES ($1)
+		ON CONFLICT (product_id) DO NOTHING
+	`
+
+	queryGetInventory = `
+		SELECT product_id, on_hand, reserved, version, updated_at
+		FROM product_inventory
+		WHERE product_id = $1
+	`
+
+	queryGetInventoryForUpdate = `
+		SELECT product_id, on_hand, reserved, version, updated_at
+		FROM product_inventory
+		WHERE product_id = $1
+		FOR UPDATE
+	`
+
+	querySaveInventory = `
+		UPDATE product_inventory
+		SET on_hand = $1, reserved = $2, version = version + 1, updated_at = NOW()
+		WHERE product_id = $3 AND version = $4
+		RETURNING version, updated_at
+	`
+
+	queryCreateReservation = `
+		INSERT INTO stock_reservations (product_id, quantity, status, expires_at, tenant_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING id, created_at
+	`
+
+	queryGetReservationForUpdate = `
+		SELECT id, product_id, quantity, status, expires_at, created_at
+		FROM stock_reservations
+		WHERE id = $1 AND ($2 = '' OR tenant_id = $2)
+		FOR UPDATE
+	`
+
+	queryUpdateReservationStatus = `
+		UPDATE stock_reservations
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2 AND ($3 = '' OR tenant_id = $3)
+	`
+
+	queryListExpiredReservations = `
+		SELECT id, product_id, quantity, status, expires_at, created_at
+		FROM stock_reservations
+		WHERE status = $1 AND expires_at <= $2 AND ($4 = '' OR tenant_id = $4)
+		ORDER BY product_id ASC, id ASC
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`
+)
+
+const (
+	queryCategoryColumns = `id, name, slug, parent_id, path, version, created_at, updated_at`
+
+	queryCreateCategory = `
+		WITH next AS (
+			SELECT nextval(pg_get_serial_sequence('categories', 'id')) AS id
+		), parent AS (
+			SELECT path FROM categories WHERE id = $3 AND tenant_id = $4 FOR SHARE
+		)
+		INSERT INTO categories (id, name, slug, parent_id, path, tenant_id, created_at, updated_at)
+		SELECT next.id, $1, $2, $3, COALESCE((SELECT path FROM parent), '/') || next.id || '/', $4, NOW(), NOW()
+		FROM next
+		WHERE $3::int IS NULL OR EXISTS (SELECT 1 FROM parent)
+		RETURNING id, path, version, created_at, updated_at
+	`
+
+	queryGetCategoryByID = `
+		SELECT ` + queryCategoryColumns + `
+		FROM categories
+		WHERE id = $1 AND ($2 = '' OR tenant_id = $2)
+	`
+
+	queryCategoryExists = `
+		SELECT EXISTS(SELECT 1 FROM categories WHERE id = $1 AND ($2 = '' OR tenant_id = $2))
+	`
+
+	queryListCategories = `
+		SELECT ` + queryCategoryColumns + `
+		FROM categories
+		WHERE ($1 = '' OR tenant_id = $1)
+		ORDER BY path ASC
+	`
+
+	queryUpdateCategory = `
+		UPDATE categories
+		SET name = $1, slug = $2, version = version + 1, updated_at = NOW()
+		WHERE id = $3 AND version = $4 AND ($5 = '' OR tenant_id = $5)
+		RETURNING version, updated_at
+	`
+
+	queryLockCategoriesForMove = `
+		SELECT id
+		FROM categories
+		WHERE ($3 = '' OR tenant_id = $3)
+		  AND (
+			path LIKE (SELECT path FROM categories WHERE id = $1 AND ($3 = '' OR tenant_id = $3)) || '%'
+			OR id = ANY(
+				SELECT unnest(string_to_array(trim(both '/' from path), '/')::int[])
+				FROM categories
+				WHERE id = $2::int AND ($3 = '' OR tenant_id = $3)
+			)
+		  )
+		ORDER BY id
+		FOR UPDATE
+	`
+
+	queryMoveCategory = `
+		UPDATE categories
+		SET parent_id = $1, path = $2, version = version + 1, updated_at = NOW()
+		WHERE id = $3 AND version = $4 AND ($5 = '' OR tenant_id = $5)
+		RETURNING version, updated_at
+	`
+
+	queryMoveCategoryDescendants = `
+		UPDATE categories
+		SET path = $1 || substring(path FROM char_length($2) + 1), updated_at = NOW()
+		WHERE path LIKE $2 || '%' AND id <> $3 AND ($4 = '' OR tenant_id = $4)
+	`
+
+	queryDeleteCategory = `
+		DELETE FROM categories
+		WHERE id = $1 AND version = $2 AND ($3 = '' OR tenant_id = $3)
+	`
+
+	queryCategoryUsage = `
+		SELECT
+			(SELECT COUNT(*) FROM product_categories WHERE category_id = $1),
+			(SELECT COUNT(*) FROM categories WHERE parent_id = $1)
+	`
+
+	queryCategorySubtreeDepth = `
+		SELECT COALESCE(MAX(char_length(path) - char_length(replace(path, '/', ''))) - 1, 0)
+		FROM categories
+		WHERE path LIKE $1 || '%' AND ($2 = '' OR tenant_id = $2)
+	`
+
+	queryListProductCategories = `
+		SELECT c.id, c.name, c.slug, c.parent_id, c.path, c.version, c.created_at, c.updated_at
+		FROM categories c
+		JOIN product_categories pc ON pc.category_id = c.id
+		WHERE pc.product_id = $1 AND ($2 = '' OR c.tenant_id = $2)
+		ORDER BY c.path ASC
+	`
+
+	querySetProductCategories = `
+		WITH removed AS (
+			DELETE FROM product_categories
+			WHERE product_id = $1 AND NOT (category_id = ANY($2::int[]))
+		)
+		INSERT INTO product_categories (product_id, category_id, tenant_id)
+		SELECT $1, category_id, $3 FROM unnest($2::int[]) AS category_id
+		ON CONFLICT (product_id, category_id) DO NOTHING
+	`
+
+	queryAppendProductHistory = `
+		INSERT INTO product_history (product_id, action, changes, actor, request_id, created_at)
+		SELECT h.product_id, h.action, h.changes::jsonb, h.actor, NULLIF(h.request_id, ''), NOW()
+		FROM unnest($1::int[], $2::text[], $3::text[], $4::text[], $5::text[]) AS h(product_id, action, changes, actor, request_id)
+	`
+
+	queryListProductHistory = `
+		SELECT id, product_id, action, changes, actor, COALESCE(request_id, ''), created_at, COUNT(*) OVER()
+		FROM product_history
+		WHERE product_id = $1
+		ORDER BY id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	queryAcquireIdempotencyKey = `
+		INSERT INTO idempotency_keys (scope, key, request_hash, locked_until, expires_at, created_at)
+		VALUES ($1, $2, $3, NOW() + make_interval(secs => $4), NOW() + make_interval(secs => $5), NOW())
+		ON CONFLICT (scope, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response_headers = NULL,
+			response_body = NULL,
+			locked_until = EXCLUDED.locked_until,
+			expires_at = EXCLUDED.expires_at,
+			created_at = EXCLUDED.created_at
+		WHERE idempotency_keys.expires_at <= NOW()
+			OR (idempotency_keys.status_code IS NULL
+				AND idempotency_keys.locked_until <= NOW()
+				AND idempotency_keys.request_hash = EXCLUDED.request_hash)
+		RETURNING expires_at
+	`
+
+	queryGetIdempotencyKey = `
+		SELECT request_hash, COALESCE(status_code, 0), COALESCE(content_type, ''), COALESCE(response_headers, '{}')::text, response_body, expires_at
+		FROM idempotency_keys
+		WHERE scope = $1 AND key = $2
+	`
+
+	queryCompleteIdempotencyKey = `
+		UPDATE idempotency_keys
+		SET status_code = $3, content_type = $4, response_headers = $5::jsonb, response_body = $6, locked_until = NULL
+		WHERE scope = $1 AND key = $2 AND status_code IS NULL
+	`
+
+	queryRetainIdempotencyKey = `
+		UPDATE idempotency_keys
+		SET locked_until = expires_at
+		WHERE scope = $1 AND key = $2 AND status_code IS NULL
+	`
+
+	queryReleaseIdempotencyKey = `
+		DELETE FROM idempotency_keys
+		WHERE scope = $1 AND key = $2 AND status_code IS NULL
+	`
+
+	queryPurgeIdempotencyKeys = `
+		DELETE FROM idempotency_keys
+		WHERE (scope, key) IN (
+			SELECT scope, key
+			FROM idempotency_keys
+			WHERE expires_at < $1
+			ORDER BY expires_at ASC
+			LIMIT $2
+		)
+	`
+
+	queryAPIKeyColumns = `id, tenant_id, name, key_prefix, key_hash, array_to_string(scopes, ','), rate_limit, created_by, created_at, last_used_at, revoked_at`
+
+	queryCreateAPIKey = `
+		INSERT INTO api_keys (tenant_id, name, key_prefix, key_hash, scopes, rate_limit, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5::text[], $6, $7, NOW())
+		RETURNING id, created_at
+	`
+
+	queryGetAPIKeyByHash = `
+		SELECT ` + queryAPIKeyColumns + `
+		FROM api_keys
+		WHERE key_hash = $1
+	`
+
+	queryListAPIKeys = `
+		SELECT ` + queryAPIKeyColumns + `, COUNT(*) OVER()
+		FROM api_keys
+		WHERE ($3 = '' OR tenant_id = $3)
+		ORDER BY id DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	queryUpdateAPIKeyScopes = `
+		UPDATE api_keys
+		SET scopes = $2::text[]
+		WHERE id = $1 AND revoked_at IS NULL AND ($3 = '' OR tenant_id = $3)
+		RETURNING ` + queryAPIKeyColumns + `
+	`
+
+	queryRevokeAPIKey = `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE id = $1 AND ($2 = '' OR tenant_id = $2)
+		RETURNING ` + queryAPIKeyColumns + `
+	`
+
+	queryTouchAPIKey = `
+		UPDATE api_keys
+		SET last_used_at = $2
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $2)
 	`
 )
 
 const (
 	querySaveOutboxEvent = `
-		INSERT INTO outbox (event_type, event_data, idempotency_key, status, created_at)
-		VALUES ($1, $2, $3, $4, NOW())
-		ON CONFLICT (idempotency_key) DO NOTHING
+		INSERT INTO outbox (event_type, event_data, idempotency_key, status, tenant_id, created_at)
+		VALUES (
+			$1, $2, NULLIF($3, ''), $4,
+			COALESCE(NULLIF($5, ''), (SELECT tenant_id FROM products WHERE id = ($2::jsonb->>'product_id')::int), 'default'),
+			NOW()
+		)
+		ON CONFLICT (tenant_id, idempotency_key) DO NOTHING
 		RETURNING id, created_at
 	`
 
 	queryGetPendingEvents = `
-		SELECT id, event_type, event_data, idempotency_key, created_at, published_at, retry_count, status
+		SELECT id, event_type, event_data, idempotency_key, tenant_id, created_at, published_at, retry_count, status
 		FROM outbox
-		WHERE status = $1
+		WHERE status = $1 AND ($3 = '' OR tenant_id = $3)
 		ORDER BY created_at ASC
 		LIMIT $2
 		FOR UPDATE SKIP LOCKED
@@ -50,28 +463,55 @@ const (
 	queryMarkAsPublished = `
 		UPDATE outbox
 		SET status = $1, published_at = NOW()
-		WHERE id = $2
+		WHERE id = $2 AND ($3 = '' OR tenant_id = $3)
 	`
 
 	queryMarkAsFailed = `
 		UPDATE outbox
 		SET status = $1, retry_count = $2
-		WHERE id = $3
+		WHERE id = $3 AND ($4 = '' OR tenant_id = $4)
 	`
 
 	queryCheckIdempotencyKey = `
-		SELECT EXISTS(SELECT 1 FROM outbox WHERE idempotency_key = $1)
+		SELECT EXISTS(SELECT 1 FROM outbox WHERE idempotency_key = $1 AND ($2 = '' OR tenant_id = $2))
 	`
 
 	queryMoveToDLQ = `
 		UPDATE outbox
 		SET status = $1, retry_count = retry_count + 1, dlq_reason = $3
-		WHERE id = $2
+		WHERE id = $2 AND ($4 = '' OR tenant_id = $4)
 	`
 
 	querySaveEventsBatch = `
-		INSERT INTO outbox (event_type, event_data, idempotency_key, status, created_at)
-		VALUES 
+		INSERT INTO outbox (event_type, event_data, idempotency_key, status, tenant_id, created_at)
+		SELECT
+			event.event_type,
+			event.event_data,
+			NULLIF(event.idempotency_key, ''),
+			event.status,
+			COALESCE(NULLIF(event.tenant_id, ''), (SELECT tenant_id FROM products WHERE id = (event.event_data->>'product_id')::int), 'default'),
+			NOW()
+		FROM (VALUES %s) AS event (event_type, event_data, idempotency_key, status, tenant_id)
+		ON CONFLICT (tenant_id, idempotency_key) DO NOTHING
+		RETURNING id, created_at
+	`
+
+	queryListDeadLetters = `
+		SELECT id, event_type, event_data, idempotency_key, tenant_id, created_at, published_at, retry_count, status, COALESCE(dlq_reason, ''), COUNT(*) OVER()
+		FROM outbox
+		WHERE status = $1 AND ($4 = '' OR tenant_id = $4)
+		ORDER BY id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	queryRequeueDeadLetter = `
+		UPDATE outbox
+		SET status = $1, retry_count = 0, dlq_reason = NULL
+		WHERE id = $2 AND status = $3 AND ($4 = '' OR tenant_id = $4)
+	`
+
+	querySetTenant = `
+		SELECT set_config('app.tenant_id', $1, true)
 	`
 )
 
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"product_service/products/internal/usecase/ports"
 )
 
@@ -14,14 +15,18 @@ type RepositoryFactory interface {
 }
 
 type postgresUnitOfWork struct {
-	db            *sql.DB
-	tx            *sql.Tx
-	productRepo   ports.ProductRepository
-	outboxRepo    ports.OutboxRepository
-	inTransaction bool
-	productStm    *PreparedStatements
-	outboxStm     *PreparedStatements
-	metrics       ports.MetricsCollector
+	db               *sql.DB
+	tx               *sql.Tx
+	productRepo      ports.ProductRepository
+	outboxRepo       ports.OutboxRepository
+	inventoryRepo    ports.InventoryRepository
+	categoryRepo     ports.CategoryRepository
+	historyRepo      ports.ProductHistoryRepository
+	inTransaction    bool
+	productStm       *PreparedStatements
+	outboxStm        *PreparedStatements
+	metrics          ports.MetricsCollector
+	rowLevelSecurity bool
 }
 
 func NewUnitOfWork(db *sql.DB, productStm, outboxStm *PreparedStatements) ports.UnitOfWork {
@@ -42,28 +47,33 @@ func NewUnitOfWorkWithMetrics(db *sql.DB, productStm, outboxStm *PreparedStateme
 }
 
 type uowFactory struct {
-	db         *sql.DB
-	productStm *PreparedStatements
-	outboxStm  *PreparedStatements
-	metrics    ports.MetricsCollector
+	db               *sql.DB
+	productStm       *PreparedStatements
+	outboxStm        *PreparedStatements
+	metrics          ports.MetricsCollector
+	rowLevelSecurity bool
 }
 
 var _ ports.UoWFactory = (*uowFactory)(nil)
 
-func NewUoWFactory(db *sql.DB, productStm, outboxStm *PreparedStatements, metrics ports.MetricsCollector) ports.UoWFactory {
+func NewUoWFactory(db *sql.DB, productStm, outboxStm *PreparedStatements, metrics ports.MetricsCollector, rowLevelSecurity bool) ports.UoWFactory {
 	return &uowFactory{
-		db:         db,
-		productStm: productStm,
-		outboxStm:  outboxStm,
-		metrics:    metrics,
+		db:               db,
+		productStm:       productStm,
+		outboxStm:        outboxStm,
+		metrics:          metrics,
+		rowLevelSecurity: rowLevelSecurity,
 	}
 }
 
 func (f *uowFactory) CreateUnitOfWork() ports.UnitOfWork {
-	if f.metrics != nil {
-		return NewUnitOfWorkWithMetrics(f.db, f.productStm, f.outboxStm, f.metrics)
+	return &postgresUnitOfWork{
+		db:               f.db,
+		productStm:       f.productStm,
+		outboxStm:        f.outboxStm,
+		metrics:          f.metrics,
+		rowLevelSecurity: f.rowLevelSecurity,
 	}
-	return NewUnitOfWork(f.db, f.productStm, f.outboxStm)
 }
 
 func (u *postgresUnitOfWork) Begin(ctx context.Context) error {
@@ -75,6 +85,13 @@ func (u *postgresUnitOfWork) Begin(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+
+	if u.rowLevelSecurity {
+		if _, err := tx.ExecContext(ctx, querySetTenant, tenantScope(ctx)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to set tenant for transaction: %w", err)
+		}
+	}
 	
 	u.tx = tx
 	u.inTransaction = true
@@ -88,6 +105,9 @@ func (u *postgresUnitOfWork) Begin(ctx context.Context) error {
 	}
 	
 	u.outboxRepo = NewPostgresOutboxRepository(u.db, u.outboxStm)
+	u.inventoryRepo = NewPostgresInventoryRepository(u.db, u.productStm)
+	u.categoryRepo = NewPostgresCategoryRepository(u.db, u.productStm)
+	u.historyRepo = NewPostgresProductHistoryRepository(u.db, u.productStm)
 	
 	if txRepo, ok := u.productRepo.(ports.TransactionalRepository); ok {
 		txRepo.SetTransaction(tx)
@@ -95,6 +115,15 @@ func (u *postgresUnitOfWork) Begin(ctx context.Context) error {
 	if txRepo, ok := u.outboxRepo.(ports.TransactionalRepository); ok {
 		txRepo.SetTransaction(tx)
 	}
+	if txRepo, ok := u.inventoryRepo.(ports.TransactionalRepository); ok {
+		txRepo.SetTransaction(tx)
+	}
+	if txRepo, ok := u.categoryRepo.(ports.TransactionalRepository); ok {
+		txRepo.SetTransaction(tx)
+	}
+	if txRepo, ok := u.historyRepo.(ports.TransactionalRepository); ok {
+		txRepo.SetTransaction(tx)
+	}
 	
 	return nil
 }
@@ -118,6 +147,15 @@ func (u *postgresUnitOfWork) Commit() error {
 	if txRepo, ok := u.outboxRepo.(ports.TransactionalRepository); ok {
 		txRepo.ClearTransaction()
 	}
+	if txRepo, ok := u.inventoryRepo.(ports.TransactionalRepository); ok {
+		txRepo.ClearTransaction()
+	}
+	if txRepo, ok := u.categoryRepo.(ports.TransactionalRepository); ok {
+		txRepo.ClearTransaction()
+	}
+	if txRepo, ok := u.historyRepo.(ports.TransactionalRepository); ok {
+		txRepo.ClearTransaction()
+	}
 	
 	return err
 }
@@ -141,6 +179,15 @@ func (u *postgresUnitOfWork) Rollback() error {
 	if txRepo, ok := u.outboxRepo.(ports.TransactionalRepository); ok {
 		txRepo.ClearTransaction()
 	}
+	if txRepo, ok := u.inventoryRepo.(ports.TransactionalRepository); ok {
+		txRepo.ClearTransaction()
+	}
+	if txRepo, ok := u.categoryRepo.(ports.TransactionalRepository); ok {
+		txRepo.ClearTransaction()
+	}
+	if txRepo, ok := u.historyRepo.(ports.TransactionalRepository); ok {
+		txRepo.ClearTransaction()
+	}
 	
 	return err
 }
@@ -153,6 +200,18 @@ func (u *postgresUnitOfWork) OutboxRepository() ports.OutboxRepository {
 	return u.outboxRepo
 }
 
+func (u *postgresUnitOfWork) InventoryRepository() ports.InventoryRepository {
+	return u.inventoryRepo
+}
+
+func (u *postgresUnitOfWork) CategoryRepository() ports.CategoryRepository {
+	return u.categoryRepo
+}
+
+func (u *postgresUnitOfWork) ProductHistoryRepository() ports.ProductHistoryRepository {
+	return u.historyRepo
+}
+
 func (u *postgresUnitOfWork) Transaction() *sql.Tx {
 	return u.tx
 }
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultAPIKeyPageSize = 20
+	MaxAPIKeyPageSize     = 100
+)
+
+type APIKeyUseCase interface {
+	CreateAPIKey(ctx context.Context, name string, scopes []string, rateLimit int) (*domain.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, page, limit int) (APIKeyPage, error)
+	SetAPIKeyScopes(ctx context.Context, id int64, scopes []string) (*domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id int64) (*domain.APIKey, error)
+	AuthenticateAPIKey(ctx context.Context, plaintext string) (*domain.APIKey, error)
+}
+
+type APIKeyPage struct {
+	Keys  []domain.APIKey
+	Page  int
+	Limit int
+	Total int
+}
+
+type apiKeyUseCase struct {
+	repo   ports.APIKeyRepository
+	logger ports.Logger
+	now    func() time.Time
+}
+
+func NewAPIKeyUseCase(repo ports.APIKeyRepository, logger ports.Logger) APIKeyUseCase {
+	return &apiKeyUseCase{
+		repo:   repo,
+		logger: logger,
+		now:    func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (uc *apiKeyUseCase) CreateAPIKey(ctx context.Context, name string, scopes []string, rateLimit int) (*domain.APIKey, string, error) {
+	parsed, err := domain.ParseAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, "", fmt.Errorf("api key validation failed: %w", err)
+	}
+
+	key, plaintext, err := domain.NewAPIKey(name, parsed, rateLimit)
+	if err != nil {
+		return nil, "", fmt.Errorf("api key validation failed: %w", err)
+	}
+	key.TenantID = ports.TenantFromContext(ctx)
+	key.CreatedBy = ports.ActorFromContext(ctx)
+
+	if err := uc.repo.Create(ctx, key); err != nil {
+		uc.logger.Error("Failed to create api key",
+			ports.NewField("error", err),
+			ports.NewField("name", key.Name),
+		)
+		return nil, "", fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	uc.logger.Info("API key created",
+		ports.NewField("api_key_id", key.ID),
+		ports.NewField("prefix", key.Prefix),
+		ports.NewField("tenant_id", key.TenantID),
+		ports.NewField("created_by", key.CreatedBy),
+	)
+	return key, plaintext, nil
+}
+
+func (uc *apiKeyUseCase) ListAPIKeys(ctx context.Context, page, limit int) (APIKeyPage, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = DefaultAPIKeyPageSize
+	}
+	if limit > MaxAPIKeyPageSize {
+		limit = MaxAPIKeyPageSize
+	}
+
+	keys, total, err := uc.repo.List(ctx, limit, (page-1)*limit)
+	if err != nil {
+		uc.logger.Error("Failed to list api keys", ports.NewField("error", err))
+		return APIKeyPage{}, fmt.Errorf("failed to list api keys: %w", err)
+	}
+
+	return APIKeyPage{
+		Keys:  keys,
+		Page:  page,
+		Limit: limit,
+		Total: total,
+	}, nil
+}
+
+func (uc *apiKeyUseCase) SetAPIKeyScopes(ctx context.Context, id int64, scopes []string) (*domain.APIKey, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid api key id: %w", domain.ErrInvalidInput)
+	}
+
+	parsed, err := domain.ParseAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, fmt.Errorf("api key validation failed: %w", err)
+	}
+
+	key, err := uc.repo.UpdateScopes(ctx, id, parsed)
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			return nil, fmt.Errorf("active api key %d not found: %w", id, domain.ErrAPIKeyNotFound)
+		}
+		uc.logger.Error("Failed to update api key scopes",
+			ports.NewField("error", err),
+			ports.NewField("api_key_id", id),
+		)
+		return nil, fmt.Errorf("failed to update api key scopes: %w", err)
+	}
+
+	uc.logger.Info("API key scopes updated",
+		ports.NewField("api_key_id", id),
+		ports.NewField("scopes", parsed),
+		ports.NewField("actor", ports.ActorFromContext(ctx)),
+	)
+	return key, nil
+}
+
+func (uc *apiKeyUseCase) RevokeAPIKey(ctx context.Context, id int64) (*domain.APIKey, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid api key id: %w", domain.ErrInvalidInput)
+	}
+
+	key, err := uc.repo.Revoke(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			return nil, fmt.Errorf("api key %d not found: %w", id, domain.ErrAPIKeyNotFound)
+		}
+		uc.logger.Error("Failed to revoke api key",
+			ports.NewField("error", err),
+			ports.NewField("api_key_id", id),
+		)
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	uc.logger.Info("API key revoked",
+		ports.NewField("api_key_id", id),
+		ports.NewField("actor", ports.ActorFromContext(ctx)),
+	)
+	return key, nil
+}
+
+func (uc *apiKeyUseCase) AuthenticateAPIKey(ctx context.Context, plaintext string) (*domain.APIKey, error) {
+	plaintext = strings.TrimSpace(plaintext)
+	if !strings.HasPrefix(plaintext, domain.APIKeyPrefix) {
+		return nil, fmt.Errorf("malformed api key: %w", domain.ErrInvalidAPIKey)
+	}
+
+	key, err := uc.repo.GetByHash(ctx, domain.HashAPIKey(plaintext))
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			return nil, fmt.Errorf("unknown api key: %w", domain.ErrInvalidAPIKey)
+		}
+		return nil, fmt.Errorf("failed to look up api key: %w", err)
+	}
+	if !key.Active() {
+		return nil, fmt.Errorf("api key %s is revoked: %w", key.Prefix, domain.ErrInvalidAPIKey)
+	}
+
+	now := uc.now()
+	if key.NeedsLastUsedUpdate(now) {
+		if err := uc.repo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			uc.logger.Warn("Failed to record api key usage",
+				ports.NewField("error", err),
+				ports.NewField("api_key_id", key.ID),
+			)
+		} else {
+			key.LastUsedAt = &now
+		}
+	}
+
+	return key, nil
+}
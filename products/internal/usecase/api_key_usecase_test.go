@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"product_service/products/mocks"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAPIKeyUseCase_CreateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockAPIKeyRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewAPIKeyUseCase(mockRepo, mockLogger)
+
+	ctx := ports.WithActor(ports.WithTenant(context.Background(), "acme"), "user:admin")
+	var stored *domain.APIKey
+	mockRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, key *domain.APIKey) error {
+		key.ID = 7
+		stored = key
+		return nil
+	})
+
+	key, plaintext, err := useCase.CreateAPIKey(ctx, "batch import", []string{"products:write", "products:read"}, 0)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if key.ID != 7 || key.TenantID != "acme" || key.CreatedBy != "user:admin" {
+		t.Errorf("Unexpected api key: %+v", key)
+	}
+	if stored.Hash != domain.HashAPIKey(plaintext) {
+		t.Errorf("Expected only the key hash to be stored")
+	}
+	if len(key.Scopes) != 2 || !key.HasScope(domain.ScopeProductsWrite) {
+		t.Errorf("Unexpected scopes: %v", key.Scopes)
+	}
+}
+
+func TestAPIKeyUseCase_CreateAPIKey_InvalidScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockAPIKeyRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewAPIKeyUseCase(mockRepo, mockLogger)
+
+	_, _, err := useCase.CreateAPIKey(context.Background(), "job", []string{"admin"}, 0)
+	if !errors.Is(err, domain.ErrInvalidAPIKeyScope) {
+		t.Errorf("Expected ErrInvalidAPIKeyScope, got: %v", err)
+	}
+}
+
+func TestAPIKeyUseCase_AuthenticateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockAPIKeyRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	useCase := &apiKeyUseCase{repo: mockRepo, logger: mockLogger, now: func() time.Time { return now }}
+
+	ctx := context.Background()
+	plaintext := "pk_0011aabb_secret"
+	stale := now.Add(-time.Hour)
+	mockRepo.EXPECT().GetByHash(ctx, domain.HashAPIKey(plaintext)).Return(&domain.APIKey{ID: 3, Prefix: "pk_0011aabb", LastUsedAt: &stale}, nil)
+	mockRepo.EXPECT().TouchLastUsed(ctx, int64(3), now).Return(nil)
+
+	key, err := useCase.AuthenticateAPIKey(ctx, plaintext)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if key.LastUsedAt == nil || !key.LastUsedAt.Equal(now) {
+		t.Errorf("Expected last used time to be recorded, got: %v", key.LastUsedAt)
+	}
+}
+
+func TestAPIKeyUseCase_AuthenticateAPIKey_Rejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockAPIKeyRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewAPIKeyUseCase(mockRepo, mockLogger)
+
+	ctx := context.Background()
+	revokedAt := time.Now()
+	mockRepo.EXPECT().GetByHash(ctx, domain.HashAPIKey("pk_unknown_key")).Return(nil, domain.ErrAPIKeyNotFound)
+	mockRepo.EXPECT().GetByHash(ctx, domain.HashAPIKey("pk_revoked_key")).Return(&domain.APIKey{ID: 4, RevokedAt: &revokedAt}, nil)
+
+	for _, plaintext := range []string{"not-a-key", "pk_unknown_key", "pk_revoked_key"} {
+		if _, err := useCase.AuthenticateAPIKey(ctx, plaintext); !errors.Is(err, domain.ErrInvalidAPIKey) {
+			t.Errorf("AuthenticateAPIKey(%q): expected ErrInvalidAPIKey, got: %v", plaintext, err)
+		}
+	}
+}
+
+func TestAPIKeyUseCase_RevokeAPIKey_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockAPIKeyRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewAPIKeyUseCase(mockRepo, mockLogger)
+
+	ctx := context.Background()
+	mockRepo.EXPECT().Revoke(ctx, int64(9)).Return(nil, domain.ErrAPIKeyNotFound)
+
+	if _, err := useCase.RevokeAPIKey(ctx, 9); !errors.Is(err, domain.ErrAPIKeyNotFound) {
+		t.Errorf("Expected ErrAPIKeyNotFound, got: %v", err)
+	}
+}
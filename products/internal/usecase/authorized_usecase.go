@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"context"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"time"
+)
+
+type authorizedProductUseCase struct {
+	next       ProductUseCase
+	authorizer ports.Authorizer
+}
+
+var _ Shutdownable = (*authorizedProductUseCase)(nil)
+
+func NewAuthorizedProductUseCase(next ProductUseCase, authorizer ports.Authorizer) ProductUseCase {
+	if authorizer == nil {
+		return next
+	}
+	return &authorizedProductUseCase{next: next, authorizer: authorizer}
+}
+
+func (uc *authorizedProductUseCase) CreateProduct(ctx context.Context, name string, price domain.Money, prices []domain.Money, attributes domain.Attributes, idempotencyKey string) (*domain.Product, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.CreateProduct(ctx, name, price, prices, attributes, idempotencyKey)
+}
+
+func (uc *authorizedProductUseCase) CreateProducts(ctx context.Context, inputs []ProductInput, mode BatchMode, idempotencyKey string) (BatchCreateResult, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return BatchCreateResult{}, err
+	}
+	return uc.next.CreateProducts(ctx, inputs, mode, idempotencyKey)
+}
+
+func (uc *authorizedProductUseCase) CreateVariant(ctx context.Context, parentID int, variant VariantInput, idempotencyKey string) (*domain.Product, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.CreateVariant(ctx, parentID, variant, idempotencyKey)
+}
+
+func (uc *authorizedProductUseCase) authorizeRead(ctx context.Context, includeDeleted bool) error {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsRead); err != nil {
+		return err
+	}
+	if includeDeleted {
+		return uc.authorizer.Authorize(ctx, domain.PermissionProductsAdmin)
+	}
+	return nil
+}
+
+func (uc *authorizedProductUseCase) GetProduct(ctx context.Context, id int, includeDeleted bool) (*domain.Product, error) {
+	if err := uc.authorizeRead(ctx, includeDeleted); err != nil {
+		return nil, err
+	}
+	return uc.next.GetProduct(ctx, id, includeDeleted)
+}
+
+func (uc *authorizedProductUseCase) GetProductsByIDs(ctx context.Context, ids []int, includeDeleted bool) ([]domain.Product, error) {
+	if err := uc.authorizeRead(ctx, includeDeleted); err != nil {
+		return nil, err
+	}
+	return uc.next.GetProductsByIDs(ctx, ids, includeDeleted)
+}
+
+func (uc *authorizedProductUseCase) GetProducts(ctx context.Context, query ports.ProductListQuery) (ports.ProductListResult, error) {
+	if err := uc.authorizeRead(ctx, query.IncludeDeleted); err != nil {
+		return ports.ProductListResult{}, err
+	}
+	return uc.next.GetProducts(ctx, query)
+}
+
+func (uc *authorizedProductUseCase) SearchProducts(ctx context.Context, query ports.ProductSearchQuery) (ports.ProductSearchResult, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsRead); err != nil {
+		return ports.ProductSearchResult{}, err
+	}
+	return uc.next.SearchProducts(ctx, query)
+}
+
+func (uc *authorizedProductUseCase) UpdateProduct(ctx context.Context, id int, update ProductUpdate, expectedVersion int, idempotencyKey string) (*domain.Product, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.UpdateProduct(ctx, id, update, expectedVersion, idempotencyKey)
+}
+
+func (uc *authorizedProductUseCase) DeleteProduct(ctx context.Context, id int, expectedVersion int, idempotencyKey string) error {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsDelete); err != nil {
+		return err
+	}
+	return uc.next.DeleteProduct(ctx, id, expectedVersion, idempotencyKey)
+}
+
+func (uc *authorizedProductUseCase) RestoreProduct(ctx context.Context, id int, expectedVersion int, idempotencyKey string) (*domain.Product, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.RestoreProduct(ctx, id, expectedVersion, idempotencyKey)
+}
+
+func (uc *authorizedProductUseCase) TransitionProduct(ctx context.Context, id int, target domain.ProductStatus, expectedVersion int, idempotencyKey string) (*domain.Product, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.TransitionProduct(ctx, id, target, expectedVersion, idempotencyKey)
+}
+
+func (uc *authorizedProductUseCase) ExportProducts(ctx context.Context, query ports.ProductListQuery, fn func(*domain.Product) error) (int, error) {
+	if err := uc.authorizeRead(ctx, query.IncludeDeleted); err != nil {
+		return 0, err
+	}
+	return uc.next.ExportProducts(ctx, query, fn)
+}
+
+func (uc *authorizedProductUseCase) ImportProducts(ctx context.Context, source ProductImportSource, idempotencyKey string) (ImportReport, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return ImportReport{}, err
+	}
+	return uc.next.ImportProducts(ctx, source, idempotencyKey)
+}
+
+func (uc *authorizedProductUseCase) Shutdown(ctx context.Context) error {
+	if shutdownable, ok := uc.next.(Shutdownable); ok {
+		return shutdownable.Shutdown(ctx)
+	}
+	return nil
+}
+
+type authorizedInventoryUseCase struct {
+	next       InventoryUseCase
+	authorizer ports.Authorizer
+}
+
+func NewAuthorizedInventoryUseCase(next InventoryUseCase, authorizer ports.Authorizer) InventoryUseCase {
+	if authorizer == nil {
+		return next
+	}
+	return &authorizedInventoryUseCase{next: next, authorizer: authorizer}
+}
+
+func (uc *authorizedInventoryUseCase) GetInventory(ctx context.Context, productID int) (*domain.Inventory, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsRead); err != nil {
+		return nil, err
+	}
+	return uc.next.GetInventory(ctx, productID)
+}
+
+func (uc *authorizedInventoryUseCase) AdjustStock(ctx context.Context, productID int, delta int, reason string, idempotencyKey string) (*domain.Inventory, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.AdjustStock(ctx, productID, delta, reason, idempotencyKey)
+}
+
+func (uc *authorizedInventoryUseCase) ReserveStock(ctx context.Context, productID int, quantity int, ttl time.Duration, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, nil, err
+	}
+	return uc.next.ReserveStock(ctx, productID, quantity, ttl, idempotencyKey)
+}
+
+func (uc *authorizedInventoryUseCase) ConfirmReservation(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, nil, err
+	}
+	return uc.next.ConfirmReservation(ctx, reservationID, idempotencyKey)
+}
+
+func (uc *authorizedInventoryUseCase) ReleaseReservation(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, nil, err
+	}
+	return uc.next.ReleaseReservation(ctx, reservationID, idempotencyKey)
+}
+
+type authorizedCategoryUseCase struct {
+	next       CategoryUseCase
+	authorizer ports.Authorizer
+}
+
+func NewAuthorizedCategoryUseCase(next CategoryUseCase, authorizer ports.Authorizer) CategoryUseCase {
+	if authorizer == nil {
+		return next
+	}
+	return &authorizedCategoryUseCase{next: next, authorizer: authorizer}
+}
+
+func (uc *authorizedCategoryUseCase) CreateCategory(ctx context.Context, name, slug string, parentID *int, idempotencyKey string) (*domain.Category, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.CreateCategory(ctx, name, slug, parentID, idempotencyKey)
+}
+
+func (uc *authorizedCategoryUseCase) GetCategory(ctx context.Context, id int) (*domain.Category, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsRead); err != nil {
+		return nil, err
+	}
+	return uc.next.GetCategory(ctx, id)
+}
+
+func (uc *authorizedCategoryUseCase) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsRead); err != nil {
+		return nil, err
+	}
+	return uc.next.ListCategories(ctx)
+}
+
+func (uc *authorizedCategoryUseCase) UpdateCategory(ctx context.Context, id int, update CategoryUpdate, expectedVersion int, idempotencyKey string) (*domain.Category, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.UpdateCategory(ctx, id, update, expectedVersion, idempotencyKey)
+}
+
+func (uc *authorizedCategoryUseCase) MoveCategory(ctx context.Context, id int, parentID *int, expectedVersion int, idempotencyKey string) (*domain.Category, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.MoveCategory(ctx, id, parentID, expectedVersion, idempotencyKey)
+}
+
+func (uc *authorizedCategoryUseCase) DeleteCategory(ctx context.Context, id int, expectedVersion int, idempotencyKey string) error {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsDelete); err != nil {
+		return err
+	}
+	return uc.next.DeleteCategory(ctx, id, expectedVersion, idempotencyKey)
+}
+
+func (uc *authorizedCategoryUseCase) GetProductCategories(ctx context.Context, productID int) ([]domain.Category, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsRead); err != nil {
+		return nil, err
+	}
+	return uc.next.GetProductCategories(ctx, productID)
+}
+
+func (uc *authorizedCategoryUseCase) SetProductCategories(ctx context.Context, productID int, categoryIDs []int) ([]domain.Category, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsWrite); err != nil {
+		return nil, err
+	}
+	return uc.next.SetProductCategories(ctx, productID, categoryIDs)
+}
+
+type authorizedProductHistoryUseCase struct {
+	next       ProductHistoryUseCase
+	authorizer ports.Authorizer
+}
+
+func NewAuthorizedProductHistoryUseCase(next ProductHistoryUseCase, authorizer ports.Authorizer) ProductHistoryUseCase {
+	if authorizer == nil {
+		return next
+	}
+	return &authorizedProductHistoryUseCase{next: next, authorizer: authorizer}
+}
+
+func (uc *authorizedProductHistoryUseCase) GetProductHistory(ctx context.Context, productID int, page, limit int) (ProductHistoryPage, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionProductsRead); err != nil {
+		return ProductHistoryPage{}, err
+	}
+	return uc.next.GetProductHistory(ctx, productID, page, limit)
+}
+
+type authorizedAPIKeyUseCase struct {
+	next       APIKeyUseCase
+	authorizer ports.Authorizer
+}
+
+func NewAuthorizedAPIKeyUseCase(next APIKeyUseCase, authorizer ports.Authorizer) APIKeyUseCase {
+	if authorizer == nil {
+		return next
+	}
+	return &authorizedAPIKeyUseCase{next: next, authorizer: authorizer}
+}
+
+func (uc *authorizedAPIKeyUseCase) CreateAPIKey(ctx context.Context, name string, scopes []string, rateLimit int) (*domain.APIKey, string, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionAPIKeysManage); err != nil {
+		return nil, "", err
+	}
+	return uc.next.CreateAPIKey(ctx, name, scopes, rateLimit)
+}
+
+func (uc *authorizedAPIKeyUseCase) ListAPIKeys(ctx context.Context, page, limit int) (APIKeyPage, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionAPIKeysManage); err != nil {
+		return APIKeyPage{}, err
+	}
+	return uc.next.ListAPIKeys(ctx, page, limit)
+}
+
+func (uc *authorizedAPIKeyUseCase) SetAPIKeyScopes(ctx context.Context, id int64, scopes []string) (*domain.APIKey, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionAPIKeysManage); err != nil {
+		return nil, err
+	}
+	return uc.next.SetAPIKeyScopes(ctx, id, scopes)
+}
+
+func (uc *authorizedAPIKeyUseCase) RevokeAPIKey(ctx context.Context, id int64) (*domain.APIKey, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionAPIKeysManage); err != nil {
+		return nil, err
+	}
+	return uc.next.RevokeAPIKey(ctx, id)
+}
+
+func (uc *authorizedAPIKeyUseCase) AuthenticateAPIKey(ctx context.Context, plaintext string) (*domain.APIKey, error) {
+	return uc.next.AuthenticateAPIKey(ctx, plaintext)
+}
+
+type authorizedOutboxAdminUseCase struct {
+	next       OutboxAdminUseCase
+	authorizer ports.Authorizer
+}
+
+func NewAuthorizedOutboxAdminUseCase(next OutboxAdminUseCase, authorizer ports.Authorizer) OutboxAdminUseCase {
+	if authorizer == nil {
+		return next
+	}
+	return &authorizedOutboxAdminUseCase{next: next, authorizer: authorizer}
+}
+
+func (uc *authorizedOutboxAdminUseCase) ListDeadLetters(ctx context.Context, page, limit int) (DeadLetterPage, error) {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionOutboxManage); err != nil {
+		return DeadLetterPage{}, err
+	}
+	return uc.next.ListDeadLetters(ctx, page, limit)
+}
+
+func (uc *authorizedOutboxAdminUseCase) RetryDeadLetter(ctx context.Context, eventID int64) error {
+	if err := uc.authorizer.Authorize(ctx, domain.PermissionOutboxManage); err != nil {
+		return err
+	}
+	return uc.next.RetryDeadLetter(ctx, eventID)
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"product_service/products/internal/domain"
+	domainServices "product_service/products/internal/domain/services"
+	"product_service/products/internal/usecase/ports"
+	"product_service/products/mocks"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthorizedProductUseCase_DeniesBeforeDelegating(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockAuthorizer := mocks.NewMockAuthorizer(ctrl)
+
+	useCase := NewAuthorizedProductUseCase(
+		NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger),
+		mockAuthorizer,
+	)
+
+	ctx := context.Background()
+	mockAuthorizer.EXPECT().Authorize(ctx, domain.PermissionProductsDelete).Return(domain.ErrForbidden)
+
+	err := useCase.DeleteProduct(ctx, 1, 0, "")
+	if !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got: %v", err)
+	}
+}
+
+func TestAuthorizedProductUseCase_DelegatesWhenAllowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockAuthorizer := mocks.NewMockAuthorizer(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewAuthorizedProductUseCase(
+		NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger),
+		mockAuthorizer,
+	)
+
+	ctx := context.Background()
+	product := &domain.Product{ID: 1}
+	mockAuthorizer.EXPECT().Authorize(ctx, domain.PermissionProductsRead).Return(nil)
+	mockRepo.EXPECT().GetByID(ctx, 1, false).Return(product, nil)
+
+	got, err := useCase.GetProduct(ctx, 1, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if got != product {
+		t.Errorf("Expected product from inner use case, got: %+v", got)
+	}
+}
+
+func TestAuthorizedProductUseCase_IncludeDeletedRequiresAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockAuthorizer := mocks.NewMockAuthorizer(ctrl)
+
+	useCase := NewAuthorizedProductUseCase(
+		NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger),
+		mockAuthorizer,
+	)
+
+	ctx := context.Background()
+	mockAuthorizer.EXPECT().Authorize(ctx, domain.PermissionProductsRead).Return(nil).Times(4)
+	mockAuthorizer.EXPECT().Authorize(ctx, domain.PermissionProductsAdmin).Return(domain.ErrForbidden).Times(4)
+
+	if _, err := useCase.GetProduct(ctx, 1, true); !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("GetProduct: expected ErrForbidden, got: %v", err)
+	}
+	if _, err := useCase.GetProductsByIDs(ctx, []int{1}, true); !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("GetProductsByIDs: expected ErrForbidden, got: %v", err)
+	}
+	if _, err := useCase.GetProducts(ctx, ports.ProductListQuery{IncludeDeleted: true}); !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("GetProducts: expected ErrForbidden, got: %v", err)
+	}
+	_, err := useCase.ExportProducts(ctx, ports.ProductListQuery{IncludeDeleted: true}, func(*domain.Product) error { return nil })
+	if !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("ExportProducts: expected ErrForbidden, got: %v", err)
+	}
+}
+
+func TestAuthorizedAPIKeyUseCase_AuthenticateIsNotGuarded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockAPIKeyRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockAuthorizer := mocks.NewMockAuthorizer(ctrl)
+
+	useCase := NewAuthorizedAPIKeyUseCase(NewAPIKeyUseCase(mockRepo, mockLogger), mockAuthorizer)
+
+	ctx := context.Background()
+	mockRepo.EXPECT().GetByHash(ctx, gomock.Any()).Return(nil, domain.ErrAPIKeyNotFound)
+
+	if _, err := useCase.AuthenticateAPIKey(ctx, "pk_deadbeef_secret"); !errors.Is(err, domain.ErrInvalidAPIKey) {
+		t.Errorf("Expected ErrInvalidAPIKey, got: %v", err)
+	}
+
+	mockAuthorizer.EXPECT().Authorize(ctx, domain.PermissionAPIKeysManage).Return(domain.ErrInsufficientScope)
+	if _, err := useCase.RevokeAPIKey(ctx, 3); !errors.Is(err, domain.ErrInsufficientScope) {
+		t.Errorf("Expected ErrInsufficientScope, got: %v", err)
+	}
+}
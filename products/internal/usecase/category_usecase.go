@@ -0,0 +1,343 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/domain/services"
+	"product_service/products/internal/usecase/ports"
+)
+
+const MaxCategoriesPerProduct = 20
+
+type CategoryUseCase interface {
+	CreateCategory(ctx context.Context, name, slug string, parentID *int, idempotencyKey string) (*domain.Category, error)
+	GetCategory(ctx context.Context, id int) (*domain.Category, error)
+	ListCategories(ctx context.Context) ([]domain.Category, error)
+	UpdateCategory(ctx context.Context, id int, update CategoryUpdate, expectedVersion int, idempotencyKey string) (*domain.Category, error)
+	MoveCategory(ctx context.Context, id int, parentID *int, expectedVersion int, idempotencyKey string) (*domain.Category, error)
+	DeleteCategory(ctx context.Context, id int, expectedVersion int, idempotencyKey string) error
+	GetProductCategories(ctx context.Context, productID int) ([]domain.Category, error)
+	SetProductCategories(ctx context.Context, productID int, categoryIDs []int) ([]domain.Category, error)
+}
+
+type CategoryUpdate struct {
+	Name *string
+	Slug *string
+}
+
+type categoryUseCase struct {
+	categoryRepo  ports.CategoryRepository
+	productRepo   ports.ProductRepository
+	appService    ports.CategoryApplicationService
+	domainService services.CategoryDomainService
+	logger        ports.Logger
+}
+
+func NewCategoryUseCase(
+	categoryRepo ports.CategoryRepository,
+	productRepo ports.ProductRepository,
+	appService ports.CategoryApplicationService,
+	domainService services.CategoryDomainService,
+	logger ports.Logger,
+) CategoryUseCase {
+	return &categoryUseCase{
+		categoryRepo:  categoryRepo,
+		productRepo:   productRepo,
+		appService:    appService,
+		domainService: domainService,
+		logger:        logger,
+	}
+}
+
+func (uc *categoryUseCase) CreateCategory(ctx context.Context, name, slug string, parentID *int, idempotencyKey string) (*domain.Category, error) {
+	parent, err := uc.getParent(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.domainService.ValidateCategoryParent(parent); err != nil {
+		uc.logger.Warn("Category parent validation failed",
+			ports.NewField("error", err),
+			ports.NewField("parent_id", parentID),
+		)
+		return nil, fmt.Errorf("category validation failed: %w", err)
+	}
+
+	category, err := domain.NewCategory(name, slug, parent)
+	if err != nil {
+		uc.logger.Warn("Failed to create category entity",
+			ports.NewField("error", err),
+			ports.NewField("name", name),
+		)
+		return nil, fmt.Errorf("category validation failed: %w", err)
+	}
+
+	if err := uc.appService.CreateCategoryWithEvent(ctx, category, idempotencyKey); err != nil {
+		uc.logger.Error("Failed to create category",
+			ports.NewField("error", err),
+			ports.NewField("slug", category.Slug),
+		)
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (uc *categoryUseCase) GetCategory(ctx context.Context, id int) (*domain.Category, error) {
+	if id <= 0 {
+		uc.logger.Warn("Invalid category ID",
+			ports.NewField("category_id", id),
+		)
+		return nil, fmt.Errorf("invalid category id: %w", domain.ErrInvalidInput)
+	}
+
+	category, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			return nil, fmt.Errorf("category not found: %w", domain.ErrCategoryNotFound)
+		}
+		uc.logger.Error("Failed to get category from repository",
+			ports.NewField("error", err),
+			ports.NewField("category_id", id),
+		)
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (uc *categoryUseCase) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	categories, err := uc.categoryRepo.List(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list categories",
+			ports.NewField("error", err),
+		)
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (uc *categoryUseCase) UpdateCategory(ctx context.Context, id int, update CategoryUpdate, expectedVersion int, idempotencyKey string) (*domain.Category, error) {
+	if update.Name == nil && update.Slug == nil {
+		uc.logger.Warn("Empty category update",
+			ports.NewField("category_id", id),
+		)
+		return nil, fmt.Errorf("no fields to update: %w", domain.ErrInvalidInput)
+	}
+
+	category, err := uc.getForChange(ctx, id, expectedVersion, "update")
+	if err != nil {
+		return nil, err
+	}
+
+	name := category.Name
+	if update.Name != nil {
+		name = *update.Name
+	}
+	slug := category.Slug
+	if update.Slug != nil {
+		slug = *update.Slug
+	}
+
+	if err := category.Rename(name, slug); err != nil {
+		uc.logger.Warn("Category update validation failed",
+			ports.NewField("error", err),
+			ports.NewField("category_id", id),
+		)
+		return nil, fmt.Errorf("category validation failed: %w", err)
+	}
+
+	if len(category.DomainEvents()) == 0 {
+		uc.logger.Debug("Category update is a no-op",
+			ports.NewField("category_id", id),
+		)
+		return category, nil
+	}
+
+	if err := uc.appService.UpdateCategoryWithEvent(ctx, category, idempotencyKey); err != nil {
+		uc.logger.Error("Failed to update category",
+			ports.NewField("error", err),
+			ports.NewField("category_id", id),
+		)
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (uc *categoryUseCase) MoveCategory(ctx context.Context, id int, parentID *int, expectedVersion int, idempotencyKey string) (*domain.Category, error) {
+	check := func(category, parent *domain.Category, subtreeHeight int) error {
+		return uc.domainService.CanMoveCategory(category, parent, subtreeHeight)
+	}
+
+	category, err := uc.appService.MoveCategoryWithEvent(ctx, id, parentID, expectedVersion, check, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) ||
+			errors.Is(err, domain.ErrVersionConflict) ||
+			errors.Is(err, domain.ErrInvalidCategoryMove) {
+			uc.logger.Warn("Category move rejected",
+				ports.NewField("error", err),
+				ports.NewField("category_id", id),
+				ports.NewField("parent_id", parentID),
+				ports.NewField("expected_version", expectedVersion),
+			)
+			return nil, fmt.Errorf("cannot move category: %w", err)
+		}
+		uc.logger.Error("Failed to move category",
+			ports.NewField("error", err),
+			ports.NewField("category_id", id),
+		)
+		return nil, fmt.Errorf("failed to move category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (uc *categoryUseCase) DeleteCategory(ctx context.Context, id int, expectedVersion int, idempotencyKey string) error {
+	category, err := uc.getForChange(ctx, id, expectedVersion, "delete")
+	if err != nil {
+		return err
+	}
+
+	usage, err := uc.categoryRepo.Usage(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get category usage",
+			ports.NewField("error", err),
+			ports.NewField("category_id", id),
+		)
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	if err := uc.domainService.CanDeleteCategory(category, usage); err != nil {
+		uc.logger.Warn("Category deletion validation failed",
+			ports.NewField("error", err),
+			ports.NewField("category_id", id),
+		)
+		return fmt.Errorf("cannot delete category: %w", err)
+	}
+
+	category.RecordDeleteEvent()
+
+	if err := uc.appService.DeleteCategoryWithEvent(ctx, category, idempotencyKey); err != nil {
+		uc.logger.Error("Failed to delete category",
+			ports.NewField("error", err),
+			ports.NewField("category_id", id),
+		)
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *categoryUseCase) GetProductCategories(ctx context.Context, productID int) ([]domain.Category, error) {
+	if err := uc.ensureProductExists(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	categories, err := uc.categoryRepo.ListProductCategories(ctx, productID)
+	if err != nil {
+		uc.logger.Error("Failed to list product categories",
+			ports.NewField("error", err),
+			ports.NewField("product_id", productID),
+		)
+		return nil, fmt.Errorf("failed to get product categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (uc *categoryUseCase) SetProductCategories(ctx context.Context, productID int, categoryIDs []int) ([]domain.Category, error) {
+	if len(categoryIDs) > MaxCategoriesPerProduct {
+		return nil, fmt.Errorf("a product can belong to at most %d categories: %w", MaxCategoriesPerProduct, domain.ErrInvalidInput)
+	}
+
+	seen := make(map[int]struct{}, len(categoryIDs))
+	unique := make([]int, 0, len(categoryIDs))
+	for _, id := range categoryIDs {
+		if id <= 0 {
+			return nil, fmt.Errorf("invalid category id %d: %w", id, domain.ErrInvalidInput)
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	if err := uc.ensureProductExists(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.categoryRepo.SetProductCategories(ctx, productID, unique); err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			uc.logger.Warn("Unknown category in product assignment",
+				ports.NewField("product_id", productID),
+				ports.NewField("category_ids", unique),
+			)
+			return nil, fmt.Errorf("category not found: %w", domain.ErrCategoryNotFound)
+		}
+		uc.logger.Error("Failed to assign product categories",
+			ports.NewField("error", err),
+			ports.NewField("product_id", productID),
+		)
+		return nil, fmt.Errorf("failed to assign product categories: %w", err)
+	}
+
+	return uc.GetProductCategories(ctx, productID)
+}
+
+func (uc *categoryUseCase) getForChange(ctx context.Context, id int, expectedVersion int, operation string) (*domain.Category, error) {
+	category, err := uc.GetCategory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := category.CheckVersion(expectedVersion); err != nil {
+		uc.logger.Warn("Category version mismatch",
+			ports.NewField("category_id", id),
+			ports.NewField("operation", operation),
+			ports.NewField("expected_version", expectedVersion),
+			ports.NewField("current_version", category.Version),
+		)
+		return nil, fmt.Errorf("cannot %s category: %w", operation, err)
+	}
+
+	return category, nil
+}
+
+func (uc *categoryUseCase) getParent(ctx context.Context, parentID *int) (*domain.Category, error) {
+	if parentID == nil {
+		return nil, nil
+	}
+
+	parent, err := uc.GetCategory(ctx, *parentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			return nil, fmt.Errorf("parent category %d: %w", *parentID, domain.ErrCategoryNotFound)
+		}
+		return nil, err
+	}
+
+	return parent, nil
+}
+
+func (uc *categoryUseCase) ensureProductExists(ctx context.Context, productID int) error {
+	if productID <= 0 {
+		uc.logger.Warn("Invalid product ID for categories",
+			ports.NewField("product_id", productID),
+		)
+		return fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+
+	if _, err := uc.productRepo.GetByID(ctx, productID, false); err != nil {
+		if errors.Is(err, domain.ErrProductNotFound) {
+			return fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+		}
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+
+	return nil
+}
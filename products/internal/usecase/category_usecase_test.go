@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/domain/services"
+	"product_service/products/internal/usecase/ports"
+	"product_service/products/mocks"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestCategoryUseCase_MoveCategory_UnderDescendant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockProductRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockCategoryApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewCategoryUseCase(mockCategoryRepo, mockProductRepo, mockAppService, services.NewCategoryDomainService(), mockLogger)
+
+	ctx := context.Background()
+	parentID := 4
+	category := &domain.Category{ID: 1, Name: "Clothing", Slug: "clothing", Path: "/1/", Version: 2}
+	parent := &domain.Category{ID: 4, Name: "Shirts", Slug: "shirts", ParentID: &category.ID, Path: "/1/4/"}
+
+	mockAppService.EXPECT().
+		MoveCategoryWithEvent(ctx, 1, &parentID, 2, gomock.Any(), "").
+		DoAndReturn(func(ctx context.Context, id int, parentID *int, expectedVersion int, check ports.CategoryMoveCheck, key string) (*domain.Category, error) {
+			return nil, check(category, parent, 2)
+		})
+
+	_, err := useCase.MoveCategory(ctx, 1, &parentID, 2, "")
+	if !errors.Is(err, domain.ErrInvalidCategoryMove) {
+		t.Errorf("Expected ErrInvalidCategoryMove, got: %v", err)
+	}
+}
+
+func TestCategoryUseCase_MoveCategory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockProductRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockCategoryApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewCategoryUseCase(mockCategoryRepo, mockProductRepo, mockAppService, services.NewCategoryDomainService(), mockLogger)
+
+	ctx := context.Background()
+	parentID := 2
+	category := &domain.Category{ID: 5, Name: "Shirts", Slug: "shirts", Path: "/5/", Version: 1}
+	parent := &domain.Category{ID: 2, Name: "Clothing", Slug: "clothing", Path: "/2/"}
+
+	mockAppService.EXPECT().
+		MoveCategoryWithEvent(ctx, 5, &parentID, 1, gomock.Any(), "move-key").
+		DoAndReturn(func(ctx context.Context, id int, parentID *int, expectedVersion int, check ports.CategoryMoveCheck, key string) (*domain.Category, error) {
+			if err := check(category, parent, 1); err != nil {
+				return nil, err
+			}
+			if err := category.MoveTo(parent); err != nil {
+				return nil, err
+			}
+			return category, nil
+		})
+
+	moved, err := useCase.MoveCategory(ctx, 5, &parentID, 1, "move-key")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if moved.Path != "/2/5/" {
+		t.Errorf("Expected path /2/5/, got %s", moved.Path)
+	}
+	if moved.ParentID == nil || *moved.ParentID != 2 {
+		t.Errorf("Expected parent 2, got %v", moved.ParentID)
+	}
+}
+
+func TestCategoryUseCase_DeleteCategory_InUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockProductRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockCategoryApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewCategoryUseCase(mockCategoryRepo, mockProductRepo, mockAppService, services.NewCategoryDomainService(), mockLogger)
+
+	ctx := context.Background()
+
+	mockCategoryRepo.EXPECT().
+		GetByID(ctx, 3).
+		Return(&domain.Category{ID: 3, Name: "Shoes", Slug: "shoes", Path: "/3/", Version: 1}, nil)
+	mockCategoryRepo.EXPECT().
+		Usage(ctx, 3).
+		Return(domain.CategoryUsage{Products: 2}, nil)
+
+	err := useCase.DeleteCategory(ctx, 3, 1, "")
+	if !errors.Is(err, domain.ErrCategoryInUse) {
+		t.Errorf("Expected ErrCategoryInUse, got: %v", err)
+	}
+}
+
+func TestCategoryUseCase_SetProductCategories_Deduplicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockProductRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockCategoryApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewCategoryUseCase(mockCategoryRepo, mockProductRepo, mockAppService, services.NewCategoryDomainService(), mockLogger)
+
+	ctx := context.Background()
+
+	mockProductRepo.EXPECT().
+		GetByID(ctx, 1, false).
+		Return(&domain.Product{ID: 1}, nil).
+		Times(2)
+	mockCategoryRepo.EXPECT().
+		SetProductCategories(ctx, 1, []int{3, 5}).
+		Return(nil)
+	mockCategoryRepo.EXPECT().
+		ListProductCategories(ctx, 1).
+		Return([]domain.Category{{ID: 3}, {ID: 5}}, nil)
+
+	categories, err := useCase.SetProductCategories(ctx, 1, []int{3, 5, 3})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(categories) != 2 {
+		t.Errorf("Expected 2 categories, got %d", len(categories))
+	}
+}
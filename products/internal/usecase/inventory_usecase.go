@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	DefaultReservationTTL = 15 * time.Minute
+	MaxReservationTTL     = 24 * time.Hour
+	MaxStockAdjustment    = 1_000_000
+	maxStockReasonLength  = 255
+)
+
+type InventoryUseCase interface {
+	GetInventory(ctx context.Context, productID int) (*domain.Inventory, error)
+	AdjustStock(ctx context.Context, productID int, delta int, reason string, idempotencyKey string) (*domain.Inventory, error)
+	ReserveStock(ctx context.Context, productID int, quantity int, ttl time.Duration, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error)
+	ConfirmReservation(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error)
+	ReleaseReservation(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error)
+}
+
+type inventoryUseCase struct {
+	productRepo   ports.ProductRepository
+	inventoryRepo ports.InventoryRepository
+	appService    ports.InventoryApplicationService
+	logger        ports.Logger
+}
+
+func NewInventoryUseCase(
+	productRepo ports.ProductRepository,
+	inventoryRepo ports.InventoryRepository,
+	appService ports.InventoryApplicationService,
+	logger ports.Logger,
+) InventoryUseCase {
+	return &inventoryUseCase{
+		productRepo:   productRepo,
+		inventoryRepo: inventoryRepo,
+		appService:    appService,
+		logger:        logger,
+	}
+}
+
+func (uc *inventoryUseCase) GetInventory(ctx context.Context, productID int) (*domain.Inventory, error) {
+	if err := uc.ensureProductExists(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	inventory, err := uc.inventoryRepo.Get(ctx, productID)
+	if err != nil {
+		uc.logger.Error("Failed to get inventory from repository",
+			ports.NewField("error", err),
+			ports.NewField("product_id", productID),
+		)
+		return nil, fmt.Errorf("failed to get inventory: %w", err)
+	}
+
+	return inventory, nil
+}
+
+func (uc *inventoryUseCase) AdjustStock(ctx context.Context, productID int, delta int, reason string, idempotencyKey string) (*domain.Inventory, error) {
+	if delta == 0 || delta > MaxStockAdjustment || delta < -MaxStockAdjustment {
+		uc.logger.Warn("Invalid stock adjustment",
+			ports.NewField("product_id", productID),
+			ports.NewField("delta", delta),
+		)
+		return nil, fmt.Errorf("stock adjustment must be non-zero and at most %d units: %w", MaxStockAdjustment, domain.ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(reason) > maxStockReasonLength {
+		return nil, fmt.Errorf("stock adjustment reason must be at most %d characters: %w", maxStockReasonLength, domain.ErrInvalidInput)
+	}
+
+	if err := uc.ensureProductExists(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	inventory, err := uc.appService.AdjustStockWithEvent(ctx, productID, delta, reason, idempotencyKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to adjust stock: %w", err)
+	}
+
+	return inventory, nil
+}
+
+func (uc *inventoryUseCase) ReserveStock(ctx context.Context, productID int, quantity int, ttl time.Duration, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error) {
+	if quantity <= 0 || quantity > MaxStockAdjustment {
+		uc.logger.Warn("Invalid reservation quantity",
+			ports.NewField("product_id", productID),
+			ports.NewField("quantity", quantity),
+		)
+		return nil, nil, fmt.Errorf("reservation quantity must be between 1 and %d: %w", MaxStockAdjustment, domain.ErrInvalidInput)
+	}
+	if ttl == 0 {
+		ttl = DefaultReservationTTL
+	}
+	if ttl < 0 || ttl > MaxReservationTTL {
+		return nil, nil, fmt.Errorf("reservation ttl must be positive and at most %s: %w", MaxReservationTTL, domain.ErrInvalidInput)
+	}
+
+	if err := uc.ensureProductExists(ctx, productID); err != nil {
+		return nil, nil, err
+	}
+
+	expiresAt := time.Now().UTC().Add(ttl)
+	reservation, inventory, err := uc.appService.ReserveStockWithEvent(ctx, productID, quantity, expiresAt, idempotencyKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to reserve stock: %w", err)
+	}
+
+	return reservation, inventory, nil
+}
+
+func (uc *inventoryUseCase) ConfirmReservation(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error) {
+	if reservationID <= 0 {
+		return nil, nil, fmt.Errorf("invalid reservation id: %w", domain.ErrInvalidInput)
+	}
+
+	reservation, inventory, err := uc.appService.ConfirmReservationWithEvent(ctx, reservationID, idempotencyKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to confirm reservation: %w", err)
+	}
+
+	return reservation, inventory, nil
+}
+
+func (uc *inventoryUseCase) ReleaseReservation(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error) {
+	if reservationID <= 0 {
+		return nil, nil, fmt.Errorf("invalid reservation id: %w", domain.ErrInvalidInput)
+	}
+
+	reservation, inventory, err := uc.appService.ReleaseReservationWithEvent(ctx, reservationID, idempotencyKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to release reservation: %w", err)
+	}
+
+	return reservation, inventory, nil
+}
+
+func (uc *inventoryUseCase) ensureProductExists(ctx context.Context, productID int) error {
+	if productID <= 0 {
+		uc.logger.Warn("Invalid product ID for inventory",
+			ports.NewField("product_id", productID),
+		)
+		return fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+
+	if _, err := uc.productRepo.GetByID(ctx, productID, false); err != nil {
+		if errors.Is(err, domain.ErrProductNotFound) {
+			return fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+		}
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"product_service/products/internal/domain"
+	"product_service/products/mocks"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestInventoryUseCase_ReserveStock_DefaultTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := mocks.NewMockProductRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockAppService := mocks.NewMockInventoryApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewInventoryUseCase(mockProductRepo, mockInventoryRepo, mockAppService, mockLogger)
+
+	ctx := context.Background()
+	before := time.Now().UTC()
+
+	mockProductRepo.EXPECT().
+		GetByID(ctx, 1, false).
+		Return(&domain.Product{ID: 1}, nil)
+
+	mockAppService.EXPECT().
+		ReserveStockWithEvent(ctx, 1, 3, gomock.Any(), "reserve-key").
+		DoAndReturn(func(ctx context.Context, productID int, quantity int, expiresAt time.Time, key string) (*domain.Reservation, *domain.Inventory, error) {
+			if expiresAt.Before(before.Add(DefaultReservationTTL)) || expiresAt.After(time.Now().UTC().Add(DefaultReservationTTL)) {
+				t.Errorf("Expected expiry %s after now, got %s", DefaultReservationTTL, expiresAt)
+			}
+			inventory := domain.NewInventory(productID)
+			inventory.OnHand = 10
+			inventory.Reserved = quantity
+			return &domain.Reservation{ID: 7, ProductID: productID, Quantity: quantity, Status: domain.ReservationStatusPending, ExpiresAt: expiresAt}, inventory, nil
+		})
+
+	reservation, inventory, err := useCase.ReserveStock(ctx, 1, 3, 0, "reserve-key")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if reservation.ID != 7 {
+		t.Errorf("Expected reservation ID 7, got %d", reservation.ID)
+	}
+
+	if inventory.Available() != 7 {
+		t.Errorf("Expected 7 available, got %d", inventory.Available())
+	}
+}
+
+func TestInventoryUseCase_ReserveStock_InvalidInput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := mocks.NewMockProductRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockAppService := mocks.NewMockInventoryApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewInventoryUseCase(mockProductRepo, mockInventoryRepo, mockAppService, mockLogger)
+
+	ctx := context.Background()
+
+	if _, _, err := useCase.ReserveStock(ctx, 1, 0, 0, ""); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for zero quantity, got: %v", err)
+	}
+
+	if _, _, err := useCase.ReserveStock(ctx, 1, 1, MaxReservationTTL+time.Second, ""); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for excessive ttl, got: %v", err)
+	}
+
+	if _, err := useCase.AdjustStock(ctx, 1, 0, "", ""); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for zero delta, got: %v", err)
+	}
+}
+
+func TestInventoryUseCase_AdjustStock_ProductNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := mocks.NewMockProductRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockAppService := mocks.NewMockInventoryApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewInventoryUseCase(mockProductRepo, mockInventoryRepo, mockAppService, mockLogger)
+
+	ctx := context.Background()
+
+	mockProductRepo.EXPECT().
+		GetByID(ctx, 99, false).
+		Return(nil, domain.ErrProductNotFound)
+
+	_, err := useCase.AdjustStock(ctx, 99, 5, "restock", "")
+	if !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("Expected ErrProductNotFound, got: %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	DefaultDeadLetterPageSize = 20
+	MaxDeadLetterPageSize     = 100
+)
+
+type OutboxAdminUseCase interface {
+	ListDeadLetters(ctx context.Context, page, limit int) (DeadLetterPage, error)
+	RetryDeadLetter(ctx context.Context, eventID int64) error
+}
+
+type DeadLetterPage struct {
+	Events []ports.OutboxEvent
+	Page   int
+	Limit  int
+	Total  int
+}
+
+type outboxAdminUseCase struct {
+	repo   ports.OutboxAdminRepository
+	logger ports.Logger
+}
+
+func NewOutboxAdminUseCase(repo ports.OutboxAdminRepository, logger ports.Logger) OutboxAdminUseCase {
+	return &outboxAdminUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (uc *outboxAdminUseCase) ListDeadLetters(ctx context.Context, page, limit int) (DeadLetterPage, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = DefaultDeadLetterPageSize
+	}
+	if limit > MaxDeadLetterPageSize {
+		limit = MaxDeadLetterPageSize
+	}
+
+	events, total, err := uc.repo.ListDeadLetters(ctx, limit, (page-1)*limit)
+	if err != nil {
+		uc.logger.Error("Failed to list dead-letter events", ports.NewField("error", err))
+		return DeadLetterPage{}, fmt.Errorf("failed to list dead-letter events: %w", err)
+	}
+
+	return DeadLetterPage{
+		Events: events,
+		Page:   page,
+		Limit:  limit,
+		Total:  total,
+	}, nil
+}
+
+func (uc *outboxAdminUseCase) RetryDeadLetter(ctx context.Context, eventID int64) error {
+	if eventID <= 0 {
+		return fmt.Errorf("invalid event id: %w", domain.ErrInvalidInput)
+	}
+
+	if err := uc.repo.RequeueDeadLetter(ctx, eventID); err != nil {
+		if errors.Is(err, domain.ErrDeadLetterNotFound) {
+			return err
+		}
+		uc.logger.Error("Failed to requeue dead-letter event",
+			ports.NewField("error", err),
+			ports.NewField("event_id", eventID),
+		)
+		return fmt.Errorf("failed to requeue dead-letter event: %w", err)
+	}
+
+	uc.logger.Info("Dead-letter event requeued",
+		ports.NewField("event_id", eventID),
+		ports.NewField("actor", ports.ActorFromContext(ctx)),
+	)
+	return nil
+}
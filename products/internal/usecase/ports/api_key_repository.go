@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"product_service/products/internal/domain"
+	"time"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *domain.APIKey) error
+	GetByHash(ctx context.Context, hash string) (*domain.APIKey, error)
+	List(ctx context.Context, limit, offset int) ([]domain.APIKey, int, error)
+	UpdateScopes(ctx context.Context, id int64, scopes []domain.APIKeyScope) (*domain.APIKey, error)
+	Revoke(ctx context.Context, id int64) (*domain.APIKey, error)
+	TouchLastUsed(ctx context.Context, id int64, usedAt time.Time) error
+}
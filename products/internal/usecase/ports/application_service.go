@@ -3,14 +3,47 @@ package ports
 import (
 	"context"
 	"product_service/products/internal/domain"
+	"time"
 )
 
 type ProductApplicationService interface {
 	CreateProductWithEvent(ctx context.Context, product *domain.Product, idempotencyKey string) error
 	
+	CreateProductsWithEvents(ctx context.Context, products []*domain.Product, idempotencyKey string) error
+	
+	ImportProductsWithEvents(ctx context.Context, created []*domain.Product, updated []*domain.Product, idempotencyKey string) error
+	
+	UpdateProductWithEvent(ctx context.Context, product *domain.Product, idempotencyKey string) error
+	
 	DeleteProductWithEvent(ctx context.Context, product *domain.Product, idempotencyKey string) error
+	
+	RestoreProductWithEvent(ctx context.Context, product *domain.Product, idempotencyKey string) error
+}
+
+type InventoryApplicationService interface {
+	AdjustStockWithEvent(ctx context.Context, productID int, delta int, reason string, idempotencyKey string) (*domain.Inventory, error)
+	
+	ReserveStockWithEvent(ctx context.Context, productID int, quantity int, expiresAt time.Time, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error)
+	
+	ConfirmReservationWithEvent(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error)
+	
+	ReleaseReservationWithEvent(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error)
+	
+	ReleaseExpiredReservations(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+type CategoryApplicationService interface {
+	CreateCategoryWithEvent(ctx context.Context, category *domain.Category, idempotencyKey string) error
+	
+	UpdateCategoryWithEvent(ctx context.Context, category *domain.Category, idempotencyKey string) error
+	
+	MoveCategoryWithEvent(ctx context.Context, id int, parentID *int, expectedVersion int, check CategoryMoveCheck, idempotencyKey string) (*domain.Category, error)
+	
+	DeleteCategoryWithEvent(ctx context.Context, category *domain.Category, idempotencyKey string) error
 }
 
+type CategoryMoveCheck func(category, parent *domain.Category, subtreeHeight int) error
+
 type UoWFactory interface {
 	CreateUnitOfWork() UnitOfWork
 }
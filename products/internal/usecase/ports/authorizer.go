@@ -0,0 +1,10 @@
+package ports
+
+import (
+	"context"
+	"product_service/products/internal/domain"
+)
+
+type Authorizer interface {
+	Authorize(ctx context.Context, permission domain.Permission) error
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"product_service/products/internal/domain"
+)
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *domain.Category) error
+	GetByID(ctx context.Context, id int) (*domain.Category, error)
+	List(ctx context.Context) ([]domain.Category, error)
+	Update(ctx context.Context, category *domain.Category) error
+	LockForMove(ctx context.Context, id int, parentID *int) error
+	Move(ctx context.Context, category *domain.Category, previousPath string) error
+	Delete(ctx context.Context, id int, version int) error
+	Usage(ctx context.Context, id int) (domain.CategoryUsage, error)
+	SubtreeDepth(ctx context.Context, category *domain.Category) (int, error)
+	ListProductCategories(ctx context.Context, productID int) ([]domain.Category, error)
+	SetProductCategories(ctx context.Context, productID int, categoryIDs []int) error
+}
@@ -1,10 +1,23 @@
 package ports
 
-import "context"
+import (
+	"context"
+	"product_service/products/internal/domain"
+)
 
 type EventPublisher interface {
-	PublishProductCreated(ctx context.Context, productID int) error
+	PublishProductCreated(ctx context.Context, productID int, details domain.ProductEventDetails) error
+	PublishProductUpdated(ctx context.Context, productID int, changes map[string]domain.FieldChange, details domain.ProductEventDetails) error
 	PublishProductDeleted(ctx context.Context, productID int) error
+	PublishProductRestored(ctx context.Context, productID int) error
+	PublishProductStatusChanged(ctx context.Context, eventType string, productID int, from, to domain.ProductStatus) error
+	PublishStockChanged(ctx context.Context, productID int, stock domain.StockSnapshot) error
+	PublishStockReserved(ctx context.Context, productID int, stock domain.StockSnapshot) error
+	PublishOutOfStock(ctx context.Context, productID int, stock domain.StockSnapshot) error
+	PublishCategoryCreated(ctx context.Context, category domain.CategorySnapshot) error
+	PublishCategoryUpdated(ctx context.Context, category domain.CategorySnapshot, changes map[string]domain.FieldChange) error
+	PublishCategoryMoved(ctx context.Context, category domain.CategorySnapshot) error
+	PublishCategoryDeleted(ctx context.Context, category domain.CategorySnapshot) error
 	Close() error
 }
 
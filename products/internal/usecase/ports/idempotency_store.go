@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"product_service/products/internal/domain"
+	"time"
+)
+
+type IdempotencyStore interface {
+	Acquire(ctx context.Context, scope, key, requestHash string, lockTimeout, ttl time.Duration) (*domain.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, scope, key string, statusCode int, contentType string, headers map[string]string, body []byte) error
+	Retain(ctx context.Context, scope, key string) error
+	Release(ctx context.Context, scope, key string) error
+	PurgeExpired(ctx context.Context, expiredBefore time.Time, limit int) (int, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"product_service/products/internal/domain"
+	"time"
+)
+
+type InventoryRepository interface {
+	Get(ctx context.Context, productID int) (*domain.Inventory, error)
+	GetForUpdate(ctx context.Context, productID int) (*domain.Inventory, error)
+	Save(ctx context.Context, inventory *domain.Inventory) error
+	CreateReservation(ctx context.Context, reservation *domain.Reservation) error
+	GetReservationForUpdate(ctx context.Context, id int64) (*domain.Reservation, error)
+	UpdateReservationStatus(ctx context.Context, reservation *domain.Reservation) error
+	ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]domain.Reservation, error)
+}
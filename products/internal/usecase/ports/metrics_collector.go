@@ -4,7 +4,11 @@ import "time"
 
 type MetricsCollector interface {
 	IncrementProductsCreated()
+	IncrementProductsUpdated()
 	IncrementProductsDeleted()
+	IncrementProductsRestored()
+	IncrementProductsPurged(count int)
+	IncrementReservationsExpired(count int)
 	RecordRequestDuration(method, endpoint, status string, duration time.Duration)
 	IncrementRequestCount(method, endpoint, status string)
 	RecordDatabaseQueryDuration(duration time.Duration)
@@ -21,5 +25,10 @@ type MetricsCollector interface {
 	RecordOutboxRetryAttempt(eventType string, attempt int)
 	
 	RecordOutboxEventProcessed(eventType string, status string)
+
+	IncrementAuthorizationDenied(permission string)
+
+	RecordGRPCRequestDuration(method, code string, duration time.Duration)
+	IncrementGRPCRequestCount(method, code string)
 }
 
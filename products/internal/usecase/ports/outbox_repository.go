@@ -10,6 +10,8 @@ type OutboxEvent struct {
 	EventType      string
 	EventData      []byte
 	IdempotencyKey string
+	TenantID       string
+	DLQReason      string
 	CreatedAt      time.Time
 	PublishedAt    *time.Time
 	RetryCount     int
@@ -35,3 +37,8 @@ type OutboxRepository interface {
 	
 }
 
+type OutboxAdminRepository interface {
+	ListDeadLetters(ctx context.Context, limit, offset int) ([]OutboxEvent, int, error)
+	RequeueDeadLetter(ctx context.Context, eventID int64) error
+}
+
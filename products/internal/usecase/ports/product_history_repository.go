@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+	"product_service/products/internal/domain"
+)
+
+type ProductHistoryRepository interface {
+	Append(ctx context.Context, entries []domain.ProductHistoryEntry) error
+	ListByProduct(ctx context.Context, productID int, limit, offset int) ([]domain.ProductHistoryEntry, int, error)
+}
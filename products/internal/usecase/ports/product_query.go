@@ -0,0 +1,99 @@
+package ports
+
+import (
+	"product_service/products/internal/domain"
+	"time"
+)
+
+type CursorDirection string
+
+const (
+	CursorNext CursorDirection = "next"
+	CursorPrev CursorDirection = "prev"
+)
+
+type ProductSortField string
+
+const (
+	SortByCreatedAt ProductSortField = "created_at"
+	SortByPrice     ProductSortField = "price"
+	SortByName      ProductSortField = "name"
+)
+
+type ProductSort struct {
+	Field      ProductSortField
+	Descending bool
+}
+
+func DefaultProductSort() ProductSort {
+	return ProductSort{Field: SortByCreatedAt, Descending: true}
+}
+
+func (s ProductSort) String() string {
+	if s.Descending {
+		return "-" + string(s.Field)
+	}
+	return string(s.Field)
+}
+
+type ProductListFilter struct {
+	NameContains  string
+	NamePrefix    string
+	Currency      string
+	MinPrice      *domain.Money
+	MaxPrice      *domain.Money
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	ParentID      *int
+	Statuses      []domain.ProductStatus
+	Attributes    domain.Attributes
+	Category      *CategoryFilter
+}
+
+func (f ProductListFilter) PriceCurrency() string {
+	if f.Currency == "" {
+		return domain.DefaultCurrency
+	}
+	return f.Currency
+}
+
+func (f ProductListFilter) HasPriceBounds() bool {
+	return f.MinPrice != nil || f.MaxPrice != nil
+}
+
+type CategoryFilter struct {
+	ID                 int
+	Slug               string
+	IncludeDescendants bool
+}
+
+type ProductCursor struct {
+	Sort      ProductSort
+	CreatedAt time.Time
+	Price     domain.Money
+	Name      string
+	ID        int
+	Direction CursorDirection
+}
+
+type ProductListQuery struct {
+	Page           int
+	Limit          int
+	Cursor         *ProductCursor
+	Filter         ProductListFilter
+	Sort           ProductSort
+	IncludeDeleted bool
+}
+
+func (q ProductListQuery) IsCursorMode() bool {
+	return q.Cursor != nil
+}
+
+type ProductListResult struct {
+	Products   []domain.Product
+	Total      int
+	Page       int
+	Limit      int
+	NextCursor *ProductCursor
+	PrevCursor *ProductCursor
+}
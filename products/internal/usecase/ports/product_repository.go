@@ -3,12 +3,19 @@ package ports
 import (
 	"context"
 	"product_service/products/internal/domain"
+	"time"
 )
 
 type ProductRepository interface {
 	Create(ctx context.Context, product *domain.Product) error
-	GetByID(ctx context.Context, id int) (*domain.Product, error)
-	List(ctx context.Context, page, limit int) ([]domain.Product, int, error)
-	Delete(ctx context.Context, id int) error
+	CreateBatch(ctx context.Context, products []*domain.Product) error
+	GetByID(ctx context.Context, id int, includeDeleted bool) (*domain.Product, error)
+	GetByIDs(ctx context.Context, ids []int, includeDeleted bool) ([]domain.Product, error)
+	List(ctx context.Context, query ProductListQuery) (ProductListResult, error)
+	Search(ctx context.Context, query ProductSearchQuery) (ProductSearchResult, error)
+	Update(ctx context.Context, product *domain.Product) error
+	CountActiveVariants(ctx context.Context, parentID int) (int, error)
+	Delete(ctx context.Context, id int, version int) error
+	Restore(ctx context.Context, product *domain.Product) error
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) (int, error)
 }
-
@@ -0,0 +1,19 @@
+package ports
+
+import "product_service/products/internal/domain"
+
+type ProductSearchQuery struct {
+	Text  string
+	Limit int
+}
+
+type ProductSearchHit struct {
+	Product   domain.Product
+	Rank      float64
+	Highlight string
+}
+
+type ProductSearchResult struct {
+	Hits  []ProductSearchHit
+	Fuzzy bool
+}
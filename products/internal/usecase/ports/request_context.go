@@ -0,0 +1,83 @@
+package ports
+
+import (
+	"context"
+	"product_service/products/internal/domain"
+)
+
+const SystemActor = "system"
+
+type requestIDKey struct{}
+
+type actorKey struct{}
+
+type principalKey struct{}
+
+type apiKeyKey struct{}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return SystemActor
+}
+
+func WithPrincipal(ctx context.Context, principal *domain.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*domain.Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*domain.Principal)
+	return principal, ok && principal != nil
+}
+
+func WithAPIKey(ctx context.Context, key *domain.APIKey) context.Context {
+	return context.WithValue(ctx, apiKeyKey{}, key)
+}
+
+func APIKeyFromContext(ctx context.Context) (*domain.APIKey, bool) {
+	key, ok := ctx.Value(apiKeyKey{}).(*domain.APIKey)
+	return key, ok && key != nil
+}
+
+const DefaultTenant = "default"
+
+type tenantKey struct{}
+
+type tenantScope struct {
+	tenant string
+	all    bool
+}
+
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantScope{tenant: tenant})
+}
+
+func WithAllTenants(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantScope{all: true})
+}
+
+func TenantFromContext(ctx context.Context) string {
+	if scope, ok := ctx.Value(tenantKey{}).(tenantScope); ok && !scope.all && scope.tenant != "" {
+		return scope.tenant
+	}
+	return DefaultTenant
+}
+
+func IsAllTenants(ctx context.Context) bool {
+	scope, ok := ctx.Value(tenantKey{}).(tenantScope)
+	return ok && scope.all
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "product_service/products/internal/domain"
+
+type TokenVerifier interface {
+	Verify(token string) (*domain.Principal, error)
+}
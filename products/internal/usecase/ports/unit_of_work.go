@@ -16,6 +16,12 @@ type UnitOfWork interface {
 	
 	OutboxRepository() OutboxRepository
 	
+	InventoryRepository() InventoryRepository
+	
+	CategoryRepository() CategoryRepository
+	
+	ProductHistoryRepository() ProductHistoryRepository
+	
 	Transaction() *sql.Tx
 }
 
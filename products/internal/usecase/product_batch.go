@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+)
+
+const MaxBatchCreateSize = 100
+
+const MaxProductLookupSize = 100
+
+type BatchMode string
+
+const (
+	BatchModeAtomic  BatchMode = "atomic"
+	BatchModePerItem BatchMode = "per_item"
+)
+
+func ParseBatchMode(value string) (BatchMode, error) {
+	switch BatchMode(value) {
+	case "", BatchModeAtomic:
+		return BatchModeAtomic, nil
+	case BatchModePerItem:
+		return BatchModePerItem, nil
+	default:
+		return "", fmt.Errorf("unsupported batch mode %q: %w", value, domain.ErrInvalidInput)
+	}
+}
+
+type ProductInput struct {
+	Name       string
+	Price      domain.Money
+	Prices     []domain.Money
+	Attributes domain.Attributes
+}
+
+type BatchItemResult struct {
+	Index   int
+	Product *domain.Product
+	Err     error
+}
+
+type BatchCreateResult struct {
+	Items   []BatchItemResult
+	Created int
+	Failed  int
+}
+
+func (uc *productUseCase) CreateProducts(ctx context.Context, inputs []ProductInput, mode BatchMode, idempotencyKey string) (BatchCreateResult, error) {
+	if len(inputs) == 0 || len(inputs) > MaxBatchCreateSize {
+		uc.logger.Warn("Invalid batch size",
+			ports.NewField("count", len(inputs)),
+		)
+		return BatchCreateResult{}, fmt.Errorf("batch must contain between 1 and %d products: %w", MaxBatchCreateSize, domain.ErrInvalidInput)
+	}
+
+	result := BatchCreateResult{Items: make([]BatchItemResult, len(inputs))}
+	products := make([]*domain.Product, 0, len(inputs))
+	for i, input := range inputs {
+		result.Items[i].Index = i
+
+		product, err := uc.newProduct(input.Name, input.Price, input.Prices, input.Attributes)
+		if err != nil {
+			result.Items[i].Err = err
+			result.Failed++
+			continue
+		}
+		result.Items[i].Product = product
+		products = append(products, product)
+	}
+
+	if len(products) == 0 || (mode == BatchModeAtomic && result.Failed > 0) {
+		uc.logger.Warn("Product batch rejected",
+			ports.NewField("mode", string(mode)),
+			ports.NewField("failed", result.Failed),
+		)
+		for i := range result.Items {
+			result.Items[i].Product = nil
+		}
+		return result, nil
+	}
+
+	if err := uc.appService.CreateProductsWithEvents(ctx, products, idempotencyKey); err != nil {
+		uc.logger.Error("Failed to create product batch",
+			ports.NewField("error", err),
+			ports.NewField("count", len(products)),
+		)
+		return BatchCreateResult{}, fmt.Errorf("failed to create products: %w", err)
+	}
+
+	result.Created = len(products)
+	return result, nil
+}
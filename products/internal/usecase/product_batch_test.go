@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"product_service/products/internal/domain"
+	domainServices "product_service/products/internal/domain/services"
+	"product_service/products/mocks"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestProductUseCase_CreateProducts_AtomicRejectsWholeBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	inputs := []ProductInput{
+		{Name: "Mug", Price: testPrice(t, "9.99")},
+		{Name: "", Price: testPrice(t, "4.99")},
+	}
+
+	result, err := useCase.CreateProducts(context.Background(), inputs, BatchModeAtomic, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.Created != 0 || result.Failed != 1 {
+		t.Errorf("Expected 0 created and 1 failed, got %d and %d", result.Created, result.Failed)
+	}
+	if result.Items[0].Product != nil || result.Items[0].Err != nil {
+		t.Errorf("Expected valid item to be skipped, got %+v", result.Items[0])
+	}
+	if result.Items[1].Index != 1 || result.Items[1].Err == nil {
+		t.Errorf("Expected item 1 to fail, got %+v", result.Items[1])
+	}
+}
+
+func TestProductUseCase_CreateProducts_PerItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	inputs := []ProductInput{
+		{Name: "Mug", Price: testPrice(t, "9.99")},
+		{Name: "Plate", Price: domain.Money{}},
+		{Name: "Bowl", Price: testPrice(t, "12.50")},
+	}
+
+	mockAppService.EXPECT().
+		CreateProductsWithEvents(ctx, gomock.Len(2), "batch-key").
+		DoAndReturn(func(ctx context.Context, products []*domain.Product, key string) error {
+			for i, product := range products {
+				product.ID = i + 1
+			}
+			return nil
+		})
+
+	result, err := useCase.CreateProducts(ctx, inputs, BatchModePerItem, "batch-key")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.Created != 2 || result.Failed != 1 {
+		t.Errorf("Expected 2 created and 1 failed, got %d and %d", result.Created, result.Failed)
+	}
+	if !errors.Is(result.Items[1].Err, domain.ErrInvalidProductPrice) {
+		t.Errorf("Expected ErrInvalidProductPrice for item 1, got: %v", result.Items[1].Err)
+	}
+	if result.Items[2].Product == nil || result.Items[2].Product.ID != 2 {
+		t.Errorf("Expected item 2 to be created, got %+v", result.Items[2])
+	}
+}
+
+func TestProductUseCase_CreateProducts_TooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mocks.NewMockProductRepository(ctrl), mocks.NewMockProductApplicationService(ctrl), domainServices.NewProductDomainService(nil), mockLogger)
+
+	_, err := useCase.CreateProducts(context.Background(), make([]ProductInput, MaxBatchCreateSize+1), BatchModeAtomic, "")
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+)
+
+const (
+	DefaultHistoryPageSize = 20
+	MaxHistoryPageSize     = 100
+)
+
+type ProductHistoryUseCase interface {
+	GetProductHistory(ctx context.Context, productID int, page, limit int) (ProductHistoryPage, error)
+}
+
+type ProductHistoryPage struct {
+	Entries []domain.ProductHistoryEntry
+	Page    int
+	Limit   int
+	Total   int
+}
+
+type productHistoryUseCase struct {
+	historyRepo ports.ProductHistoryRepository
+	productRepo ports.ProductRepository
+	logger      ports.Logger
+}
+
+func NewProductHistoryUseCase(
+	historyRepo ports.ProductHistoryRepository,
+	productRepo ports.ProductRepository,
+	logger ports.Logger,
+) ProductHistoryUseCase {
+	return &productHistoryUseCase{
+		historyRepo: historyRepo,
+		productRepo: productRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *productHistoryUseCase) GetProductHistory(ctx context.Context, productID int, page, limit int) (ProductHistoryPage, error) {
+	if productID <= 0 {
+		uc.logger.Warn("Invalid product ID for history",
+			ports.NewField("product_id", productID),
+		)
+		return ProductHistoryPage{}, fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = DefaultHistoryPageSize
+	}
+	if limit > MaxHistoryPageSize {
+		limit = MaxHistoryPageSize
+	}
+
+	if _, err := uc.productRepo.GetByID(ctx, productID, true); err != nil {
+		if errors.Is(err, domain.ErrProductNotFound) {
+			return ProductHistoryPage{}, fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+		}
+		return ProductHistoryPage{}, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	entries, total, err := uc.historyRepo.ListByProduct(ctx, productID, limit, (page-1)*limit)
+	if err != nil {
+		uc.logger.Error("Failed to list product history",
+			ports.NewField("error", err),
+			ports.NewField("product_id", productID),
+		)
+		return ProductHistoryPage{}, fmt.Errorf("failed to get product history: %w", err)
+	}
+
+	return ProductHistoryPage{
+		Entries: entries,
+		Page:    page,
+		Limit:   limit,
+		Total:   total,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"product_service/products/internal/domain"
+	"product_service/products/mocks"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestProductHistoryUseCase_GetProductHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistory := mocks.NewMockProductHistoryRepository(ctrl)
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewProductHistoryUseCase(mockHistory, mockRepo, mockLogger)
+
+	ctx := context.Background()
+	entries := []domain.ProductHistoryEntry{{ID: 2, ProductID: 1, Action: domain.HistoryActionUpdated}}
+	mockRepo.EXPECT().GetByID(ctx, 1, true).Return(&domain.Product{ID: 1}, nil)
+	mockHistory.EXPECT().ListByProduct(ctx, 1, MaxHistoryPageSize, MaxHistoryPageSize).Return(entries, 101, nil)
+
+	page, err := useCase.GetProductHistory(ctx, 1, 2, 500)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if page.Limit != MaxHistoryPageSize || page.Total != 101 || len(page.Entries) != 1 {
+		t.Errorf("Unexpected history page: %+v", page)
+	}
+}
+
+func TestProductHistoryUseCase_GetProductHistory_UnknownProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistory := mocks.NewMockProductHistoryRepository(ctrl)
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewProductHistoryUseCase(mockHistory, mockRepo, mockLogger)
+
+	ctx := context.Background()
+	mockRepo.EXPECT().GetByID(ctx, 9, true).Return(nil, domain.ErrProductNotFound)
+
+	_, err := useCase.GetProductHistory(ctx, 9, 0, 0)
+	if !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("Expected ErrProductNotFound, got: %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+)
+
+func (uc *productUseCase) TransitionProduct(ctx context.Context, id int, target domain.ProductStatus, expectedVersion int, idempotencyKey string) (*domain.Product, error) {
+	if id <= 0 {
+		uc.logger.Warn("Invalid product ID for transition",
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+
+	product, err := uc.repo.GetByID(ctx, id, false)
+	if err != nil {
+		if errors.Is(err, domain.ErrProductNotFound) {
+			uc.logger.Warn("Product not found for transition",
+				ports.NewField("product_id", id),
+			)
+			return nil, fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+		}
+		uc.logger.Error("Failed to get product for transition",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if err := product.CheckVersion(expectedVersion); err != nil {
+		uc.logger.Warn("Product version mismatch for transition",
+			ports.NewField("product_id", id),
+			ports.NewField("expected_version", expectedVersion),
+			ports.NewField("current_version", product.Version),
+		)
+		return nil, fmt.Errorf("cannot transition product: %w", err)
+	}
+
+	from := product.Status
+	if err := product.TransitionTo(target); err != nil {
+		uc.logger.Warn("Product transition rejected",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+			ports.NewField("from", from.String()),
+			ports.NewField("to", target.String()),
+		)
+		return nil, fmt.Errorf("cannot transition product: %w", err)
+	}
+
+	if err := uc.appService.UpdateProductWithEvent(ctx, product, idempotencyKey); err != nil {
+		uc.logger.Error("Failed to transition product",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("failed to transition product: %w", err)
+	}
+
+	uc.logger.Info("Product transitioned",
+		ports.NewField("product_id", id),
+		ports.NewField("from", from.String()),
+		ports.NewField("to", target.String()),
+	)
+	return product, nil
+}
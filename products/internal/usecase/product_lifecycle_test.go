@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"product_service/products/internal/domain"
+	domainServices "product_service/products/internal/domain/services"
+	"product_service/products/mocks"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestProductUseCase_TransitionProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	product, _ := domain.NewProduct("Lamp", testPrice(t, "25.00"))
+	product.ID = 3
+	product.Version = 2
+
+	mockRepo.EXPECT().GetByID(ctx, 3, false).Return(product, nil)
+	mockAppService.EXPECT().
+		UpdateProductWithEvent(ctx, product, "activate-3").
+		DoAndReturn(func(_ context.Context, p *domain.Product, _ string) error {
+			events := p.DomainEvents()
+			if len(events) != 1 || events[0].EventType() != "PRODUCT_ACTIVATED" {
+				t.Errorf("Expected a single PRODUCT_ACTIVATED event, got %v", events)
+			}
+			return nil
+		})
+
+	updated, err := useCase.TransitionProduct(ctx, 3, domain.ProductStatusActive, 2, "activate-3")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if updated.Status != domain.ProductStatusActive {
+		t.Errorf("Expected product to be active, got %s", updated.Status)
+	}
+}
+
+func TestProductUseCase_TransitionProduct_Rejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	product := &domain.Product{ID: 4, Status: domain.ProductStatusArchived}
+	mockRepo.EXPECT().GetByID(ctx, 4, false).Return(product, nil)
+
+	_, err := useCase.TransitionProduct(ctx, 4, domain.ProductStatusActive, 0, "")
+	if !errors.Is(err, domain.ErrInvalidStatusTransition) {
+		t.Errorf("Expected ErrInvalidStatusTransition, got: %v", err)
+	}
+}
+
+func TestProductUseCase_DeleteProduct_ActiveProductRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	product := &domain.Product{ID: 5, Status: domain.ProductStatusActive}
+	mockRepo.EXPECT().GetByID(ctx, 5, false).Return(product, nil)
+	mockRepo.EXPECT().CountActiveVariants(ctx, 5).Return(0, nil)
+
+	err := useCase.DeleteProduct(ctx, 5, 0, "")
+	if !errors.Is(err, domain.ErrInvalidStatusTransition) {
+		t.Errorf("Expected ErrInvalidStatusTransition, got: %v", err)
+	}
+}
+
+func TestProductUseCase_DeleteProduct_ParentWithLiveVariantRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	parent, _ := domain.NewProduct("Shirt", testPrice(t, "20.00"))
+	parent.ID = 6
+	mockRepo.EXPECT().GetByID(ctx, 6, false).Return(parent, nil)
+	mockRepo.EXPECT().CountActiveVariants(ctx, 6).Return(1, nil)
+
+	err := useCase.DeleteProduct(ctx, 6, 0, "")
+	if !errors.Is(err, domain.ErrProductHasVariants) {
+		t.Errorf("Expected ErrProductHasVariants, got: %v", err)
+	}
+	if events := parent.DomainEvents(); len(events) != 0 {
+		t.Errorf("Expected no delete event to be recorded, got %v", events)
+	}
+}
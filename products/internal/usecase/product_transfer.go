@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"product_service/products/internal/domain"
+	"product_service/products/internal/usecase/ports"
+	"slices"
+	"strconv"
+)
+
+const (
+	ExportChunkSize = 500
+	ImportChunkSize = 100
+)
+
+type ProductImportRow struct {
+	Line    int
+	ID      int
+	Version int
+	Input   ProductInput
+	Err     error
+}
+
+type ProductImportSource interface {
+	Next() (ProductImportRow, error)
+}
+
+type ImportRowError struct {
+	Line int
+	Err  error
+}
+
+type ImportReport struct {
+	Created   int
+	Updated   int
+	Unchanged int
+	Rejected  []ImportRowError
+}
+
+type importChunk struct {
+	created      []*domain.Product
+	createdLines []int
+	updated      []*domain.Product
+	updatedLines []int
+	ids          map[int]struct{}
+}
+
+func (c *importChunk) size() int {
+	return len(c.created) + len(c.updated)
+}
+
+func (c *importChunk) reset() {
+	c.created, c.createdLines = nil, nil
+	c.updated, c.updatedLines = nil, nil
+	c.ids = make(map[int]struct{})
+}
+
+func (uc *productUseCase) ExportProducts(ctx context.Context, query ports.ProductListQuery, fn func(*domain.Product) error) (int, error) {
+	if err := validateListFilter(query.Filter); err != nil {
+		uc.logger.Warn("Invalid product export filter",
+			ports.NewField("error", err),
+		)
+		return 0, err
+	}
+
+	sort := ports.ProductSort{Field: ports.SortByCreatedAt}
+	query.Sort = sort
+	query.Limit = ExportChunkSize
+	query.Cursor = &ports.ProductCursor{Sort: sort, Direction: ports.CursorNext}
+
+	exported := 0
+	for {
+		result, err := uc.repo.List(ctx, query)
+		if err != nil {
+			uc.logger.Error("Failed to list products for export",
+				ports.NewField("error", err),
+				ports.NewField("exported", exported),
+			)
+			return exported, fmt.Errorf("failed to export products: %w", err)
+		}
+
+		for i := range result.Products {
+			if err := fn(&result.Products[i]); err != nil {
+				return exported, err
+			}
+			exported++
+		}
+
+		if result.NextCursor == nil {
+			return exported, nil
+		}
+		query.Cursor = result.NextCursor
+	}
+}
+
+func (uc *productUseCase) ImportProducts(ctx context.Context, source ProductImportSource, idempotencyKey string) (ImportReport, error) {
+	var report ImportReport
+	chunk := &importChunk{}
+	chunk.reset()
+	chunks := 0
+
+	flush := func() error {
+		if chunk.size() == 0 {
+			return nil
+		}
+		err := uc.flushImportChunk(ctx, chunk, chunkIdempotencyKey(idempotencyKey, chunks), &report)
+		chunks++
+		chunk.reset()
+		return err
+	}
+
+	for {
+		row, err := source.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if flushErr := flush(); flushErr != nil {
+				return report, flushErr
+			}
+			uc.logger.Warn("Product import stopped",
+				ports.NewField("error", err),
+				ports.NewField("created", report.Created),
+				ports.NewField("updated", report.Updated),
+			)
+			sortRejected(&report)
+			return report, fmt.Errorf("import stopped: %w", err)
+		}
+
+		if _, ok := chunk.ids[row.ID]; ok && row.ID > 0 {
+			if err := flush(); err != nil {
+				return report, err
+			}
+		}
+
+		product, isUpdate, err := uc.prepareImportRow(ctx, row)
+		if err != nil {
+			if ctx.Err() != nil {
+				return report, err
+			}
+			report.Rejected = append(report.Rejected, ImportRowError{Line: row.Line, Err: err})
+			continue
+		}
+		if product == nil {
+			report.Unchanged++
+			continue
+		}
+
+		if isUpdate {
+			chunk.updated = append(chunk.updated, product)
+			chunk.updatedLines = append(chunk.updatedLines, row.Line)
+			chunk.ids[product.ID] = struct{}{}
+		} else {
+			chunk.created = append(chunk.created, product)
+			chunk.createdLines = append(chunk.createdLines, row.Line)
+		}
+
+		if chunk.size() >= ImportChunkSize {
+			if err := flush(); err != nil {
+				return report, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return report, err
+	}
+
+	sortRejected(&report)
+	uc.logger.Info("Product import finished",
+		ports.NewField("created", report.Created),
+		ports.NewField("updated", report.Updated),
+		ports.NewField("unchanged", report.Unchanged),
+		ports.NewField("rejected", len(report.Rejected)),
+	)
+	return report, nil
+}
+
+func (uc *productUseCase) prepareImportRow(ctx context.Context, row ProductImportRow) (*domain.Product, bool, error) {
+	if row.Err != nil {
+		return nil, false, row.Err
+	}
+	if row.ID < 0 {
+		return nil, false, fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+
+	input := row.Input
+	if row.ID == 0 {
+		product, err := uc.newProduct(input.Name, input.Price, input.Prices, input.Attributes)
+		return product, false, err
+	}
+
+	product, err := uc.repo.GetByID(ctx, row.ID, false)
+	if err != nil {
+		if errors.Is(err, domain.ErrProductNotFound) {
+			return nil, false, fmt.Errorf("product %d not found: %w", row.ID, domain.ErrProductNotFound)
+		}
+		uc.logger.Error("Failed to get product for import",
+			ports.NewField("error", err),
+			ports.NewField("product_id", row.ID),
+		)
+		return nil, false, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if err := product.CheckVersion(row.Version); err != nil {
+		return nil, false, fmt.Errorf("product %d is at version %d: %w", row.ID, product.Version, err)
+	}
+
+	if err := uc.domainService.ValidateProductForUpdate(input.Name, input.Price); err != nil {
+		return nil, false, fmt.Errorf("product validation failed: %w", err)
+	}
+
+	if input.Attributes != nil {
+		if err := uc.domainService.ValidateAttributes(product.InheritedAttributes.Merge(input.Attributes)); err != nil {
+			return nil, false, fmt.Errorf("product validation failed: %w", err)
+		}
+	}
+
+	if err := product.Update(input.Name, input.Price, input.Attributes, input.Prices...); err != nil {
+		return nil, false, fmt.Errorf("failed to update product: %w", err)
+	}
+
+	if len(product.DomainEvents()) == 0 {
+		return nil, true, nil
+	}
+	return product, true, nil
+}
+
+func (uc *productUseCase) flushImportChunk(ctx context.Context, chunk *importChunk, idempotencyKey string, report *ImportReport) error {
+	if err := uc.appService.ImportProductsWithEvents(ctx, chunk.created, chunk.updated, idempotencyKey); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("failed to import products: %w", err)
+		}
+		uc.logger.Warn("Product import chunk rejected",
+			ports.NewField("error", err),
+			ports.NewField("rows", chunk.size()),
+		)
+		for _, line := range slices.Concat(chunk.createdLines, chunk.updatedLines) {
+			report.Rejected = append(report.Rejected, ImportRowError{Line: line, Err: err})
+		}
+		return nil
+	}
+
+	report.Created += len(chunk.created)
+	report.Updated += len(chunk.updated)
+	return nil
+}
+
+func chunkIdempotencyKey(idempotencyKey string, chunk int) string {
+	if idempotencyKey == "" {
+		return ""
+	}
+	return idempotencyKey + ":chunk-" + strconv.Itoa(chunk)
+}
+
+func sortRejected(report *ImportReport) {
+	slices.SortStableFunc(report.Rejected, func(a, b ImportRowError) int {
+		return a.Line - b.Line
+	})
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"product_service/products/internal/domain"
+	domainServices "product_service/products/internal/domain/services"
+	"product_service/products/internal/usecase/ports"
+	"product_service/products/mocks"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+type sliceImportSource struct {
+	rows []ProductImportRow
+}
+
+func (s *sliceImportSource) Next() (ProductImportRow, error) {
+	if len(s.rows) == 0 {
+		return ProductImportRow{}, io.EOF
+	}
+	row := s.rows[0]
+	s.rows = s.rows[1:]
+	return row, nil
+}
+
+func TestProductUseCase_ImportProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	existing, _ := domain.NewProduct("Old Name", testPrice(t, "5.00"))
+	existing.ID = 7
+	existing.Version = 3
+	unchanged, _ := domain.NewProduct("Same", testPrice(t, "1.00"))
+	unchanged.ID = 8
+
+	mockRepo.EXPECT().GetByID(ctx, 7, false).Return(existing, nil)
+	mockRepo.EXPECT().GetByID(ctx, 8, false).Return(unchanged, nil)
+	mockRepo.EXPECT().GetByID(ctx, 9, false).Return(nil, domain.ErrProductNotFound)
+
+	source := &sliceImportSource{rows: []ProductImportRow{
+		{Line: 2, Input: ProductInput{Name: "New", Price: testPrice(t, "9.99")}},
+		{Line: 3, ID: 7, Version: 3, Input: ProductInput{Name: "New Name", Price: testPrice(t, "5.00")}},
+		{Line: 4, ID: 8, Input: ProductInput{Name: "Same", Price: testPrice(t, "1.00")}},
+		{Line: 5, ID: 9, Input: ProductInput{Name: "Missing", Price: testPrice(t, "1.00")}},
+		{Line: 6, Err: domain.ErrInvalidInput},
+	}}
+
+	mockAppService.EXPECT().
+		ImportProductsWithEvents(ctx, gomock.Len(1), gomock.Len(1), "import-1:chunk-0").
+		Return(nil)
+
+	report, err := useCase.ImportProducts(ctx, source, "import-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if report.Created != 1 || report.Updated != 1 || report.Unchanged != 1 {
+		t.Errorf("Expected 1 created, 1 updated and 1 unchanged, got %+v", report)
+	}
+	if len(report.Rejected) != 2 || report.Rejected[0].Line != 5 || report.Rejected[1].Line != 6 {
+		t.Fatalf("Expected lines 5 and 6 to be rejected, got %+v", report.Rejected)
+	}
+	if !errors.Is(report.Rejected[0].Err, domain.ErrProductNotFound) {
+		t.Errorf("Expected ErrProductNotFound for line 5, got: %v", report.Rejected[0].Err)
+	}
+}
+
+func TestProductUseCase_ImportProducts_ChunkFailureRejectsRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	source := &sliceImportSource{rows: []ProductImportRow{
+		{Line: 1, Input: ProductInput{Name: "A", Price: testPrice(t, "1.00")}},
+		{Line: 2, Input: ProductInput{Name: "B", Price: testPrice(t, "2.00")}},
+	}}
+
+	mockAppService.EXPECT().
+		ImportProductsWithEvents(ctx, gomock.Len(2), gomock.Len(0), "").
+		Return(errors.New("connection reset"))
+
+	report, err := useCase.ImportProducts(ctx, source, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if report.Created != 0 || len(report.Rejected) != 2 {
+		t.Errorf("Expected both rows to be rejected, got %+v", report)
+	}
+}
+
+func TestProductUseCase_ExportProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+
+	useCase := NewProductUseCase(mockRepo, mocks.NewMockProductApplicationService(ctrl), domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	next := &ports.ProductCursor{Sort: ports.ProductSort{Field: ports.SortByCreatedAt}, ID: 2, Direction: ports.CursorNext}
+
+	gomock.InOrder(
+		mockRepo.EXPECT().List(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, query ports.ProductListQuery) (ports.ProductListResult, error) {
+			if query.Limit != ExportChunkSize || query.Cursor == nil || query.Cursor.ID != 0 {
+				t.Errorf("Unexpected first export query: %+v", query)
+			}
+			return ports.ProductListResult{Products: []domain.Product{{ID: 1}, {ID: 2}}, NextCursor: next}, nil
+		}),
+		mockRepo.EXPECT().List(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, query ports.ProductListQuery) (ports.ProductListResult, error) {
+			if query.Cursor != next {
+				t.Errorf("Expected export to continue from the next cursor, got %+v", query.Cursor)
+			}
+			return ports.ProductListResult{Products: []domain.Product{{ID: 3}}}, nil
+		}),
+	)
+
+	var ids []int
+	exported, err := useCase.ExportProducts(ctx, ports.ProductListQuery{}, func(product *domain.Product) error {
+		ids = append(ids, product.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if exported != 3 || len(ids) != 3 || ids[2] != 3 {
+		t.Errorf("Expected 3 exported products in order, got %d %v", exported, ids)
+	}
+}
+
+func TestProductUseCase_ExportImportRoundTrip_KeepsSecondaryPrices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	stored, _ := domain.NewProduct("Mug", testPrice(t, "10.00"))
+	stored.ID = 5
+	stored.Version = 2
+	for _, price := range []struct{ amount, currency string }{{"9.00", "EUR"}, {"400.00", "UAH"}} {
+		money, err := domain.ParseMoney(price.amount, price.currency)
+		if err != nil {
+			t.Fatalf("Failed to parse price: %v", err)
+		}
+		if err := stored.AddPrice(money); err != nil {
+			t.Fatalf("Failed to add price: %v", err)
+		}
+	}
+
+	mockRepo.EXPECT().List(ctx, gomock.Any()).Return(ports.ProductListResult{Products: []domain.Product{*stored}}, nil)
+
+	var exported []domain.Product
+	if _, err := useCase.ExportProducts(ctx, ports.ProductListQuery{}, func(product *domain.Product) error {
+		exported = append(exported, *product)
+		return nil
+	}); err != nil {
+		t.Fatalf("Expected no error exporting, got: %v", err)
+	}
+	if len(exported) != 1 {
+		t.Fatalf("Expected 1 exported product, got %d", len(exported))
+	}
+
+	amounts := exported[0].PriceAmounts()
+	amounts["EUR"] = "8.50"
+	input := ProductInput{Name: exported[0].Name.Value(), Price: exported[0].Price}
+	for currency, amount := range amounts {
+		price, err := domain.ParseMoney(amount, currency)
+		if err != nil {
+			t.Fatalf("Failed to parse exported price: %v", err)
+		}
+		input.Prices = append(input.Prices, price)
+	}
+
+	current, _ := domain.NewProduct("Mug", testPrice(t, "10.00"))
+	current.ID = stored.ID
+	current.Version = stored.Version
+	current.Prices = stored.Prices
+	mockRepo.EXPECT().GetByID(ctx, 5, false).Return(current, nil)
+
+	mockAppService.EXPECT().
+		ImportProductsWithEvents(ctx, gomock.Len(0), gomock.Len(1), "").
+		DoAndReturn(func(ctx context.Context, created, updated []*domain.Product, key string) error {
+			got := updated[0].PriceAmounts()
+			want := map[string]string{"USD": "10.00", "EUR": "8.50", "UAH": "400.00"}
+			if len(got) != len(want) {
+				t.Errorf("Expected prices %v, got %v", want, got)
+			}
+			for currency, amount := range want {
+				if got[currency] != amount {
+					t.Errorf("Expected %s price %s, got %q", currency, amount, got[currency])
+				}
+			}
+
+			updatedEvent := updated[0].DomainEvents()[0].(domain.ProductUpdatedEvent)
+			change, ok := updatedEvent.Changes["prices"]
+			if !ok {
+				t.Fatalf("Expected a prices change, got %+v", updatedEvent.Changes)
+			}
+			if to := change.To.(map[string]string); len(to) != 1 || to["EUR"] != "8.50" {
+				t.Errorf("Expected only the EUR price to change, got %+v", change)
+			}
+			return nil
+		})
+
+	report, err := useCase.ImportProducts(ctx, &sliceImportSource{rows: []ProductImportRow{
+		{Line: 2, ID: 5, Version: 2, Input: input},
+	}}, "")
+	if err != nil {
+		t.Fatalf("Expected no error importing, got: %v", err)
+	}
+	if report.Updated != 1 || len(report.Rejected) != 0 {
+		t.Errorf("Expected 1 updated row, got %+v", report)
+	}
+}
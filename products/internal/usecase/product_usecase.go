@@ -7,12 +7,49 @@ import (
 	"product_service/products/internal/domain"
 	"product_service/products/internal/domain/services"
 	"product_service/products/internal/usecase/ports"
+	"strings"
+	"unicode/utf8"
 )
 
 type ProductUseCase interface {
-	CreateProduct(ctx context.Context, name string, price float64, idempotencyKey string) (*domain.Product, error)
-	GetProducts(ctx context.Context, page, limit int) ([]domain.Product, int, error)
-	DeleteProduct(ctx context.Context, id int, idempotencyKey string) error
+	CreateProduct(ctx context.Context, name string, price domain.Money, prices []domain.Money, attributes domain.Attributes, idempotencyKey string) (*domain.Product, error)
+	CreateProducts(ctx context.Context, inputs []ProductInput, mode BatchMode, idempotencyKey string) (BatchCreateResult, error)
+	CreateVariant(ctx context.Context, parentID int, variant VariantInput, idempotencyKey string) (*domain.Product, error)
+	GetProduct(ctx context.Context, id int, includeDeleted bool) (*domain.Product, error)
+	GetProductsByIDs(ctx context.Context, ids []int, includeDeleted bool) ([]domain.Product, error)
+	GetProducts(ctx context.Context, query ports.ProductListQuery) (ports.ProductListResult, error)
+	SearchProducts(ctx context.Context, query ports.ProductSearchQuery) (ports.ProductSearchResult, error)
+	UpdateProduct(ctx context.Context, id int, update ProductUpdate, expectedVersion int, idempotencyKey string) (*domain.Product, error)
+	DeleteProduct(ctx context.Context, id int, expectedVersion int, idempotencyKey string) error
+	RestoreProduct(ctx context.Context, id int, expectedVersion int, idempotencyKey string) (*domain.Product, error)
+	TransitionProduct(ctx context.Context, id int, target domain.ProductStatus, expectedVersion int, idempotencyKey string) (*domain.Product, error)
+	ExportProducts(ctx context.Context, query ports.ProductListQuery, fn func(*domain.Product) error) (int, error)
+	ImportProducts(ctx context.Context, source ProductImportSource, idempotencyKey string) (ImportReport, error)
+}
+
+type ProductUpdate struct {
+	Name       *string
+	Price      *PriceInput
+	Attributes domain.Attributes
+}
+
+type VariantInput struct {
+	Name       string
+	Price      *PriceInput
+	Attributes domain.Attributes
+}
+
+type PriceInput struct {
+	Amount   string
+	Currency string
+}
+
+func (p PriceInput) Money(baseCurrency string) (domain.Money, error) {
+	currency := p.Currency
+	if currency == "" {
+		currency = baseCurrency
+	}
+	return domain.ParseMoney(p.Amount, currency)
 }
 
 type Shutdownable interface {
@@ -42,12 +79,33 @@ func NewProductUseCase(
 	}
 }
 
-func (uc *productUseCase) CreateProduct(ctx context.Context, name string, price float64, idempotencyKey string) (*domain.Product, error) {
+func (uc *productUseCase) CreateProduct(ctx context.Context, name string, price domain.Money, prices []domain.Money, attributes domain.Attributes, idempotencyKey string) (*domain.Product, error) {
+	product, err := uc.newProduct(name, price, prices, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.appService.CreateProductWithEvent(ctx, product, idempotencyKey); err != nil {
+		return nil, fmt.Errorf("failed to create product: %w", err)
+	}
+
+	return product, nil
+}
+
+func (uc *productUseCase) newProduct(name string, price domain.Money, prices []domain.Money, attributes domain.Attributes) (*domain.Product, error) {
 	if err := uc.domainService.ValidateProductForCreation(name, price); err != nil {
 		uc.logger.Warn("Product validation failed",
 			ports.NewField("error", err),
 			ports.NewField("name", name),
-			ports.NewField("price", price),
+			ports.NewField("price", price.String()),
+		)
+		return nil, fmt.Errorf("product validation failed: %w", err)
+	}
+
+	if err := uc.domainService.ValidateAttributes(attributes); err != nil {
+		uc.logger.Warn("Product attribute validation failed",
+			ports.NewField("error", err),
+			ports.NewField("name", name),
 		)
 		return nil, fmt.Errorf("product validation failed: %w", err)
 	}
@@ -57,49 +115,358 @@ func (uc *productUseCase) CreateProduct(ctx context.Context, name string, price
 		uc.logger.Warn("Failed to create product domain entity",
 			ports.NewField("error", err),
 			ports.NewField("name", name),
-			ports.NewField("price", price),
+			ports.NewField("price", price.String()),
 		)
 		return nil, fmt.Errorf("failed to create product: %w", err)
 	}
 
+	for _, extra := range prices {
+		if err := product.AddPrice(extra); err != nil {
+			uc.logger.Warn("Invalid product price list",
+				ports.NewField("error", err),
+				ports.NewField("price", extra.String()),
+			)
+			return nil, fmt.Errorf("failed to create product: %w", err)
+		}
+	}
+	product.Attributes = attributes
+
+	return product, nil
+}
+
+func (uc *productUseCase) CreateVariant(ctx context.Context, parentID int, variant VariantInput, idempotencyKey string) (*domain.Product, error) {
+	if parentID <= 0 {
+		uc.logger.Warn("Invalid parent product ID for variant",
+			ports.NewField("parent_id", parentID),
+		)
+		return nil, fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+
+	parent, err := uc.repo.GetByID(ctx, parentID, false)
+	if err != nil {
+		if errors.Is(err, domain.ErrProductNotFound) {
+			uc.logger.Warn("Parent product not found for variant",
+				ports.NewField("parent_id", parentID),
+			)
+			return nil, fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+		}
+		uc.logger.Error("Failed to get parent product for variant",
+			ports.NewField("error", err),
+			ports.NewField("parent_id", parentID),
+		)
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if err := uc.domainService.ValidateVariantParent(parent); err != nil {
+		uc.logger.Warn("Variant parent validation failed",
+			ports.NewField("error", err),
+			ports.NewField("parent_id", parentID),
+		)
+		return nil, fmt.Errorf("cannot create variant: %w", err)
+	}
+
+	price := parent.Price
+	if variant.Price != nil {
+		if price, err = variant.Price.Money(parent.Price.Currency()); err != nil {
+			uc.logger.Warn("Invalid variant price",
+				ports.NewField("error", err),
+				ports.NewField("parent_id", parentID),
+			)
+			return nil, fmt.Errorf("product validation failed: %w", err)
+		}
+	}
+
+	if err := uc.domainService.ValidateProductForCreation(variant.Name, price); err != nil {
+		uc.logger.Warn("Variant validation failed",
+			ports.NewField("error", err),
+			ports.NewField("parent_id", parentID),
+		)
+		return nil, fmt.Errorf("product validation failed: %w", err)
+	}
+
+	if err := uc.domainService.ValidateAttributes(parent.EffectiveAttributes().Merge(variant.Attributes)); err != nil {
+		uc.logger.Warn("Variant attribute validation failed",
+			ports.NewField("error", err),
+			ports.NewField("parent_id", parentID),
+		)
+		return nil, fmt.Errorf("product validation failed: %w", err)
+	}
+
+	product, err := parent.NewVariant(variant.Name, price, variant.Attributes)
+	if err != nil {
+		uc.logger.Warn("Failed to create variant domain entity",
+			ports.NewField("error", err),
+			ports.NewField("parent_id", parentID),
+		)
+		return nil, fmt.Errorf("failed to create variant: %w", err)
+	}
+
 	if err := uc.appService.CreateProductWithEvent(ctx, product, idempotencyKey); err != nil {
-		return nil, fmt.Errorf("failed to create product: %w", err)
+		return nil, fmt.Errorf("failed to create variant: %w", err)
 	}
 
 	return product, nil
 }
 
-func (uc *productUseCase) GetProducts(ctx context.Context, page, limit int) ([]domain.Product, int, error) {
-	if page < 1 {
-		page = 1
+func (uc *productUseCase) GetProduct(ctx context.Context, id int, includeDeleted bool) (*domain.Product, error) {
+	if id <= 0 {
+		uc.logger.Warn("Invalid product ID for retrieval",
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
 	}
-	if limit < 1 {
-		limit = 10
+
+	product, err := uc.repo.GetByID(ctx, id, includeDeleted)
+	if err != nil {
+		if errors.Is(err, domain.ErrProductNotFound) {
+			uc.logger.Warn("Product not found",
+				ports.NewField("product_id", id),
+			)
+			return nil, fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+		}
+		uc.logger.Error("Failed to get product from repository",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	return product, nil
+}
+
+func (uc *productUseCase) GetProductsByIDs(ctx context.Context, ids []int, includeDeleted bool) ([]domain.Product, error) {
+	if len(ids) > MaxProductLookupSize {
+		return nil, fmt.Errorf("%w: at most %d product ids can be looked up at once", domain.ErrInvalidInput, MaxProductLookupSize)
 	}
-	if limit > 100 {
-		limit = 100
+
+	unique := make([]int, 0, len(ids))
+	seen := make(map[int]struct{}, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("invalid product id %d: %w", id, domain.ErrInvalidInput)
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
 	}
 
-	products, total, err := uc.repo.List(ctx, page, limit)
+	products, err := uc.repo.GetByIDs(ctx, unique, includeDeleted)
+	if err != nil {
+		uc.logger.Error("Failed to get products from repository",
+			ports.NewField("error", err),
+			ports.NewField("product_ids", unique),
+		)
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+
+	return products, nil
+}
+
+func (uc *productUseCase) GetProducts(ctx context.Context, query ports.ProductListQuery) (ports.ProductListResult, error) {
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+	if query.Sort.Field == "" {
+		query.Sort = ports.DefaultProductSort()
+	}
+	if query.Cursor != nil {
+		query.Sort = query.Cursor.Sort
+	}
+
+	if err := validateListQuery(query); err != nil {
+		uc.logger.Warn("Invalid product list filter",
+			ports.NewField("error", err),
+		)
+		return ports.ProductListResult{}, err
+	}
+
+	result, err := uc.repo.List(ctx, query)
 	if err != nil {
 		uc.logger.Error("Failed to list products from repository",
 			ports.NewField("error", err),
-			ports.NewField("page", page),
-			ports.NewField("limit", limit),
+			ports.NewField("page", query.Page),
+			ports.NewField("limit", query.Limit),
+			ports.NewField("cursor_mode", query.IsCursorMode()),
 		)
-		return nil, 0, fmt.Errorf("failed to list products from repository: %w", err)
+		return ports.ProductListResult{}, fmt.Errorf("failed to list products from repository: %w", err)
 	}
+	result.Page = query.Page
+	result.Limit = query.Limit
 
 	uc.logger.Debug("Products listed successfully",
-		ports.NewField("count", len(products)),
-		ports.NewField("total", total),
-		ports.NewField("page", page),
+		ports.NewField("count", len(result.Products)),
+		ports.NewField("total", result.Total),
+		ports.NewField("page", query.Page),
+		ports.NewField("sort", query.Sort.String()),
+		ports.NewField("cursor_mode", query.IsCursorMode()),
 	)
 
-	return products, total, nil
+	return result, nil
 }
 
-func (uc *productUseCase) DeleteProduct(ctx context.Context, id int, idempotencyKey string) error {
+func (uc *productUseCase) SearchProducts(ctx context.Context, query ports.ProductSearchQuery) (ports.ProductSearchResult, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if query.Text == "" || utf8.RuneCountInString(query.Text) > 200 {
+		uc.logger.Warn("Invalid search query",
+			ports.NewField("length", len(query.Text)),
+		)
+		return ports.ProductSearchResult{}, fmt.Errorf("search query must be 1-200 characters: %w", domain.ErrInvalidInput)
+	}
+	if query.Limit < 1 {
+		query.Limit = 20
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+
+	result, err := uc.repo.Search(ctx, query)
+	if err != nil {
+		uc.logger.Error("Failed to search products",
+			ports.NewField("error", err),
+			ports.NewField("query", query.Text),
+		)
+		return ports.ProductSearchResult{}, fmt.Errorf("failed to search products: %w", err)
+	}
+
+	uc.logger.Debug("Products searched successfully",
+		ports.NewField("query", query.Text),
+		ports.NewField("count", len(result.Hits)),
+		ports.NewField("fuzzy", result.Fuzzy),
+	)
+
+	return result, nil
+}
+
+func validateListQuery(query ports.ProductListQuery) error {
+	if query.Cursor != nil && query.Sort.Field == ports.SortByPrice && query.Cursor.Price.Currency() != query.Filter.PriceCurrency() {
+		return fmt.Errorf("cursor is for %s prices, not %s: %w", query.Cursor.Price.Currency(), query.Filter.PriceCurrency(), domain.ErrInvalidInput)
+	}
+	return validateListFilter(query.Filter)
+}
+
+func validateListFilter(filter ports.ProductListFilter) error {
+	currency := filter.PriceCurrency()
+	for _, bound := range []*domain.Money{filter.MinPrice, filter.MaxPrice} {
+		if bound != nil && bound.Currency() != currency {
+			return fmt.Errorf("price bounds must be in %s: %w", currency, domain.ErrInvalidInput)
+		}
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && filter.MinPrice.MinorUnits() > filter.MaxPrice.MinorUnits() {
+		return fmt.Errorf("min_price must not exceed max_price: %w", domain.ErrInvalidInput)
+	}
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return fmt.Errorf("created_after must be before created_before: %w", domain.ErrInvalidInput)
+	}
+	return nil
+}
+
+func (uc *productUseCase) UpdateProduct(ctx context.Context, id int, update ProductUpdate, expectedVersion int, idempotencyKey string) (*domain.Product, error) {
+	if id <= 0 {
+		uc.logger.Warn("Invalid product ID for update",
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+
+	if update.Name == nil && update.Price == nil && update.Attributes == nil {
+		uc.logger.Warn("Empty product update",
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("no fields to update: %w", domain.ErrInvalidInput)
+	}
+
+	product, err := uc.repo.GetByID(ctx, id, false)
+	if err != nil {
+		if errors.Is(err, domain.ErrProductNotFound) {
+			uc.logger.Warn("Product not found for update",
+				ports.NewField("product_id", id),
+			)
+			return nil, fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+		}
+		uc.logger.Error("Failed to get product for update",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if err := product.CheckVersion(expectedVersion); err != nil {
+		uc.logger.Warn("Product version mismatch for update",
+			ports.NewField("product_id", id),
+			ports.NewField("expected_version", expectedVersion),
+			ports.NewField("current_version", product.Version),
+		)
+		return nil, fmt.Errorf("cannot update product: %w", err)
+	}
+
+	name := product.Name.Value()
+	if update.Name != nil {
+		name = *update.Name
+	}
+	price := product.Price
+	if update.Price != nil {
+		if price, err = update.Price.Money(product.Price.Currency()); err != nil {
+			uc.logger.Warn("Invalid product price for update",
+				ports.NewField("error", err),
+				ports.NewField("product_id", id),
+			)
+			return nil, fmt.Errorf("product validation failed: %w", err)
+		}
+	}
+
+	if err := uc.domainService.ValidateProductForUpdate(name, price); err != nil {
+		uc.logger.Warn("Product update validation failed",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("product validation failed: %w", err)
+	}
+
+	if update.Attributes != nil {
+		if err := uc.domainService.ValidateAttributes(product.InheritedAttributes.Merge(update.Attributes)); err != nil {
+			uc.logger.Warn("Product attribute validation failed",
+				ports.NewField("error", err),
+				ports.NewField("product_id", id),
+			)
+			return nil, fmt.Errorf("product validation failed: %w", err)
+		}
+	}
+
+	if err := product.Update(name, price, update.Attributes); err != nil {
+		uc.logger.Warn("Failed to apply product update",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("failed to update product: %w", err)
+	}
+
+	if len(product.DomainEvents()) == 0 {
+		uc.logger.Debug("Product update is a no-op",
+			ports.NewField("product_id", id),
+		)
+		return product, nil
+	}
+
+	if err := uc.appService.UpdateProductWithEvent(ctx, product, idempotencyKey); err != nil {
+		uc.logger.Error("Failed to update product",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("failed to update product: %w", err)
+	}
+
+	return product, nil
+}
+
+func (uc *productUseCase) DeleteProduct(ctx context.Context, id int, expectedVersion int, idempotencyKey string) error {
 	if id <= 0 {
 		uc.logger.Warn("Invalid product ID for deletion",
 			ports.NewField("product_id", id),
@@ -107,7 +474,7 @@ func (uc *productUseCase) DeleteProduct(ctx context.Context, id int, idempotency
 		return fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
 	}
 
-	product, err := uc.repo.GetByID(ctx, id)
+	product, err := uc.repo.GetByID(ctx, id, false)
 	if err != nil {
 		if errors.Is(err, domain.ErrProductNotFound) {
 			uc.logger.Warn("Product not found for deletion",
@@ -122,7 +489,25 @@ func (uc *productUseCase) DeleteProduct(ctx context.Context, id int, idempotency
 		return fmt.Errorf("failed to get product: %w", err)
 	}
 
-	if err := uc.domainService.CanDeleteProduct(product); err != nil {
+	if err := product.CheckVersion(expectedVersion); err != nil {
+		uc.logger.Warn("Product version mismatch for deletion",
+			ports.NewField("product_id", id),
+			ports.NewField("expected_version", expectedVersion),
+			ports.NewField("current_version", product.Version),
+		)
+		return fmt.Errorf("cannot delete product: %w", err)
+	}
+
+	variants, err := uc.repo.CountActiveVariants(ctx, product.ID)
+	if err != nil {
+		uc.logger.Error("Failed to count product variants for deletion",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if err := uc.domainService.CanDeleteProduct(product, variants); err != nil {
 		uc.logger.Warn("Product deletion validation failed",
 			ports.NewField("error", err),
 			ports.NewField("product_id", id),
@@ -143,6 +528,57 @@ func (uc *productUseCase) DeleteProduct(ctx context.Context, id int, idempotency
 	return nil
 }
 
+func (uc *productUseCase) RestoreProduct(ctx context.Context, id int, expectedVersion int, idempotencyKey string) (*domain.Product, error) {
+	if id <= 0 {
+		uc.logger.Warn("Invalid product ID for restore",
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("invalid product id: %w", domain.ErrInvalidInput)
+	}
+
+	product, err := uc.repo.GetByID(ctx, id, true)
+	if err != nil {
+		if errors.Is(err, domain.ErrProductNotFound) {
+			uc.logger.Warn("Product not found for restore",
+				ports.NewField("product_id", id),
+			)
+			return nil, fmt.Errorf("product not found: %w", domain.ErrProductNotFound)
+		}
+		uc.logger.Error("Failed to get product for restore",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if err := product.CheckVersion(expectedVersion); err != nil {
+		uc.logger.Warn("Product version mismatch for restore",
+			ports.NewField("product_id", id),
+			ports.NewField("expected_version", expectedVersion),
+			ports.NewField("current_version", product.Version),
+		)
+		return nil, fmt.Errorf("cannot restore product: %w", err)
+	}
+
+	if err := product.Restore(); err != nil {
+		uc.logger.Warn("Product restore rejected",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("cannot restore product: %w", err)
+	}
+
+	if err := uc.appService.RestoreProductWithEvent(ctx, product, idempotencyKey); err != nil {
+		uc.logger.Error("Failed to restore product",
+			ports.NewField("error", err),
+			ports.NewField("product_id", id),
+		)
+		return nil, fmt.Errorf("failed to restore product: %w", err)
+	}
+
+	return product, nil
+}
+
 func (uc *productUseCase) Shutdown(ctx context.Context) error {
 	return nil
 }
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"product_service/products/internal/domain"
 	domainServices "product_service/products/internal/domain/services"
+	"product_service/products/internal/usecase/ports"
 	"product_service/products/mocks"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 )
@@ -31,7 +33,7 @@ func TestProductUseCase_CreateProduct_WithGeneratedMocks(t *testing.T) {
 
 	ctx := context.Background()
 	name := "Test Product"
-	price := 99.99
+	price := testPrice(t, "99.99")
 	idempotencyKey := "test-key-123"
 
 	mockAppService.EXPECT().
@@ -41,7 +43,7 @@ func TestProductUseCase_CreateProduct_WithGeneratedMocks(t *testing.T) {
 			return nil
 		})
 
-	result, err := useCase.CreateProduct(ctx, name, price, idempotencyKey)
+	result, err := useCase.CreateProduct(ctx, name, price, nil, nil, idempotencyKey)
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
@@ -54,8 +56,57 @@ func TestProductUseCase_CreateProduct_WithGeneratedMocks(t *testing.T) {
 		t.Errorf("Expected name %s, got %s", name, result.Name.Value())
 	}
 
-	if result.Price.Value() != price {
-		t.Errorf("Expected price %f, got %f", price, result.Price.Value())
+	if !result.Price.Equal(price) {
+		t.Errorf("Expected price %s, got %s", price, result.Price)
+	}
+}
+
+func TestProductUseCase_CreateProduct_MultiCurrency_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	usd := testPrice(t, "10.00")
+	eur, _ := domain.ParseMoney("9.20", "EUR")
+	uah, _ := domain.ParseMoney("410.00", "UAH")
+
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockAppService.EXPECT().
+		CreateProductWithEvent(ctx, gomock.Any(), "").
+		Return(nil)
+
+	result, err := useCase.CreateProduct(ctx, "Test Product", usd, []domain.Money{usd, eur, uah}, nil, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if price, ok := result.PriceIn("eur"); !ok || !price.Equal(eur) {
+		t.Errorf("Expected EUR price %s, got %s", eur, price)
+	}
+
+	amounts := result.PriceAmounts()
+	if len(amounts) != 3 || amounts["UAH"] != "410.00" || amounts["USD"] != "10.00" {
+		t.Errorf("Unexpected price amounts: %v", amounts)
+	}
+
+	conflicting := testPrice(t, "11.00")
+	_, err = useCase.CreateProduct(ctx, "Test Product", usd, []domain.Money{conflicting}, nil, "")
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for conflicting base price, got: %v", err)
 	}
 }
 
@@ -79,19 +130,132 @@ func TestProductUseCase_CreateProduct_InvalidInput_WithGeneratedMocks(t *testing
 
 	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
 
-	_, err := useCase.CreateProduct(ctx, "", 99.99, "")
+	_, err := useCase.CreateProduct(ctx, "", testPrice(t, "99.99"), nil, nil, "")
 	if err == nil {
 		t.Error("Expected error for empty name")
 	}
 
-	_, err = useCase.CreateProduct(ctx, "Test", -10, "")
-	if err == nil {
-		t.Error("Expected error for negative price")
+	_, err = useCase.CreateProduct(ctx, "Test", domain.Money{}, nil, nil, "")
+	if !errors.Is(err, domain.ErrInvalidProductPrice) {
+		t.Errorf("Expected ErrInvalidProductPrice for zero price, got: %v", err)
 	}
+}
 
-	_, err = useCase.CreateProduct(ctx, "Test", 0, "")
-	if err == nil {
-		t.Error("Expected error for zero price")
+func TestProductUseCase_CreateProduct_InvalidAttributes_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	tests := map[string]domain.Attributes{
+		"color must be a string":  {"color": domain.NumberAttribute(3)},
+		"weight must be positive": {"weight": domain.NumberAttribute(-1)},
+	}
+	for name, attributes := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := useCase.CreateProduct(ctx, "Shirt", testPrice(t, "10.00"), nil, attributes, "")
+			if !errors.Is(err, domain.ErrInvalidAttribute) {
+				t.Errorf("Expected ErrInvalidAttribute, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestProductUseCase_CreateVariant_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+	parent, _ := domain.NewProduct("Shirt", testPrice(t, "20.00"))
+	parent.ID = 7
+	parent.Attributes = domain.Attributes{
+		"color":    domain.StringAttribute("blue"),
+		"material": domain.StringAttribute("cotton"),
+	}
+
+	mockRepo.EXPECT().GetByID(ctx, 7, false).Return(parent, nil)
+	mockAppService.EXPECT().
+		CreateProductWithEvent(ctx, gomock.Any(), "variant-key").
+		DoAndReturn(func(ctx context.Context, p *domain.Product, key string) error {
+			p.ID = 8
+			return nil
+		})
+
+	result, err := useCase.CreateVariant(ctx, 7, VariantInput{
+		Name:       "Shirt (red, L)",
+		Attributes: domain.Attributes{"color": domain.StringAttribute("red"), "size": domain.StringAttribute("L")},
+	}, "variant-key")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.ParentID == nil || *result.ParentID != 7 {
+		t.Errorf("Expected parent ID 7, got %v", result.ParentID)
+	}
+	if !result.Price.Equal(parent.Price) {
+		t.Errorf("Expected variant to inherit price %s, got %s", parent.Price, result.Price)
+	}
+
+	effective := result.EffectiveAttributes()
+	if effective["color"].Text() != "red" || effective["material"].Text() != "cotton" || effective["size"].Text() != "L" {
+		t.Errorf("Unexpected effective attributes: %v", effective.Values())
+	}
+}
+
+func TestProductUseCase_CreateVariant_OfVariant_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+	parentID := 7
+	variant, _ := domain.NewProduct("Shirt (red)", testPrice(t, "20.00"))
+	variant.ID = 8
+	variant.ParentID = &parentID
+
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockRepo.EXPECT().GetByID(ctx, 8, false).Return(variant, nil)
+
+	_, err := useCase.CreateVariant(ctx, 8, VariantInput{Name: "Shirt (red, XL)", Price: &PriceInput{Amount: "25.00"}}, "")
+	if !errors.Is(err, domain.ErrInvalidVariantParent) {
+		t.Errorf("Expected ErrInvalidVariantParent, got: %v", err)
 	}
 }
 
@@ -115,7 +279,7 @@ func TestProductUseCase_GetProducts_WithGeneratedMocks(t *testing.T) {
 
 	testProducts := make([]domain.Product, 15)
 	for i := 0; i < 15; i++ {
-		product, _ := domain.NewProduct(fmt.Sprintf("Product %d", i+1), float64((i+1)*10))
+		product, _ := domain.NewProduct(fmt.Sprintf("Product %d", i+1), testPrice(t, fmt.Sprintf("%d", (i+1)*10)))
 		product.ID = i + 1
 		testProducts[i] = *product
 	}
@@ -123,33 +287,208 @@ func TestProductUseCase_GetProducts_WithGeneratedMocks(t *testing.T) {
 	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
 
 	mockRepo.EXPECT().
-		List(ctx, 1, 10).
-		Return(testProducts[0:10], 15, nil)
+		List(ctx, ports.ProductListQuery{Page: 1, Limit: 10, Sort: ports.DefaultProductSort()}).
+		Return(ports.ProductListResult{Products: testProducts[0:10], Total: 15}, nil)
+
+	result, err := useCase.GetProducts(ctx, ports.ProductListQuery{Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.Total != 15 {
+		t.Errorf("Expected total 15, got %d", result.Total)
+	}
+
+	if len(result.Products) != 10 {
+		t.Errorf("Expected 10 products, got %d", len(result.Products))
+	}
+
+	mockRepo.EXPECT().
+		List(ctx, ports.ProductListQuery{Page: 2, Limit: 10, Sort: ports.DefaultProductSort()}).
+		Return(ports.ProductListResult{Products: testProducts[10:15], Total: 15}, nil)
 
-	products, total, err := useCase.GetProducts(ctx, 1, 10)
+	result, err = useCase.GetProducts(ctx, ports.ProductListQuery{Page: 2, Limit: 10})
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if total != 15 {
-		t.Errorf("Expected total 15, got %d", total)
+	if len(result.Products) != 5 {
+		t.Errorf("Expected 5 products on page 2, got %d", len(result.Products))
+	}
+
+	mockRepo.EXPECT().
+		List(ctx, ports.ProductListQuery{Page: 1, Limit: 100, Sort: ports.DefaultProductSort()}).
+		Return(ports.ProductListResult{Products: testProducts, Total: 15}, nil)
+
+	result, err = useCase.GetProducts(ctx, ports.ProductListQuery{Page: 0, Limit: 500})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if len(products) != 10 {
-		t.Errorf("Expected 10 products, got %d", len(products))
+	if result.Page != 1 || result.Limit != 100 {
+		t.Errorf("Expected the effective page 1 and limit 100, got page %d and limit %d", result.Page, result.Limit)
 	}
+}
+
+func TestProductUseCase_GetProducts_Cursor_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	product, _ := domain.NewProduct("Product", testPrice(t, "10"))
+	product.ID = 7
+
+	cursor := &ports.ProductCursor{Sort: ports.ProductSort{Field: ports.SortByPrice}, Price: testPrice(t, "20"), ID: 8, Direction: ports.CursorNext}
+	next := &ports.ProductCursor{Sort: cursor.Sort, Price: testPrice(t, "10"), ID: 7, Direction: ports.CursorNext}
+
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
 
 	mockRepo.EXPECT().
-		List(ctx, 2, 10).
-		Return(testProducts[10:15], 15, nil)
+		List(ctx, ports.ProductListQuery{Page: 1, Limit: 100, Cursor: cursor, Sort: cursor.Sort}).
+		Return(ports.ProductListResult{Products: []domain.Product{*product}, NextCursor: next}, nil)
 
-	products, total, err = useCase.GetProducts(ctx, 2, 10)
+	result, err := useCase.GetProducts(ctx, ports.ProductListQuery{Limit: 500, Cursor: cursor})
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
 
-	if len(products) != 5 {
-		t.Errorf("Expected 5 products on page 2, got %d", len(products))
+	if result.NextCursor != next {
+		t.Errorf("Expected next cursor to be passed through, got %+v", result.NextCursor)
+	}
+
+	if len(result.Products) != 1 {
+		t.Errorf("Expected 1 product, got %d", len(result.Products))
+	}
+}
+
+func TestProductUseCase_GetProducts_FilterAndSort_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	minPrice, maxPrice := testPrice(t, "10"), testPrice(t, "50")
+	query := ports.ProductListQuery{
+		Page:  1,
+		Limit: 20,
+		Filter: ports.ProductListFilter{
+			NameContains: "phone",
+			MinPrice:     &minPrice,
+			MaxPrice:     &maxPrice,
+		},
+		Sort: ports.ProductSort{Field: ports.SortByPrice, Descending: true},
+	}
+
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockRepo.EXPECT().
+		List(ctx, query).
+		Return(ports.ProductListResult{}, nil)
+
+	if _, err := useCase.GetProducts(ctx, query); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	query.Filter.MinPrice = &maxPrice
+	query.Filter.MaxPrice = &minPrice
+	_, err := useCase.GetProducts(ctx, query)
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for inverted price range, got: %v", err)
+	}
+
+	after := time.Now()
+	before := after.Add(-time.Hour)
+	query.Filter = ports.ProductListFilter{CreatedAfter: &after, CreatedBefore: &before}
+	_, err = useCase.GetProducts(ctx, query)
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for inverted date range, got: %v", err)
+	}
+}
+
+func TestProductUseCase_GetProducts_PriceCurrency_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+	ctx := context.Background()
+	minEUR, err := domain.ParsePriceBound("100", "EUR")
+	if err != nil {
+		t.Fatalf("Failed to build price: %v", err)
+	}
+	minUSD := testPrice(t, "100")
+	bySort := ports.ProductSort{Field: ports.SortByPrice}
+
+	query := ports.ProductListQuery{
+		Page:   1,
+		Limit:  10,
+		Filter: ports.ProductListFilter{Currency: "EUR", MinPrice: &minEUR},
+		Sort:   bySort,
+	}
+	mockRepo.EXPECT().List(ctx, query).Return(ports.ProductListResult{}, nil)
+	if _, err := useCase.GetProducts(ctx, query); err != nil {
+		t.Fatalf("Expected EUR bounds to be accepted for a EUR list, got: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query ports.ProductListQuery
+	}{
+		{
+			name:  "bound in another currency",
+			query: ports.ProductListQuery{Filter: ports.ProductListFilter{Currency: "EUR", MinPrice: &minUSD}},
+		},
+		{
+			name:  "bound in another currency than the default",
+			query: ports.ProductListQuery{Filter: ports.ProductListFilter{MinPrice: &minEUR}},
+		},
+		{
+			name: "price cursor from another currency",
+			query: ports.ProductListQuery{
+				Filter: ports.ProductListFilter{Currency: "EUR"},
+				Cursor: &ports.ProductCursor{Sort: bySort, Price: minUSD, ID: 3, Direction: ports.CursorNext},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := useCase.GetProducts(ctx, tt.query); !errors.Is(err, domain.ErrInvalidInput) {
+				t.Errorf("Expected ErrInvalidInput, got: %v", err)
+			}
+		})
 	}
 }
 
@@ -172,15 +511,18 @@ func TestProductUseCase_DeleteProduct_WithGeneratedMocks(t *testing.T) {
 	ctx := context.Background()
 	productID := 1
 
-	product, err := domain.NewProduct("Test Product", 99.99)
+	product, err := domain.NewProduct("Test Product", testPrice(t, "99.99"))
 	if err != nil {
 		t.Fatalf("Failed to create product: %v", err)
 	}
 	product.ID = productID
 
 	mockRepo.EXPECT().
-		GetByID(ctx, productID).
+		GetByID(ctx, productID, false).
 		Return(product, nil)
+	mockRepo.EXPECT().
+		CountActiveVariants(ctx, productID).
+		Return(0, nil)
 
 	product.RecordDeleteEvent()
 
@@ -188,7 +530,7 @@ func TestProductUseCase_DeleteProduct_WithGeneratedMocks(t *testing.T) {
 		DeleteProductWithEvent(ctx, product, "").
 		Return(nil)
 
-	err = useCase.DeleteProduct(ctx, productID, "")
+	err = useCase.DeleteProduct(ctx, productID, 0, "")
 	if err != nil {
 		t.Fatalf("Expected no error, got: %v", err)
 	}
@@ -215,12 +557,495 @@ func TestProductUseCase_DeleteProduct_NotFound_WithGeneratedMocks(t *testing.T)
 	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
 
 	mockRepo.EXPECT().
-		GetByID(ctx, 999).
+		GetByID(ctx, 999, false).
 		Return(nil, domain.ErrProductNotFound)
 
-	err := useCase.DeleteProduct(ctx, 999, "")
+	err := useCase.DeleteProduct(ctx, 999, 0, "")
 	if !errors.Is(err, domain.ErrProductNotFound) {
 		t.Errorf("Expected ErrProductNotFound, got: %v", err)
 	}
 }
 
+
+func TestProductUseCase_GetProduct_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+	productID := 1
+
+	product, err := domain.NewProduct("Test Product", testPrice(t, "99.99"))
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	product.ID = productID
+
+	mockRepo.EXPECT().
+		GetByID(ctx, productID, false).
+		Return(product, nil)
+
+	result, err := useCase.GetProduct(ctx, productID, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.ID != productID {
+		t.Errorf("Expected product ID %d, got %d", productID, result.ID)
+	}
+
+	if result.Name.Value() != "Test Product" {
+		t.Errorf("Expected name %s, got %s", "Test Product", result.Name.Value())
+	}
+}
+
+func TestProductUseCase_GetProduct_NotFound_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockRepo.EXPECT().
+		GetByID(ctx, 999, false).
+		Return(nil, domain.ErrProductNotFound)
+
+	_, err := useCase.GetProduct(ctx, 999, false)
+	if !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("Expected ErrProductNotFound, got: %v", err)
+	}
+
+	_, err = useCase.GetProduct(ctx, 0, false)
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got: %v", err)
+	}
+}
+
+func TestProductUseCase_GetProductsByIDs_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	product, err := domain.NewProduct("Test Product", testPrice(t, "99.99"))
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	product.ID = 3
+
+	mockRepo.EXPECT().
+		GetByIDs(ctx, []int{3, 7}, true).
+		Return([]domain.Product{*product}, nil)
+
+	products, err := useCase.GetProductsByIDs(ctx, []int{3, 7, 3}, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(products) != 1 || products[0].ID != 3 {
+		t.Errorf("Expected product 3 only, got: %v", products)
+	}
+
+	if _, err := useCase.GetProductsByIDs(ctx, []int{1, -2}, false); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for a negative id, got: %v", err)
+	}
+
+	tooMany := make([]int, MaxProductLookupSize+1)
+	for i := range tooMany {
+		tooMany[i] = i + 1
+	}
+	if _, err := useCase.GetProductsByIDs(ctx, tooMany, false); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for too many ids, got: %v", err)
+	}
+}
+
+func TestProductUseCase_UpdateProduct_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+	productID := 1
+
+	product, err := domain.NewProduct("Test Product", testPrice(t, "99.99"))
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	product.ID = productID
+	product.Version = 1
+
+	mockRepo.EXPECT().
+		GetByID(ctx, productID, false).
+		Return(product, nil)
+
+	mockAppService.EXPECT().
+		UpdateProductWithEvent(ctx, product, "update-key").
+		DoAndReturn(func(ctx context.Context, p *domain.Product, key string) error {
+			events := p.DomainEvents()
+			if len(events) != 1 {
+				t.Fatalf("Expected 1 domain event, got %d", len(events))
+			}
+			updated, ok := events[0].(domain.ProductUpdatedEvent)
+			if !ok {
+				t.Fatalf("Expected ProductUpdatedEvent, got %T", events[0])
+			}
+			if _, ok := updated.Changes["name"]; ok {
+				t.Error("Expected name to be absent from changes")
+			}
+			if change, ok := updated.Changes["price"]; !ok || change.To != "149.99" {
+				t.Errorf("Expected price change to 149.99, got %+v", change)
+			}
+			return nil
+		})
+
+	newPrice := testPrice(t, "149.99")
+	result, err := useCase.UpdateProduct(ctx, productID, ProductUpdate{Price: &PriceInput{Amount: "149.99"}}, 1, "update-key")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.Name.Value() != "Test Product" {
+		t.Errorf("Expected name to stay %s, got %s", "Test Product", result.Name.Value())
+	}
+
+	if !result.Price.Equal(newPrice) {
+		t.Errorf("Expected price %s, got %s", newPrice, result.Price)
+	}
+}
+
+func TestProductUseCase_UpdateProduct_NoChanges_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	product, err := domain.NewProduct("Test Product", testPrice(t, "99.99"))
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	product.ID = 1
+
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockRepo.EXPECT().
+		GetByID(ctx, 1, false).
+		Return(product, nil)
+
+	name := "Test Product"
+	if _, err := useCase.UpdateProduct(ctx, 1, ProductUpdate{Name: &name, Price: &PriceInput{Amount: "99.99"}}, 0, ""); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+}
+
+func TestProductUseCase_UpdateProduct_PriceCurrency_WithGeneratedMocks(t *testing.T) {
+	tests := []struct {
+		name           string
+		price          PriceInput
+		wantBase       string
+		wantSecondary  []string
+		wantCurrencyCh bool
+	}{
+		{name: "keeps base currency", price: PriceInput{Amount: "12.50"}, wantBase: "EUR"},
+		{name: "same explicit currency", price: PriceInput{Amount: "12.50", Currency: "eur"}, wantBase: "EUR"},
+		{name: "explicit switch", price: PriceInput{Amount: "12.50", Currency: "USD"}, wantBase: "USD", wantSecondary: []string{"EUR"}, wantCurrencyCh: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockProductRepository(ctrl)
+			mockAppService := mocks.NewMockProductApplicationService(ctrl)
+			mockLogger := mocks.NewMockLogger(ctrl)
+			useCase := NewProductUseCase(mockRepo, mockAppService, domainServices.NewProductDomainService(nil), mockLogger)
+
+			ctx := context.Background()
+			base, err := domain.ParseMoney("10.00", "EUR")
+			if err != nil {
+				t.Fatalf("Failed to parse price: %v", err)
+			}
+			product, err := domain.NewProduct("Euro Product", base)
+			if err != nil {
+				t.Fatalf("Failed to create product: %v", err)
+			}
+			product.ID = 1
+
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			mockRepo.EXPECT().GetByID(ctx, 1, false).Return(product, nil)
+
+			mockAppService.EXPECT().
+				UpdateProductWithEvent(ctx, product, "").
+				DoAndReturn(func(ctx context.Context, p *domain.Product, key string) error {
+					updated := p.DomainEvents()[0].(domain.ProductUpdatedEvent)
+					if _, ok := updated.Changes["currency"]; ok != tt.wantCurrencyCh {
+						t.Errorf("Expected currency change %v, got %+v", tt.wantCurrencyCh, updated.Changes)
+					}
+					return nil
+				})
+
+			result, err := useCase.UpdateProduct(ctx, 1, ProductUpdate{Price: &tt.price}, 0, "")
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			if result.Price.Currency() != tt.wantBase || result.Price.Amount() != "12.50" {
+				t.Errorf("Expected base price 12.50 %s, got %s", tt.wantBase, result.Price)
+			}
+			if len(result.Prices) != 1+len(tt.wantSecondary) {
+				t.Errorf("Expected %d price rows, got %v", 1+len(tt.wantSecondary), result.PriceAmounts())
+			}
+			for _, currency := range tt.wantSecondary {
+				if _, ok := result.Prices[currency]; !ok {
+					t.Errorf("Expected %s price to be kept, got %v", currency, result.PriceAmounts())
+				}
+			}
+		})
+	}
+}
+
+func TestProductUseCase_UpdateProduct_InvalidInput_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	_, err := useCase.UpdateProduct(ctx, 1, ProductUpdate{}, 0, "")
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for empty update, got: %v", err)
+	}
+
+	product, _ := domain.NewProduct("Test Product", testPrice(t, "99.99"))
+	product.ID = 1
+
+	mockRepo.EXPECT().
+		GetByID(ctx, 1, false).
+		Return(product, nil)
+
+	blank := "   "
+	_, err = useCase.UpdateProduct(ctx, 1, ProductUpdate{Name: &blank}, 0, "")
+	if !errors.Is(err, domain.ErrInvalidProductName) {
+		t.Errorf("Expected ErrInvalidProductName, got: %v", err)
+	}
+}
+
+func TestProductUseCase_VersionConflict_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	product, err := domain.NewProduct("Test Product", testPrice(t, "99.99"))
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	product.ID = 1
+	product.Version = 3
+
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockRepo.EXPECT().
+		GetByID(ctx, 1, false).
+		Return(product, nil).
+		Times(2)
+
+	_, err = useCase.UpdateProduct(ctx, 1, ProductUpdate{Price: &PriceInput{Amount: "10"}}, 2, "")
+	if !errors.Is(err, domain.ErrVersionConflict) {
+		t.Errorf("Expected ErrVersionConflict on update, got: %v", err)
+	}
+
+	err = useCase.DeleteProduct(ctx, 1, 2, "")
+	if !errors.Is(err, domain.ErrVersionConflict) {
+		t.Errorf("Expected ErrVersionConflict on delete, got: %v", err)
+	}
+}
+
+func TestProductUseCase_RestoreProduct_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	product, err := domain.NewProduct("Test Product", testPrice(t, "99.99"))
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	product.ID = 1
+	deletedAt := time.Now()
+	product.DeletedAt = &deletedAt
+
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockRepo.EXPECT().
+		GetByID(ctx, 1, true).
+		Return(product, nil).
+		Times(2)
+
+	mockAppService.EXPECT().
+		RestoreProductWithEvent(ctx, product, "").
+		Return(nil)
+
+	result, err := useCase.RestoreProduct(ctx, 1, 0, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.IsDeleted() {
+		t.Error("Expected product to be restored")
+	}
+
+	_, err = useCase.RestoreProduct(ctx, 1, 0, "")
+	if !errors.Is(err, domain.ErrProductNotDeleted) {
+		t.Errorf("Expected ErrProductNotDeleted, got: %v", err)
+	}
+}
+
+func TestProductUseCase_SearchProducts_WithGeneratedMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductRepository(ctrl)
+	mockAppService := mocks.NewMockProductApplicationService(ctrl)
+	mockLogger := mocks.NewMockLogger(ctrl)
+	domainService := domainServices.NewProductDomainService(nil)
+
+	useCase := NewProductUseCase(
+		mockRepo,
+		mockAppService,
+		domainService,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+
+	product, _ := domain.NewProduct("Wireless Headphones", testPrice(t, "199.99"))
+	product.ID = 1
+
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockRepo.EXPECT().
+		Search(ctx, ports.ProductSearchQuery{Text: "headphon", Limit: 20}).
+		Return(ports.ProductSearchResult{
+			Hits: []ports.ProductSearchHit{{Product: *product, Rank: 0.5, Highlight: "Wireless <mark>Headphones</mark>"}},
+		}, nil)
+
+	result, err := useCase.SearchProducts(ctx, ports.ProductSearchQuery{Text: "  headphon  "})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(result.Hits) != 1 || result.Hits[0].Product.ID != 1 {
+		t.Errorf("Expected single hit for product 1, got %+v", result.Hits)
+	}
+
+	_, err = useCase.SearchProducts(ctx, ports.ProductSearchQuery{Text: "   "})
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for blank query, got: %v", err)
+	}
+}
+
+func testPrice(t *testing.T, amount string) domain.Money {
+	t.Helper()
+	price, err := domain.ParseMoney(amount, domain.DefaultCurrency)
+	if err != nil {
+		t.Fatalf("Failed to parse price %q: %v", amount, err)
+	}
+	return price
+}
@@ -0,0 +1,3 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_price_positive;
+
+ALTER TABLE products DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS product_prices (
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    currency CHAR(3) NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL CHECK (amount > 0),
+    PRIMARY KEY (product_id, currency)
+);
+
+INSERT INTO product_prices (product_id, currency, amount)
+SELECT id, currency, price FROM products
+ON CONFLICT (product_id, currency) DO NOTHING;
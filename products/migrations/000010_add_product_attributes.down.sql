@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_products_parent_id;
+DROP INDEX IF EXISTS idx_products_attributes;
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_parent_not_self,
+    DROP CONSTRAINT IF EXISTS products_attributes_object;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS parent_id,
+    DROP COLUMN IF EXISTS attributes;
@@ -0,0 +1,10 @@
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ADD COLUMN IF NOT EXISTS parent_id INTEGER REFERENCES products(id) ON DELETE RESTRICT;
+
+ALTER TABLE products
+    ADD CONSTRAINT products_attributes_object CHECK (jsonb_typeof(attributes) = 'object'),
+    ADD CONSTRAINT products_parent_not_self CHECK (parent_id IS NULL OR parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_products_attributes ON products USING GIN (attributes jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS idx_products_parent_id ON products(parent_id) WHERE parent_id IS NOT NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS product_history (
+    id BIGSERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    changes JSONB NOT NULL DEFAULT '{}'::jsonb,
+    actor VARCHAR(255) NOT NULL,
+    request_id VARCHAR(100),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_history_product_id ON product_history(product_id, id DESC);
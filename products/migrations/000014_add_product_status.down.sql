@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_products_status;
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_status_valid;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,8 @@
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active';
+
+ALTER TABLE products
+    ALTER COLUMN status SET DEFAULT 'draft',
+    ADD CONSTRAINT products_status_valid CHECK (status IN ('draft', 'active', 'discontinued', 'archived'));
+
+CREATE INDEX IF NOT EXISTS idx_products_status ON products(status) WHERE deleted_at IS NULL;
@@ -0,0 +1,19 @@
+DROP POLICY IF EXISTS outbox_tenant_isolation ON outbox;
+ALTER TABLE outbox NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE outbox DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS products_tenant_isolation ON products;
+ALTER TABLE products NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE products DISABLE ROW LEVEL SECURITY;
+
+ALTER TABLE outbox
+    DROP CONSTRAINT IF EXISTS outbox_tenant_idempotency_key_key,
+    ADD CONSTRAINT outbox_idempotency_key_key UNIQUE (idempotency_key);
+
+DROP INDEX IF EXISTS idx_products_tenant_created;
+
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS tenant_id;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS tenant_id;
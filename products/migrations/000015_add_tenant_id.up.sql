@@ -0,0 +1,25 @@
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+
+CREATE INDEX IF NOT EXISTS idx_products_tenant_created ON products(tenant_id, created_at, id) WHERE deleted_at IS NULL;
+
+ALTER TABLE outbox
+    DROP CONSTRAINT IF EXISTS outbox_idempotency_key_key,
+    ADD CONSTRAINT outbox_tenant_idempotency_key_key UNIQUE (tenant_id, idempotency_key);
+
+ALTER TABLE products ENABLE ROW LEVEL SECURITY;
+ALTER TABLE products FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY products_tenant_isolation ON products
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id))
+    WITH CHECK (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id));
+
+ALTER TABLE outbox ENABLE ROW LEVEL SECURITY;
+ALTER TABLE outbox FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY outbox_tenant_isolation ON outbox
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id))
+    WITH CHECK (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id));
@@ -0,0 +1,46 @@
+DROP POLICY IF EXISTS stock_reservations_tenant_isolation ON stock_reservations;
+ALTER TABLE stock_reservations NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE stock_reservations DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS product_categories_tenant_isolation ON product_categories;
+ALTER TABLE product_categories NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE product_categories DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS categories_tenant_isolation ON categories;
+ALTER TABLE categories NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE categories DISABLE ROW LEVEL SECURITY;
+
+DROP INDEX IF EXISTS idx_categories_tenant_path;
+
+ALTER TABLE stock_reservations
+    DROP CONSTRAINT IF EXISTS stock_reservations_product_tenant_fkey,
+    ADD CONSTRAINT stock_reservations_product_id_fkey FOREIGN KEY (product_id)
+        REFERENCES products(id) ON DELETE CASCADE;
+
+ALTER TABLE product_categories
+    DROP CONSTRAINT IF EXISTS product_categories_category_tenant_fkey,
+    DROP CONSTRAINT IF EXISTS product_categories_product_tenant_fkey,
+    ADD CONSTRAINT product_categories_product_id_fkey FOREIGN KEY (product_id)
+        REFERENCES products(id) ON DELETE CASCADE,
+    ADD CONSTRAINT product_categories_category_id_fkey FOREIGN KEY (category_id)
+        REFERENCES categories(id) ON DELETE RESTRICT;
+
+ALTER TABLE categories
+    DROP CONSTRAINT IF EXISTS categories_parent_tenant_fkey,
+    ADD CONSTRAINT categories_parent_id_fkey FOREIGN KEY (parent_id)
+        REFERENCES categories(id) ON DELETE RESTRICT,
+    DROP CONSTRAINT IF EXISTS categories_id_tenant_key,
+    DROP CONSTRAINT IF EXISTS categories_tenant_slug_key,
+    ADD CONSTRAINT categories_slug_key UNIQUE (slug);
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_id_tenant_key;
+
+ALTER TABLE stock_reservations
+    DROP COLUMN IF EXISTS tenant_id;
+
+ALTER TABLE product_categories
+    DROP COLUMN IF EXISTS tenant_id;
+
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS tenant_id;
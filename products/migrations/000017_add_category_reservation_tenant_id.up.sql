@@ -0,0 +1,65 @@
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+
+ALTER TABLE product_categories
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+
+ALTER TABLE stock_reservations
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+
+UPDATE product_categories pc
+SET tenant_id = p.tenant_id
+FROM products p
+WHERE p.id = pc.product_id AND pc.tenant_id <> p.tenant_id;
+
+UPDATE stock_reservations r
+SET tenant_id = p.tenant_id
+FROM products p
+WHERE p.id = r.product_id AND r.tenant_id <> p.tenant_id;
+
+ALTER TABLE products
+    ADD CONSTRAINT products_id_tenant_key UNIQUE (id, tenant_id);
+
+ALTER TABLE categories
+    DROP CONSTRAINT IF EXISTS categories_slug_key,
+    ADD CONSTRAINT categories_tenant_slug_key UNIQUE (tenant_id, slug),
+    ADD CONSTRAINT categories_id_tenant_key UNIQUE (id, tenant_id),
+    DROP CONSTRAINT IF EXISTS categories_parent_id_fkey,
+    ADD CONSTRAINT categories_parent_tenant_fkey FOREIGN KEY (parent_id, tenant_id)
+        REFERENCES categories(id, tenant_id) ON DELETE RESTRICT;
+
+ALTER TABLE product_categories
+    DROP CONSTRAINT IF EXISTS product_categories_product_id_fkey,
+    DROP CONSTRAINT IF EXISTS product_categories_category_id_fkey,
+    ADD CONSTRAINT product_categories_product_tenant_fkey FOREIGN KEY (product_id, tenant_id)
+        REFERENCES products(id, tenant_id) ON DELETE CASCADE,
+    ADD CONSTRAINT product_categories_category_tenant_fkey FOREIGN KEY (category_id, tenant_id)
+        REFERENCES categories(id, tenant_id) ON DELETE RESTRICT;
+
+ALTER TABLE stock_reservations
+    DROP CONSTRAINT IF EXISTS stock_reservations_product_id_fkey,
+    ADD CONSTRAINT stock_reservations_product_tenant_fkey FOREIGN KEY (product_id, tenant_id)
+        REFERENCES products(id, tenant_id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_categories_tenant_path ON categories(tenant_id, path);
+
+ALTER TABLE categories ENABLE ROW LEVEL SECURITY;
+ALTER TABLE categories FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY categories_tenant_isolation ON categories
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id))
+    WITH CHECK (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id));
+
+ALTER TABLE product_categories ENABLE ROW LEVEL SECURITY;
+ALTER TABLE product_categories FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY product_categories_tenant_isolation ON product_categories
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id))
+    WITH CHECK (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id));
+
+ALTER TABLE stock_reservations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE stock_reservations FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY stock_reservations_tenant_isolation ON stock_reservations
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id))
+    WITH CHECK (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id));
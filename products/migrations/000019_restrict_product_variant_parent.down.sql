@@ -0,0 +1,4 @@
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_parent_id_fkey,
+    ADD CONSTRAINT products_parent_id_fkey FOREIGN KEY (parent_id)
+        REFERENCES products(id) ON DELETE CASCADE;
@@ -14,6 +14,7 @@ import (
 	domain "product_service/products/internal/domain"
 	ports "product_service/products/internal/usecase/ports"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -56,6 +57,20 @@ func (mr *MockProductApplicationServiceMockRecorder) CreateProductWithEvent(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductWithEvent", reflect.TypeOf((*MockProductApplicationService)(nil).CreateProductWithEvent), ctx, product, idempotencyKey)
 }
 
+// CreateProductsWithEvents mocks base method.
+func (m *MockProductApplicationService) CreateProductsWithEvents(ctx context.Context, products []*domain.Product, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductsWithEvents", ctx, products, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProductsWithEvents indicates an expected call of CreateProductsWithEvents.
+func (mr *MockProductApplicationServiceMockRecorder) CreateProductsWithEvents(ctx, products, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductsWithEvents", reflect.TypeOf((*MockProductApplicationService)(nil).CreateProductsWithEvents), ctx, products, idempotencyKey)
+}
+
 // DeleteProductWithEvent mocks base method.
 func (m *MockProductApplicationService) DeleteProductWithEvent(ctx context.Context, product *domain.Product, idempotencyKey string) error {
 	m.ctrl.T.Helper()
@@ -70,6 +85,231 @@ func (mr *MockProductApplicationServiceMockRecorder) DeleteProductWithEvent(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductWithEvent", reflect.TypeOf((*MockProductApplicationService)(nil).DeleteProductWithEvent), ctx, product, idempotencyKey)
 }
 
+// ImportProductsWithEvents mocks base method.
+func (m *MockProductApplicationService) ImportProductsWithEvents(ctx context.Context, created, updated []*domain.Product, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportProductsWithEvents", ctx, created, updated, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportProductsWithEvents indicates an expected call of ImportProductsWithEvents.
+func (mr *MockProductApplicationServiceMockRecorder) ImportProductsWithEvents(ctx, created, updated, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportProductsWithEvents", reflect.TypeOf((*MockProductApplicationService)(nil).ImportProductsWithEvents), ctx, created, updated, idempotencyKey)
+}
+
+// RestoreProductWithEvent mocks base method.
+func (m *MockProductApplicationService) RestoreProductWithEvent(ctx context.Context, product *domain.Product, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProductWithEvent", ctx, product, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreProductWithEvent indicates an expected call of RestoreProductWithEvent.
+func (mr *MockProductApplicationServiceMockRecorder) RestoreProductWithEvent(ctx, product, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProductWithEvent", reflect.TypeOf((*MockProductApplicationService)(nil).RestoreProductWithEvent), ctx, product, idempotencyKey)
+}
+
+// UpdateProductWithEvent mocks base method.
+func (m *MockProductApplicationService) UpdateProductWithEvent(ctx context.Context, product *domain.Product, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductWithEvent", ctx, product, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductWithEvent indicates an expected call of UpdateProductWithEvent.
+func (mr *MockProductApplicationServiceMockRecorder) UpdateProductWithEvent(ctx, product, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductWithEvent", reflect.TypeOf((*MockProductApplicationService)(nil).UpdateProductWithEvent), ctx, product, idempotencyKey)
+}
+
+// MockInventoryApplicationService is a mock of InventoryApplicationService interface.
+type MockInventoryApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryApplicationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockInventoryApplicationServiceMockRecorder is the mock recorder for MockInventoryApplicationService.
+type MockInventoryApplicationServiceMockRecorder struct {
+	mock *MockInventoryApplicationService
+}
+
+// NewMockInventoryApplicationService creates a new mock instance.
+func NewMockInventoryApplicationService(ctrl *gomock.Controller) *MockInventoryApplicationService {
+	mock := &MockInventoryApplicationService{ctrl: ctrl}
+	mock.recorder = &MockInventoryApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryApplicationService) EXPECT() *MockInventoryApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// AdjustStockWithEvent mocks base method.
+func (m *MockInventoryApplicationService) AdjustStockWithEvent(ctx context.Context, productID, delta int, reason, idempotencyKey string) (*domain.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustStockWithEvent", ctx, productID, delta, reason, idempotencyKey)
+	ret0, _ := ret[0].(*domain.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustStockWithEvent indicates an expected call of AdjustStockWithEvent.
+func (mr *MockInventoryApplicationServiceMockRecorder) AdjustStockWithEvent(ctx, productID, delta, reason, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustStockWithEvent", reflect.TypeOf((*MockInventoryApplicationService)(nil).AdjustStockWithEvent), ctx, productID, delta, reason, idempotencyKey)
+}
+
+// ConfirmReservationWithEvent mocks base method.
+func (m *MockInventoryApplicationService) ConfirmReservationWithEvent(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmReservationWithEvent", ctx, reservationID, idempotencyKey)
+	ret0, _ := ret[0].(*domain.Reservation)
+	ret1, _ := ret[1].(*domain.Inventory)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConfirmReservationWithEvent indicates an expected call of ConfirmReservationWithEvent.
+func (mr *MockInventoryApplicationServiceMockRecorder) ConfirmReservationWithEvent(ctx, reservationID, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmReservationWithEvent", reflect.TypeOf((*MockInventoryApplicationService)(nil).ConfirmReservationWithEvent), ctx, reservationID, idempotencyKey)
+}
+
+// ReleaseExpiredReservations mocks base method.
+func (m *MockInventoryApplicationService) ReleaseExpiredReservations(ctx context.Context, now time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExpiredReservations", ctx, now, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseExpiredReservations indicates an expected call of ReleaseExpiredReservations.
+func (mr *MockInventoryApplicationServiceMockRecorder) ReleaseExpiredReservations(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpiredReservations", reflect.TypeOf((*MockInventoryApplicationService)(nil).ReleaseExpiredReservations), ctx, now, limit)
+}
+
+// ReleaseReservationWithEvent mocks base method.
+func (m *MockInventoryApplicationService) ReleaseReservationWithEvent(ctx context.Context, reservationID int64, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseReservationWithEvent", ctx, reservationID, idempotencyKey)
+	ret0, _ := ret[0].(*domain.Reservation)
+	ret1, _ := ret[1].(*domain.Inventory)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReleaseReservationWithEvent indicates an expected call of ReleaseReservationWithEvent.
+func (mr *MockInventoryApplicationServiceMockRecorder) ReleaseReservationWithEvent(ctx, reservationID, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseReservationWithEvent", reflect.TypeOf((*MockInventoryApplicationService)(nil).ReleaseReservationWithEvent), ctx, reservationID, idempotencyKey)
+}
+
+// ReserveStockWithEvent mocks base method.
+func (m *MockInventoryApplicationService) ReserveStockWithEvent(ctx context.Context, productID, quantity int, expiresAt time.Time, idempotencyKey string) (*domain.Reservation, *domain.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveStockWithEvent", ctx, productID, quantity, expiresAt, idempotencyKey)
+	ret0, _ := ret[0].(*domain.Reservation)
+	ret1, _ := ret[1].(*domain.Inventory)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReserveStockWithEvent indicates an expected call of ReserveStockWithEvent.
+func (mr *MockInventoryApplicationServiceMockRecorder) ReserveStockWithEvent(ctx, productID, quantity, expiresAt, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveStockWithEvent", reflect.TypeOf((*MockInventoryApplicationService)(nil).ReserveStockWithEvent), ctx, productID, quantity, expiresAt, idempotencyKey)
+}
+
+// MockCategoryApplicationService is a mock of CategoryApplicationService interface.
+type MockCategoryApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryApplicationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCategoryApplicationServiceMockRecorder is the mock recorder for MockCategoryApplicationService.
+type MockCategoryApplicationServiceMockRecorder struct {
+	mock *MockCategoryApplicationService
+}
+
+// NewMockCategoryApplicationService creates a new mock instance.
+func NewMockCategoryApplicationService(ctrl *gomock.Controller) *MockCategoryApplicationService {
+	mock := &MockCategoryApplicationService{ctrl: ctrl}
+	mock.recorder = &MockCategoryApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryApplicationService) EXPECT() *MockCategoryApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCategoryWithEvent mocks base method.
+func (m *MockCategoryApplicationService) CreateCategoryWithEvent(ctx context.Context, category *domain.Category, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategoryWithEvent", ctx, category, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCategoryWithEvent indicates an expected call of CreateCategoryWithEvent.
+func (mr *MockCategoryApplicationServiceMockRecorder) CreateCategoryWithEvent(ctx, category, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryWithEvent", reflect.TypeOf((*MockCategoryApplicationService)(nil).CreateCategoryWithEvent), ctx, category, idempotencyKey)
+}
+
+// DeleteCategoryWithEvent mocks base method.
+func (m *MockCategoryApplicationService) DeleteCategoryWithEvent(ctx context.Context, category *domain.Category, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryWithEvent", ctx, category, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategoryWithEvent indicates an expected call of DeleteCategoryWithEvent.
+func (mr *MockCategoryApplicationServiceMockRecorder) DeleteCategoryWithEvent(ctx, category, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryWithEvent", reflect.TypeOf((*MockCategoryApplicationService)(nil).DeleteCategoryWithEvent), ctx, category, idempotencyKey)
+}
+
+// MoveCategoryWithEvent mocks base method.
+func (m *MockCategoryApplicationService) MoveCategoryWithEvent(ctx context.Context, id int, parentID *int, expectedVersion int, check ports.CategoryMoveCheck, idempotencyKey string) (*domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCategoryWithEvent", ctx, id, parentID, expectedVersion, check, idempotencyKey)
+	ret0, _ := ret[0].(*domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCategoryWithEvent indicates an expected call of MoveCategoryWithEvent.
+func (mr *MockCategoryApplicationServiceMockRecorder) MoveCategoryWithEvent(ctx, id, parentID, expectedVersion, check, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCategoryWithEvent", reflect.TypeOf((*MockCategoryApplicationService)(nil).MoveCategoryWithEvent), ctx, id, parentID, expectedVersion, check, idempotencyKey)
+}
+
+// UpdateCategoryWithEvent mocks base method.
+func (m *MockCategoryApplicationService) UpdateCategoryWithEvent(ctx context.Context, category *domain.Category, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategoryWithEvent", ctx, category, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategoryWithEvent indicates an expected call of UpdateCategoryWithEvent.
+func (mr *MockCategoryApplicationServiceMockRecorder) UpdateCategoryWithEvent(ctx, category, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryWithEvent", reflect.TypeOf((*MockCategoryApplicationService)(nil).UpdateCategoryWithEvent), ctx, category, idempotencyKey)
+}
+
 // MockUoWFactory is a mock of UoWFactory interface.
 type MockUoWFactory struct {
 	ctrl     *gomock.Controller
@@ -11,6 +11,7 @@ package mocks
 
 import (
 	context "context"
+	domain "product_service/products/internal/domain"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -54,18 +55,88 @@ func (mr *MockEventPublisherMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockEventPublisher)(nil).Close))
 }
 
+// PublishCategoryCreated mocks base method.
+func (m *MockEventPublisher) PublishCategoryCreated(ctx context.Context, category domain.CategorySnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishCategoryCreated", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishCategoryCreated indicates an expected call of PublishCategoryCreated.
+func (mr *MockEventPublisherMockRecorder) PublishCategoryCreated(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishCategoryCreated", reflect.TypeOf((*MockEventPublisher)(nil).PublishCategoryCreated), ctx, category)
+}
+
+// PublishCategoryDeleted mocks base method.
+func (m *MockEventPublisher) PublishCategoryDeleted(ctx context.Context, category domain.CategorySnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishCategoryDeleted", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishCategoryDeleted indicates an expected call of PublishCategoryDeleted.
+func (mr *MockEventPublisherMockRecorder) PublishCategoryDeleted(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishCategoryDeleted", reflect.TypeOf((*MockEventPublisher)(nil).PublishCategoryDeleted), ctx, category)
+}
+
+// PublishCategoryMoved mocks base method.
+func (m *MockEventPublisher) PublishCategoryMoved(ctx context.Context, category domain.CategorySnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishCategoryMoved", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishCategoryMoved indicates an expected call of PublishCategoryMoved.
+func (mr *MockEventPublisherMockRecorder) PublishCategoryMoved(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishCategoryMoved", reflect.TypeOf((*MockEventPublisher)(nil).PublishCategoryMoved), ctx, category)
+}
+
+// PublishCategoryUpdated mocks base method.
+func (m *MockEventPublisher) PublishCategoryUpdated(ctx context.Context, category domain.CategorySnapshot, changes map[string]domain.FieldChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishCategoryUpdated", ctx, category, changes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishCategoryUpdated indicates an expected call of PublishCategoryUpdated.
+func (mr *MockEventPublisherMockRecorder) PublishCategoryUpdated(ctx, category, changes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishCategoryUpdated", reflect.TypeOf((*MockEventPublisher)(nil).PublishCategoryUpdated), ctx, category, changes)
+}
+
+// PublishOutOfStock mocks base method.
+func (m *MockEventPublisher) PublishOutOfStock(ctx context.Context, productID int, stock domain.StockSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishOutOfStock", ctx, productID, stock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishOutOfStock indicates an expected call of PublishOutOfStock.
+func (mr *MockEventPublisherMockRecorder) PublishOutOfStock(ctx, productID, stock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishOutOfStock", reflect.TypeOf((*MockEventPublisher)(nil).PublishOutOfStock), ctx, productID, stock)
+}
+
 // PublishProductCreated mocks base method.
-func (m *MockEventPublisher) PublishProductCreated(ctx context.Context, productID int) error {
+func (m *MockEventPublisher) PublishProductCreated(ctx context.Context, productID int, details domain.ProductEventDetails) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishProductCreated", ctx, productID)
+	ret := m.ctrl.Call(m, "PublishProductCreated", ctx, productID, details)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PublishProductCreated indicates an expected call of PublishProductCreated.
-func (mr *MockEventPublisherMockRecorder) PublishProductCreated(ctx, productID any) *gomock.Call {
+func (mr *MockEventPublisherMockRecorder) PublishProductCreated(ctx, productID, details any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProductCreated", reflect.TypeOf((*MockEventPublisher)(nil).PublishProductCreated), ctx, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProductCreated", reflect.TypeOf((*MockEventPublisher)(nil).PublishProductCreated), ctx, productID, details)
 }
 
 // PublishProductDeleted mocks base method.
@@ -82,6 +153,76 @@ func (mr *MockEventPublisherMockRecorder) PublishProductDeleted(ctx, productID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProductDeleted", reflect.TypeOf((*MockEventPublisher)(nil).PublishProductDeleted), ctx, productID)
 }
 
+// PublishProductRestored mocks base method.
+func (m *MockEventPublisher) PublishProductRestored(ctx context.Context, productID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishProductRestored", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishProductRestored indicates an expected call of PublishProductRestored.
+func (mr *MockEventPublisherMockRecorder) PublishProductRestored(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProductRestored", reflect.TypeOf((*MockEventPublisher)(nil).PublishProductRestored), ctx, productID)
+}
+
+// PublishProductStatusChanged mocks base method.
+func (m *MockEventPublisher) PublishProductStatusChanged(ctx context.Context, eventType string, productID int, from, to domain.ProductStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishProductStatusChanged", ctx, eventType, productID, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishProductStatusChanged indicates an expected call of PublishProductStatusChanged.
+func (mr *MockEventPublisherMockRecorder) PublishProductStatusChanged(ctx, eventType, productID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProductStatusChanged", reflect.TypeOf((*MockEventPublisher)(nil).PublishProductStatusChanged), ctx, eventType, productID, from, to)
+}
+
+// PublishProductUpdated mocks base method.
+func (m *MockEventPublisher) PublishProductUpdated(ctx context.Context, productID int, changes map[string]domain.FieldChange, details domain.ProductEventDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishProductUpdated", ctx, productID, changes, details)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishProductUpdated indicates an expected call of PublishProductUpdated.
+func (mr *MockEventPublisherMockRecorder) PublishProductUpdated(ctx, productID, changes, details any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProductUpdated", reflect.TypeOf((*MockEventPublisher)(nil).PublishProductUpdated), ctx, productID, changes, details)
+}
+
+// PublishStockChanged mocks base method.
+func (m *MockEventPublisher) PublishStockChanged(ctx context.Context, productID int, stock domain.StockSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishStockChanged", ctx, productID, stock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishStockChanged indicates an expected call of PublishStockChanged.
+func (mr *MockEventPublisherMockRecorder) PublishStockChanged(ctx, productID, stock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStockChanged", reflect.TypeOf((*MockEventPublisher)(nil).PublishStockChanged), ctx, productID, stock)
+}
+
+// PublishStockReserved mocks base method.
+func (m *MockEventPublisher) PublishStockReserved(ctx context.Context, productID int, stock domain.StockSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishStockReserved", ctx, productID, stock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishStockReserved indicates an expected call of PublishStockReserved.
+func (mr *MockEventPublisherMockRecorder) PublishStockReserved(ctx, productID, stock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStockReserved", reflect.TypeOf((*MockEventPublisher)(nil).PublishStockReserved), ctx, productID, stock)
+}
+
 // MockEventPublisherHealthChecker is a mock of EventPublisherHealthChecker interface.
 type MockEventPublisherHealthChecker struct {
 	ctrl     *gomock.Controller
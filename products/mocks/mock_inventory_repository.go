@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/ports/inventory_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/ports/inventory_repository.go -destination=mocks/mock_inventory_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "product_service/products/internal/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInventoryRepository is a mock of InventoryRepository interface.
+type MockInventoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockInventoryRepositoryMockRecorder is the mock recorder for MockInventoryRepository.
+type MockInventoryRepositoryMockRecorder struct {
+	mock *MockInventoryRepository
+}
+
+// NewMockInventoryRepository creates a new mock instance.
+func NewMockInventoryRepository(ctrl *gomock.Controller) *MockInventoryRepository {
+	mock := &MockInventoryRepository{ctrl: ctrl}
+	mock.recorder = &MockInventoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryRepository) EXPECT() *MockInventoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateReservation mocks base method.
+func (m *MockInventoryRepository) CreateReservation(ctx context.Context, reservation *domain.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReservation", ctx, reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReservation indicates an expected call of CreateReservation.
+func (mr *MockInventoryRepositoryMockRecorder) CreateReservation(ctx, reservation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservation", reflect.TypeOf((*MockInventoryRepository)(nil).CreateReservation), ctx, reservation)
+}
+
+// Get mocks base method.
+func (m *MockInventoryRepository) Get(ctx context.Context, productID int) (*domain.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, productID)
+	ret0, _ := ret[0].(*domain.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInventoryRepositoryMockRecorder) Get(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInventoryRepository)(nil).Get), ctx, productID)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockInventoryRepository) GetForUpdate(ctx context.Context, productID int) (*domain.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, productID)
+	ret0, _ := ret[0].(*domain.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockInventoryRepositoryMockRecorder) GetForUpdate(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockInventoryRepository)(nil).GetForUpdate), ctx, productID)
+}
+
+// GetReservationForUpdate mocks base method.
+func (m *MockInventoryRepository) GetReservationForUpdate(ctx context.Context, id int64) (*domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationForUpdate", ctx, id)
+	ret0, _ := ret[0].(*domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationForUpdate indicates an expected call of GetReservationForUpdate.
+func (mr *MockInventoryRepositoryMockRecorder) GetReservationForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationForUpdate", reflect.TypeOf((*MockInventoryRepository)(nil).GetReservationForUpdate), ctx, id)
+}
+
+// ListExpiredReservations mocks base method.
+func (m *MockInventoryRepository) ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredReservations", ctx, now, limit)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredReservations indicates an expected call of ListExpiredReservations.
+func (mr *MockInventoryRepositoryMockRecorder) ListExpiredReservations(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredReservations", reflect.TypeOf((*MockInventoryRepository)(nil).ListExpiredReservations), ctx, now, limit)
+}
+
+// Save mocks base method.
+func (m *MockInventoryRepository) Save(ctx context.Context, inventory *domain.Inventory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, inventory)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockInventoryRepositoryMockRecorder) Save(ctx, inventory any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockInventoryRepository)(nil).Save), ctx, inventory)
+}
+
+// UpdateReservationStatus mocks base method.
+func (m *MockInventoryRepository) UpdateReservationStatus(ctx context.Context, reservation *domain.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReservationStatus", ctx, reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReservationStatus indicates an expected call of UpdateReservationStatus.
+func (mr *MockInventoryRepositoryMockRecorder) UpdateReservationStatus(ctx, reservation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReservationStatus", reflect.TypeOf((*MockInventoryRepository)(nil).UpdateReservationStatus), ctx, reservation)
+}
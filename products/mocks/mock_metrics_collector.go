@@ -40,6 +40,30 @@ func (m *MockMetricsCollector) EXPECT() *MockMetricsCollectorMockRecorder {
 	return m.recorder
 }
 
+// IncrementAuthorizationDenied mocks base method.
+func (m *MockMetricsCollector) IncrementAuthorizationDenied(permission string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementAuthorizationDenied", permission)
+}
+
+// IncrementAuthorizationDenied indicates an expected call of IncrementAuthorizationDenied.
+func (mr *MockMetricsCollectorMockRecorder) IncrementAuthorizationDenied(permission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementAuthorizationDenied", reflect.TypeOf((*MockMetricsCollector)(nil).IncrementAuthorizationDenied), permission)
+}
+
+// IncrementGRPCRequestCount mocks base method.
+func (m *MockMetricsCollector) IncrementGRPCRequestCount(method, code string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementGRPCRequestCount", method, code)
+}
+
+// IncrementGRPCRequestCount indicates an expected call of IncrementGRPCRequestCount.
+func (mr *MockMetricsCollectorMockRecorder) IncrementGRPCRequestCount(method, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementGRPCRequestCount", reflect.TypeOf((*MockMetricsCollector)(nil).IncrementGRPCRequestCount), method, code)
+}
+
 // IncrementProductsCreated mocks base method.
 func (m *MockMetricsCollector) IncrementProductsCreated() {
 	m.ctrl.T.Helper()
@@ -64,6 +88,42 @@ func (mr *MockMetricsCollectorMockRecorder) IncrementProductsDeleted() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementProductsDeleted", reflect.TypeOf((*MockMetricsCollector)(nil).IncrementProductsDeleted))
 }
 
+// IncrementProductsPurged mocks base method.
+func (m *MockMetricsCollector) IncrementProductsPurged(count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementProductsPurged", count)
+}
+
+// IncrementProductsPurged indicates an expected call of IncrementProductsPurged.
+func (mr *MockMetricsCollectorMockRecorder) IncrementProductsPurged(count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementProductsPurged", reflect.TypeOf((*MockMetricsCollector)(nil).IncrementProductsPurged), count)
+}
+
+// IncrementProductsRestored mocks base method.
+func (m *MockMetricsCollector) IncrementProductsRestored() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementProductsRestored")
+}
+
+// IncrementProductsRestored indicates an expected call of IncrementProductsRestored.
+func (mr *MockMetricsCollectorMockRecorder) IncrementProductsRestored() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementProductsRestored", reflect.TypeOf((*MockMetricsCollector)(nil).IncrementProductsRestored))
+}
+
+// IncrementProductsUpdated mocks base method.
+func (m *MockMetricsCollector) IncrementProductsUpdated() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementProductsUpdated")
+}
+
+// IncrementProductsUpdated indicates an expected call of IncrementProductsUpdated.
+func (mr *MockMetricsCollectorMockRecorder) IncrementProductsUpdated() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementProductsUpdated", reflect.TypeOf((*MockMetricsCollector)(nil).IncrementProductsUpdated))
+}
+
 // IncrementRequestCount mocks base method.
 func (m *MockMetricsCollector) IncrementRequestCount(method, endpoint, status string) {
 	m.ctrl.T.Helper()
@@ -76,6 +136,18 @@ func (mr *MockMetricsCollectorMockRecorder) IncrementRequestCount(method, endpoi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementRequestCount", reflect.TypeOf((*MockMetricsCollector)(nil).IncrementRequestCount), method, endpoint, status)
 }
 
+// IncrementReservationsExpired mocks base method.
+func (m *MockMetricsCollector) IncrementReservationsExpired(count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementReservationsExpired", count)
+}
+
+// IncrementReservationsExpired indicates an expected call of IncrementReservationsExpired.
+func (mr *MockMetricsCollectorMockRecorder) IncrementReservationsExpired(count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementReservationsExpired", reflect.TypeOf((*MockMetricsCollector)(nil).IncrementReservationsExpired), count)
+}
+
 // IncrementTransactionRetry mocks base method.
 func (m *MockMetricsCollector) IncrementTransactionRetry() {
 	m.ctrl.T.Helper()
@@ -136,6 +208,18 @@ func (mr *MockMetricsCollectorMockRecorder) RecordDatabaseQueryDuration(duration
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDatabaseQueryDuration", reflect.TypeOf((*MockMetricsCollector)(nil).RecordDatabaseQueryDuration), duration)
 }
 
+// RecordGRPCRequestDuration mocks base method.
+func (m *MockMetricsCollector) RecordGRPCRequestDuration(method, code string, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordGRPCRequestDuration", method, code, duration)
+}
+
+// RecordGRPCRequestDuration indicates an expected call of RecordGRPCRequestDuration.
+func (mr *MockMetricsCollectorMockRecorder) RecordGRPCRequestDuration(method, code, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGRPCRequestDuration", reflect.TypeOf((*MockMetricsCollector)(nil).RecordGRPCRequestDuration), method, code, duration)
+}
+
 // RecordOutboxEventProcessed mocks base method.
 func (m *MockMetricsCollector) RecordOutboxEventProcessed(eventType, status string) {
 	m.ctrl.T.Helper()
@@ -126,3 +126,57 @@ func (mr *MockOutboxRepositoryMockRecorder) SaveEvent(ctx, event any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEvent", reflect.TypeOf((*MockOutboxRepository)(nil).SaveEvent), ctx, event)
 }
+
+// MockOutboxAdminRepository is a mock of OutboxAdminRepository interface.
+type MockOutboxAdminRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxAdminRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxAdminRepositoryMockRecorder is the mock recorder for MockOutboxAdminRepository.
+type MockOutboxAdminRepositoryMockRecorder struct {
+	mock *MockOutboxAdminRepository
+}
+
+// NewMockOutboxAdminRepository creates a new mock instance.
+func NewMockOutboxAdminRepository(ctrl *gomock.Controller) *MockOutboxAdminRepository {
+	mock := &MockOutboxAdminRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxAdminRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxAdminRepository) EXPECT() *MockOutboxAdminRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockOutboxAdminRepository) ListDeadLetters(ctx context.Context, limit, offset int) ([]ports.OutboxEvent, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", ctx, limit, offset)
+	ret0, _ := ret[0].([]ports.OutboxEvent)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockOutboxAdminRepositoryMockRecorder) ListDeadLetters(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockOutboxAdminRepository)(nil).ListDeadLetters), ctx, limit, offset)
+}
+
+// RequeueDeadLetter mocks base method.
+func (m *MockOutboxAdminRepository) RequeueDeadLetter(ctx context.Context, eventID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLetter", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueDeadLetter indicates an expected call of RequeueDeadLetter.
+func (mr *MockOutboxAdminRepositoryMockRecorder) RequeueDeadLetter(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetter", reflect.TypeOf((*MockOutboxAdminRepository)(nil).RequeueDeadLetter), ctx, eventID)
+}
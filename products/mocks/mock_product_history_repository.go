@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/ports/product_history_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/ports/product_history_repository.go -destination=mocks/mock_product_history_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "product_service/products/internal/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProductHistoryRepository is a mock of ProductHistoryRepository interface.
+type MockProductHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProductHistoryRepositoryMockRecorder is the mock recorder for MockProductHistoryRepository.
+type MockProductHistoryRepositoryMockRecorder struct {
+	mock *MockProductHistoryRepository
+}
+
+// NewMockProductHistoryRepository creates a new mock instance.
+func NewMockProductHistoryRepository(ctrl *gomock.Controller) *MockProductHistoryRepository {
+	mock := &MockProductHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockProductHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductHistoryRepository) EXPECT() *MockProductHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockProductHistoryRepository) Append(ctx context.Context, entries []domain.ProductHistoryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockProductHistoryRepositoryMockRecorder) Append(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockProductHistoryRepository)(nil).Append), ctx, entries)
+}
+
+// ListByProduct mocks base method.
+func (m *MockProductHistoryRepository) ListByProduct(ctx context.Context, productID, limit, offset int) ([]domain.ProductHistoryEntry, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByProduct", ctx, productID, limit, offset)
+	ret0, _ := ret[0].([]domain.ProductHistoryEntry)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByProduct indicates an expected call of ListByProduct.
+func (mr *MockProductHistoryRepositoryMockRecorder) ListByProduct(ctx, productID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProduct", reflect.TypeOf((*MockProductHistoryRepository)(nil).ListByProduct), ctx, productID, limit, offset)
+}
@@ -12,7 +12,9 @@ package mocks
 import (
 	context "context"
 	domain "product_service/products/internal/domain"
+	ports "product_service/products/internal/usecase/ports"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +43,21 @@ func (m *MockProductRepository) EXPECT() *MockProductRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountActiveVariants mocks base method.
+func (m *MockProductRepository) CountActiveVariants(ctx context.Context, parentID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveVariants", ctx, parentID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveVariants indicates an expected call of CountActiveVariants.
+func (mr *MockProductRepositoryMockRecorder) CountActiveVariants(ctx, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveVariants", reflect.TypeOf((*MockProductRepository)(nil).CountActiveVariants), ctx, parentID)
+}
+
 // Create mocks base method.
 func (m *MockProductRepository) Create(ctx context.Context, product *domain.Product) error {
 	m.ctrl.T.Helper()
@@ -55,47 +72,133 @@ func (mr *MockProductRepositoryMockRecorder) Create(ctx, product any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductRepository)(nil).Create), ctx, product)
 }
 
+// CreateBatch mocks base method.
+func (m *MockProductRepository) CreateBatch(ctx context.Context, products []*domain.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, products)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockProductRepositoryMockRecorder) CreateBatch(ctx, products any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockProductRepository)(nil).CreateBatch), ctx, products)
+}
+
 // Delete mocks base method.
-func (m *MockProductRepository) Delete(ctx context.Context, id int) error {
+func (m *MockProductRepository) Delete(ctx context.Context, id, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockProductRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProductRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProductRepository)(nil).Delete), ctx, id, version)
 }
 
 // GetByID mocks base method.
-func (m *MockProductRepository) GetByID(ctx context.Context, id int) (*domain.Product, error) {
+func (m *MockProductRepository) GetByID(ctx context.Context, id int, includeDeleted bool) (*domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, includeDeleted)
 	ret0, _ := ret[0].(*domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID.
-func (mr *MockProductRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) GetByID(ctx, id, includeDeleted any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProductRepository)(nil).GetByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProductRepository)(nil).GetByID), ctx, id, includeDeleted)
 }
 
-// List mocks base method.
-func (m *MockProductRepository) List(ctx context.Context, page, limit int) ([]domain.Product, int, error) {
+// GetByIDs mocks base method.
+func (m *MockProductRepository) GetByIDs(ctx context.Context, ids []int, includeDeleted bool) ([]domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, page, limit)
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids, includeDeleted)
 	ret0, _ := ret[0].([]domain.Product)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockProductRepositoryMockRecorder) GetByIDs(ctx, ids, includeDeleted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockProductRepository)(nil).GetByIDs), ctx, ids, includeDeleted)
+}
+
+// List mocks base method.
+func (m *MockProductRepository) List(ctx context.Context, query ports.ProductListQuery) (ports.ProductListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].(ports.ProductListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockProductRepositoryMockRecorder) List(ctx, page, limit any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProductRepository)(nil).List), ctx, query)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockProductRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, deletedBefore, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockProductRepositoryMockRecorder) PurgeDeleted(ctx, deletedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockProductRepository)(nil).PurgeDeleted), ctx, deletedBefore, limit)
+}
+
+// Restore mocks base method.
+func (m *MockProductRepository) Restore(ctx context.Context, product *domain.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockProductRepositoryMockRecorder) Restore(ctx, product any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockProductRepository)(nil).Restore), ctx, product)
+}
+
+// Search mocks base method.
+func (m *MockProductRepository) Search(ctx context.Context, query ports.ProductSearchQuery) (ports.ProductSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query)
+	ret0, _ := ret[0].(ports.ProductSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockProductRepositoryMockRecorder) Search(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockProductRepository)(nil).Search), ctx, query)
+}
+
+// Update mocks base method.
+func (m *MockProductRepository) Update(ctx context.Context, product *domain.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProductRepositoryMockRecorder) Update(ctx, product any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProductRepository)(nil).List), ctx, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductRepository)(nil).Update), ctx, product)
 }
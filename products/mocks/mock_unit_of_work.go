@@ -56,6 +56,20 @@ func (mr *MockUnitOfWorkMockRecorder) Begin(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockUnitOfWork)(nil).Begin), ctx)
 }
 
+// CategoryRepository mocks base method.
+func (m *MockUnitOfWork) CategoryRepository() ports.CategoryRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CategoryRepository")
+	ret0, _ := ret[0].(ports.CategoryRepository)
+	return ret0
+}
+
+// CategoryRepository indicates an expected call of CategoryRepository.
+func (mr *MockUnitOfWorkMockRecorder) CategoryRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryRepository", reflect.TypeOf((*MockUnitOfWork)(nil).CategoryRepository))
+}
+
 // Commit mocks base method.
 func (m *MockUnitOfWork) Commit() error {
 	m.ctrl.T.Helper()
@@ -70,6 +84,20 @@ func (mr *MockUnitOfWorkMockRecorder) Commit() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockUnitOfWork)(nil).Commit))
 }
 
+// InventoryRepository mocks base method.
+func (m *MockUnitOfWork) InventoryRepository() ports.InventoryRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InventoryRepository")
+	ret0, _ := ret[0].(ports.InventoryRepository)
+	return ret0
+}
+
+// InventoryRepository indicates an expected call of InventoryRepository.
+func (mr *MockUnitOfWorkMockRecorder) InventoryRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InventoryRepository", reflect.TypeOf((*MockUnitOfWork)(nil).InventoryRepository))
+}
+
 // OutboxRepository mocks base method.
 func (m *MockUnitOfWork) OutboxRepository() ports.OutboxRepository {
 	m.ctrl.T.Helper()
@@ -84,6 +112,20 @@ func (mr *MockUnitOfWorkMockRecorder) OutboxRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxRepository", reflect.TypeOf((*MockUnitOfWork)(nil).OutboxRepository))
 }
 
+// ProductHistoryRepository mocks base method.
+func (m *MockUnitOfWork) ProductHistoryRepository() ports.ProductHistoryRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductHistoryRepository")
+	ret0, _ := ret[0].(ports.ProductHistoryRepository)
+	return ret0
+}
+
+// ProductHistoryRepository indicates an expected call of ProductHistoryRepository.
+func (mr *MockUnitOfWorkMockRecorder) ProductHistoryRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductHistoryRepository", reflect.TypeOf((*MockUnitOfWork)(nil).ProductHistoryRepository))
+}
+
 // ProductRepository mocks base method.
 func (m *MockUnitOfWork) ProductRepository() ports.ProductRepository {
 	m.ctrl.T.Helper()